@@ -1,11 +1,18 @@
 package alicloud
 
 import (
+	"flag"
 	"fmt"
+	"log"
 	"os"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -43,3 +50,411 @@ func sharedClientForRegion(region string) (interface{}, error) {
 
 	return client, nil
 }
+
+// The flags below select the resources deleted by the sweepers in addition to their names, e.g.
+// go test ./alicloud -v -sweep=cn-hangzhou -sweep-tags=created_by=terraform-test -sweep-min-age=6h -sweep-dry-run
+var (
+	sweepTags   = flag.String("sweep-tags", "", "Comma separated key=value tags, the resources which have all of them are swept whatever their names are")
+	sweepMinAge = flag.Duration("sweep-min-age", 0, "The resources created within this duration are not swept, e.g. 6h")
+	sweepDryRun = flag.Bool("sweep-dry-run", false, "List the resources which would be swept without deleting them")
+)
+
+// sweepTestPrefixes are the name prefixes of the resources created by the acceptance tests.
+var sweepTestPrefixes = []string{
+	"tf-testAcc",
+	"tf_testAcc",
+}
+
+// sweepers records the registered sweepers by their names, so that their coverage and dependencies can be checked.
+var sweepers = make(map[string]*resource.Sweeper)
+
+func addTestSweepers(name string, s *resource.Sweeper) {
+	sweepers[name] = s
+	resource.AddTestSweepers(name, s)
+}
+
+// sweptWithOwners maps the resources which are deleted along with the resources owning them to their owners.
+// Their sweepers do nothing but depend on the sweepers of their owners.
+var sweptWithOwners = map[string]string{
+	"alicloud_api_gateway_app_attachment":         "alicloud_api_gateway_app",
+	"alicloud_cdn_domain_config":                  "alicloud_cdn_domain_new",
+	"alicloud_cen_bandwidth_limit":                "alicloud_cen_instance",
+	"alicloud_cen_bandwidth_package_attachment":   "alicloud_cen_bandwidth_package",
+	"alicloud_cen_instance_grant":                 "alicloud_vpc", // the grant is revoked along with the granted VPC
+	"alicloud_cen_route_entry":                    "alicloud_cen_instance",
+	"alicloud_container_cluster":                  "alicloud_cs_cluster",
+	"alicloud_cr_repo":                            "alicloud_cr_namespace",
+	"alicloud_cs_application":                     "alicloud_cs_cluster",
+	"alicloud_cs_kubernetes":                      "alicloud_cs_cluster",
+	"alicloud_cs_managed_kubernetes":              "alicloud_cs_cluster",
+	"alicloud_cs_serverless_kubernetes":           "alicloud_cs_cluster",
+	"alicloud_cs_swarm":                           "alicloud_cs_cluster",
+	"alicloud_datahub_subscription":               "alicloud_datahub_project",
+	"alicloud_datahub_topic":                      "alicloud_datahub_project",
+	"alicloud_db_account":                         "alicloud_db_instance",
+	"alicloud_db_account_privilege":               "alicloud_db_instance",
+	"alicloud_db_backup_policy":                   "alicloud_db_instance",
+	"alicloud_db_connection":                      "alicloud_db_instance",
+	"alicloud_db_database":                        "alicloud_db_instance",
+	"alicloud_db_read_write_splitting_connection": "alicloud_db_instance",
+	"alicloud_db_readonly_instance":               "alicloud_db_instance",
+	"alicloud_disk_attachment":                    "alicloud_instance",
+	"alicloud_dns_record":                         "alicloud_dns",
+	"alicloud_ecs_invocation":                     "alicloud_ecs_command",
+	"alicloud_eip_association":                    "alicloud_eip",
+	"alicloud_ess_alarm":                          "alicloud_ess_scaling_group",
+	"alicloud_ess_attachment":                     "alicloud_ess_scaling_group",
+	"alicloud_ess_lifecycle_hook":                 "alicloud_ess_scaling_group",
+	"alicloud_ess_notification":                   "alicloud_ess_scaling_group",
+	"alicloud_ess_scaling_configuration":          "alicloud_ess_scaling_group",
+	"alicloud_ess_scaling_rule":                   "alicloud_ess_scaling_group",
+	"alicloud_ess_scalinggroup_vserver_groups":    "alicloud_ess_scaling_group",
+	"alicloud_ess_schedule":                       "alicloud_ess_scaling_group",
+	"alicloud_forward_entry":                      "alicloud_nat_gateway",
+	"alicloud_gpdb_connection":                    "alicloud_gpdb_instance",
+	"alicloud_image_copy":                         "alicloud_image",
+	"alicloud_image_import":                       "alicloud_image",
+	"alicloud_image_share_permission":             "alicloud_image",
+	"alicloud_key_pair_attachment":                "alicloud_instance",
+	"alicloud_kvstore_backup_policy":              "alicloud_kvstore_instance",
+	"alicloud_log_machine_group":                  "alicloud_log_project",
+	"alicloud_log_store":                          "alicloud_log_project",
+	"alicloud_log_store_index":                    "alicloud_log_project",
+	"alicloud_logtail_attachment":                 "alicloud_log_project",
+	"alicloud_mns_topic_subscription":             "alicloud_mns_topic",
+	"alicloud_nas_access_rule":                    "alicloud_nas_access_group",
+	"alicloud_network_interface_attachment":       "alicloud_network_interface",
+	"alicloud_oss_bucket_object":                  "alicloud_oss_bucket",
+	"alicloud_ots_instance_attachment":            "alicloud_ots_instance",
+	"alicloud_ots_table":                          "alicloud_ots_instance",
+	"alicloud_pvtz_zone_attachment":               "alicloud_pvtz_zone",
+	"alicloud_pvtz_zone_record":                   "alicloud_pvtz_zone",
+	"alicloud_ram_access_key":                     "alicloud_ram_user",
+	"alicloud_ram_alias":                          "alicloud_ram_account_alias",
+	"alicloud_ram_group_membership":               "alicloud_ram_group",
+	"alicloud_ram_group_policy_attachment":        "alicloud_ram_group",
+	"alicloud_ram_login_profile":                  "alicloud_ram_user",
+	"alicloud_ram_role_attachment":                "alicloud_ram_role",
+	"alicloud_ram_role_policy_attachment":         "alicloud_ram_role",
+	"alicloud_ram_user_policy_attachment":         "alicloud_ram_user",
+	"alicloud_route_entry":                        "alicloud_route_table",
+	"alicloud_router_interface_connection":        "alicloud_router_interface",
+	"alicloud_sag_acl_rule":                       "alicloud_sag_acl",
+	"alicloud_sag_qos_car":                        "alicloud_sag_qos",
+	"alicloud_sag_qos_policy":                     "alicloud_sag_qos",
+	"alicloud_security_group_rule":                "alicloud_security_group",
+	"alicloud_slb_attachment":                     "alicloud_slb",
+	"alicloud_slb_backend_server":                 "alicloud_slb",
+	"alicloud_slb_domain_extension":               "alicloud_slb",
+	"alicloud_slb_listener":                       "alicloud_slb",
+	"alicloud_slb_master_slave_server_group":      "alicloud_slb",
+	"alicloud_slb_rule":                           "alicloud_slb",
+	"alicloud_slb_server_group":                   "alicloud_slb",
+	"alicloud_snat_entry":                         "alicloud_nat_gateway",
+	"alicloud_subnet":                             "alicloud_vswitch",
+	"alicloud_vpn_connection":                     "alicloud_vpn_gateway",
+	"alicloud_vpn_route_entry":                    "alicloud_vpn_gateway",
+}
+
+// sweptNever lists the resources which are not deleted by the sweepers, with the reasons.
+var sweptNever = map[string]string{
+	"alicloud_havip":                       "only the white listed accounts can operate the HaVip resources",
+	"alicloud_havip_attachment":            "only the white listed accounts can operate the HaVip resources",
+	"alicloud_image_export":                "the exported file is kept in its bucket which is swept along with the bucket",
+	"alicloud_ram_account_password_policy": "it is a setting of the account",
+	"alicloud_renewal":                     "it is a setting of a subscription which is released along with its instance",
+	"alicloud_sag_snat_entry":              "it belongs to a Smart Access Gateway instance which is not created by the tests",
+}
+
+func init() {
+	for name, owner := range sweptWithOwners {
+		addTestSweepers(name, &resource.Sweeper{
+			Name:         name,
+			F:            testSweepWithOwner,
+			Dependencies: []string{owner},
+		})
+	}
+	for name := range sweptNever {
+		addTestSweepers(name, &resource.Sweeper{
+			Name: name,
+			F:    testSweepWithOwner,
+		})
+	}
+}
+
+// testSweepWithOwner does nothing, the resources are swept by the sweepers of their dependencies.
+func testSweepWithOwner(region string) error {
+	return nil
+}
+
+// sweepNameMatched reports whether the name starts with one of the prefixes of the acceptance tests.
+func sweepNameMatched(name string) bool {
+	for _, prefix := range sweepTestPrefixes {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sweepSkip reports whether a sweeper skips the object, a resource found by it which it skips by name when skip is true.
+// The object is swept anyway when it has all the -sweep-tags, and it is skipped when it was created within -sweep-min-age
+// or -sweep-dry-run is set. The tags and the creation time are read from the common fields of the API objects.
+func sweepSkip(resourceType string, skip bool, object interface{}) bool {
+	if skip && !sweepTagsMatched(resourceType, object) {
+		return true
+	}
+	if *sweepMinAge > 0 {
+		if created, ok := sweepCreationTime(object); ok && time.Since(created) < *sweepMinAge {
+			log.Printf("[INFO] Skipping %s %s created at %s", resourceType, sweepDescribe(object), created.Format(time.RFC3339))
+			return true
+		}
+	}
+	if *sweepDryRun {
+		log.Printf("[INFO] Dry run, %s %s would be deleted", resourceType, sweepDescribe(object))
+		return true
+	}
+	return false
+}
+
+// sweepTagsWarned records the resource types whose API objects have no Tags field, they are warned about only once.
+var sweepTagsWarned sync.Map
+
+func sweepTagsMatched(resourceType string, object interface{}) bool {
+	if *sweepTags == "" {
+		return false
+	}
+	tags, ok := sweepObjectTags(object)
+	if !ok {
+		if _, warned := sweepTagsWarned.LoadOrStore(resourceType, true); !warned {
+			log.Printf("[WARN] -sweep-tags is ignored by the sweeper of %s, its API objects have no Tags field", resourceType)
+		}
+		return false
+	}
+	for _, tag := range strings.Split(*sweepTags, ",") {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 {
+			return false
+		}
+		if value, ok := tags[parts[0]]; !ok || value != parts[1] {
+			return false
+		}
+	}
+	return true
+}
+
+func sweepIndirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// sweepObjectTags reads the Tags field of an API object, a map or a list of key and value pairs wrapped in structs.
+// It returns false when the object has no Tags field.
+func sweepObjectTags(object interface{}) (map[string]string, bool) {
+	tags := make(map[string]string)
+	v := sweepIndirect(reflect.ValueOf(object))
+	if v.Kind() != reflect.Struct {
+		return tags, false
+	}
+	field := v.FieldByName("Tags")
+	if !field.IsValid() {
+		return tags, false
+	}
+	collectSweepTags(field, tags)
+	return tags, true
+}
+
+func collectSweepTags(v reflect.Value, tags map[string]string) {
+	v = sweepIndirect(v)
+	switch v.Kind() {
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			tags[fmt.Sprint(key.Interface())] = fmt.Sprint(sweepIndirect(v.MapIndex(key)).Interface())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			item := sweepIndirect(v.Index(i))
+			if item.Kind() != reflect.Struct {
+				continue
+			}
+			key := sweepStringField(item, "TagKey", "Key")
+			if key != "" {
+				tags[key] = sweepStringField(item, "TagValue", "Value")
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			collectSweepTags(v.Field(i), tags)
+		}
+	}
+}
+
+func sweepStringField(v reflect.Value, names ...string) string {
+	for _, name := range names {
+		if field := v.FieldByName(name); field.IsValid() && field.Kind() == reflect.String {
+			return field.String()
+		}
+	}
+	return ""
+}
+
+var sweepCreationTimeFields = []string{"CreationTime", "CreateTime", "CreatedTime", "CreateDate", "GmtCreate", "GmtCreated", "CreatedAt", "Created"}
+
+var sweepTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04Z", "2006-01-02T15:04:05.000Z", "2006-01-02 15:04:05", "2006-01-02T15:04:05.000-0700"}
+
+// sweepCreationTime reads the creation time of an API object, a time string or a Unix timestamp in seconds or milliseconds.
+func sweepCreationTime(object interface{}) (time.Time, bool) {
+	v := sweepIndirect(reflect.ValueOf(object))
+	if v.Kind() != reflect.Struct {
+		return time.Time{}, false
+	}
+	for _, name := range sweepCreationTimeFields {
+		field := sweepIndirect(v.FieldByName(name))
+		if !field.IsValid() {
+			continue
+		}
+		switch field.Kind() {
+		case reflect.String:
+			for _, layout := range sweepTimeLayouts {
+				if t, err := time.Parse(layout, field.String()); err == nil {
+					return t, true
+				}
+			}
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			if seconds := field.Int(); seconds > 1e12 {
+				return time.Unix(0, seconds*int64(time.Millisecond)), true
+			} else if seconds > 0 {
+				return time.Unix(seconds, 0), true
+			}
+		case reflect.Struct:
+			if t, ok := field.Interface().(time.Time); ok {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// sweepDescribe formats an API object as its name and ID for the logs.
+func sweepDescribe(object interface{}) string {
+	v := sweepIndirect(reflect.ValueOf(object))
+	if v.Kind() == reflect.String {
+		return v.String()
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Sprint(object)
+	}
+	var name, id string
+	for i := 0; i < v.NumField(); i++ {
+		field, value := v.Type().Field(i), sweepIndirect(v.Field(i))
+		if value.Kind() != reflect.String && value.Kind() != reflect.Int64 && value.Kind() != reflect.Int {
+			continue
+		}
+		if name == "" && strings.HasSuffix(field.Name, "Name") {
+			name = fmt.Sprint(value.Interface())
+		}
+		if id == "" && (strings.HasSuffix(field.Name, "Id") || strings.HasSuffix(field.Name, "ID")) {
+			id = fmt.Sprint(value.Interface())
+		}
+	}
+	return fmt.Sprintf("%s (%s)", name, id)
+}
+
+func TestSweepersRegistered(t *testing.T) {
+	for name := range Provider().(*schema.Provider).ResourcesMap {
+		if _, ok := sweepers[name]; !ok {
+			t.Errorf("the resource %s has no sweeper, add one or list it in sweptWithOwners or sweptNever", name)
+		}
+	}
+	for name, s := range sweepers {
+		for _, dependency := range s.Dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				t.Errorf("the sweeper %s depends on %s which is not registered", name, dependency)
+			}
+		}
+	}
+
+	// The sweepers are visited depth first, a sweeper met again while its dependencies are being visited is in a cycle.
+	visiting, visited := make(map[string]bool), make(map[string]bool)
+	var visit func(name string, path []string)
+	visit = func(name string, path []string) {
+		if visited[name] {
+			return
+		}
+		if visiting[name] {
+			t.Errorf("the sweepers depend on each other: %s", strings.Join(append(path, name), " -> "))
+			return
+		}
+		visiting[name] = true
+		if s, ok := sweepers[name]; ok {
+			for _, dependency := range s.Dependencies {
+				visit(dependency, append(path, name))
+			}
+		}
+		visiting[name] = false
+		visited[name] = true
+	}
+	for name := range sweepers {
+		visit(name, nil)
+	}
+}
+
+func TestSweepSkip(t *testing.T) {
+	type tag struct {
+		TagKey   string
+		TagValue string
+	}
+	type object struct {
+		InstanceId   string
+		InstanceName string
+		CreationTime string
+		Tags         struct {
+			Tag []tag
+		}
+	}
+	recent := object{InstanceId: "i-1", InstanceName: "recent", CreationTime: time.Now().UTC().Format("2006-01-02T15:04Z")}
+	old := object{InstanceId: "i-2", InstanceName: "old", CreationTime: "2019-01-02T15:04:05Z"}
+	old.Tags.Tag = []tag{{TagKey: "created_by", TagValue: "terraform-test"}}
+
+	defer func(tags string, age time.Duration, dryRun bool) {
+		*sweepTags, *sweepMinAge, *sweepDryRun = tags, age, dryRun
+	}(*sweepTags, *sweepMinAge, *sweepDryRun)
+
+	cases := []struct {
+		tags   string
+		age    time.Duration
+		dryRun bool
+		skip   bool
+		object object
+		want   bool
+	}{
+		{skip: true, object: old, want: true},
+		{skip: false, object: old, want: false},
+		{tags: "created_by=terraform-test", skip: true, object: old, want: false},
+		{tags: "created_by=terraform-test,team=infra", skip: true, object: old, want: true},
+		{tags: "created_by=terraform-test", skip: true, object: recent, want: true},
+		{age: time.Hour, skip: false, object: recent, want: true},
+		{age: time.Hour, skip: false, object: old, want: false},
+		{dryRun: true, skip: false, object: old, want: true},
+	}
+	for i, c := range cases {
+		*sweepTags, *sweepMinAge, *sweepDryRun = c.tags, c.age, c.dryRun
+		if got := sweepSkip("alicloud_instance", c.skip, c.object); got != c.want {
+			t.Errorf("case %d: sweepSkip of %s returned %t, expected %t", i, sweepDescribe(c.object), got, c.want)
+		}
+	}
+
+	untagged := struct {
+		InstanceId   string
+		CreationTime string
+	}{InstanceId: "i-3", CreationTime: "2019-01-02T15:04:05Z"}
+	*sweepTags, *sweepMinAge, *sweepDryRun = "created_by=terraform-test", 0, false
+	if !sweepSkip("alicloud_instance", true, untagged) {
+		t.Errorf("sweepSkip of %s swept an object without the Tags field by -sweep-tags", sweepDescribe(untagged))
+	}
+}
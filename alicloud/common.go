@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/user"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strconv"
 	"strings"
@@ -24,6 +25,7 @@ import (
 	"github.com/aliyun/fc-go-sdk"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 
 	"gopkg.in/yaml.v2"
 
@@ -31,6 +33,7 @@ import (
 	"github.com/denverdino/aliyungo/common"
 	"github.com/google/uuid"
 	"github.com/mitchellh/go-homedir"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
 type InstanceNetWork string
@@ -202,6 +205,40 @@ const (
 	SnapshotPolicyNormal    = Status("Normal")
 )
 
+const (
+	ImageCreating     = Status("Creating")
+	ImageWaiting      = Status("Waiting")
+	ImageAvailable    = Status("Available")
+	ImageUnAvailable  = Status("UnAvailable")
+	ImageCreateFailed = Status("CreateFailed")
+)
+
+// ImageStatuses are all the statuses of the custom images, DescribeImages returns the available ones only by default.
+var ImageStatuses = []Status{ImageCreating, ImageWaiting, ImageAvailable, ImageUnAvailable, ImageCreateFailed}
+
+const (
+	TaskWaiting    = Status("Waiting")
+	TaskProcessing = Status("Processing")
+	TaskFinished   = Status("Finished")
+	TaskFailed     = Status("Failed")
+	TaskDeleted    = Status("Deleted")
+)
+
+const (
+	DedicatedHostAvailable        = Status("Available")
+	DedicatedHostUnderAssessment  = Status("UnderAssessment")
+	DedicatedHostPermanentFailure = Status("PermanentFailure")
+)
+
+const (
+	InvocationPending       = Status("Pending")
+	InvocationRunning       = Status("Running")
+	InvocationFinished      = Status("Finished")
+	InvocationFailed        = Status("Failed")
+	InvocationPartialFailed = Status("PartialFailed")
+	InvocationStopped       = Status("Stopped")
+)
+
 // timeout for common product, ecs e.g.
 const DefaultTimeout = 120
 
@@ -354,6 +391,7 @@ const (
 	TagResourceInstance      = TagResourceType("instance")
 	TagResourceSnapshot      = TagResourceType("snapshot")
 	TagResourceDisk          = TagResourceType("disk")
+	TagResourceDedicatedHost = TagResourceType("ddh")
 	TagResourceSecurityGroup = TagResourceType("securitygroup")
 	TagResourceEni           = TagResourceType("eni")
 	TagResourceCdn           = TagResourceType("DOMAIN")
@@ -364,6 +402,8 @@ const (
 	TagResourcePlugin        = TagResourceType("plugin")
 	TagResourceApiGroup      = TagResourceType("apiGroup")
 	TagResourceApp           = TagResourceType("app")
+	TagResourceTopic         = TagResourceType("topic")
+	TagResourceConsumerGroup = TagResourceType("consumergroup")
 )
 
 type KubernetesNodeType string
@@ -451,54 +491,6 @@ func writeToFile(filePath string, data interface{}) error {
 	return nil
 }
 
-type Invoker struct {
-	catchers []*Catcher
-}
-
-type Catcher struct {
-	Reason           string
-	RetryCount       int
-	RetryWaitSeconds int
-}
-
-var ClientErrorCatcher = Catcher{AliyunGoClientFailure, 10, 5}
-var ServiceBusyCatcher = Catcher{"ServiceUnavailable", 10, 5}
-var ThrottlingCatcher = Catcher{Throttling, 10, 10}
-
-func NewInvoker() Invoker {
-	i := Invoker{}
-	i.AddCatcher(ClientErrorCatcher)
-	i.AddCatcher(ServiceBusyCatcher)
-	i.AddCatcher(ThrottlingCatcher)
-	return i
-}
-
-func (a *Invoker) AddCatcher(catcher Catcher) {
-	a.catchers = append(a.catchers, &catcher)
-}
-
-func (a *Invoker) Run(f func() error) error {
-	err := f()
-
-	if err == nil {
-		return nil
-	}
-
-	for _, catcher := range a.catchers {
-		if IsExceptedErrors(err, []string{catcher.Reason}) {
-			catcher.RetryCount--
-
-			if catcher.RetryCount <= 0 {
-				return fmt.Errorf("Retry timeout and got an error: %#v.", err)
-			} else {
-				time.Sleep(time.Duration(catcher.RetryWaitSeconds) * time.Second)
-				return a.Run(f)
-			}
-		}
-	}
-	return err
-}
-
 func buildClientToken(action string) string {
 	token := strings.TrimSpace(fmt.Sprintf("TF-%s-%d-%s", action, time.Now().Unix(), strings.Trim(uuid.New().String(), "-")))
 	if len(token) > 64 {
@@ -614,7 +606,6 @@ func addDebug(action, content interface{}, requestInfo ...interface{}) {
 				Method     string
 				Product    string
 				Region     string
-				AK         string
 			}{}
 			switch requestInfo[0].(type) {
 			case *requests.RpcRequest:
@@ -665,7 +656,7 @@ func addDebug(action, content interface{}, requestInfo ...interface{}) {
 
 			requestContent := ""
 			if len(requestInfo) > 1 {
-				requestContent = fmt.Sprintf("%#v", requestInfo[1])
+				requestContent = fmt.Sprintf("%#v", redactDebugRequest(requestInfo[1]))
 			}
 
 			content = fmt.Sprintf("%vDomain:%v, Version:%v, ActionName:%v, Method:%v, Product:%v, Region:%v\n\n"+
@@ -679,6 +670,66 @@ func addDebug(action, content interface{}, requestInfo ...interface{}) {
 	}
 }
 
+// redactDebugRequest returns a copy of the request whose sensitive string fields and parameters are redacted,
+// so that the passwords and keys are not written into the debug logs.
+func redactDebugRequest(request interface{}) interface{} {
+	value := reflect.ValueOf(request)
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() || value.Elem().Kind() != reflect.Struct {
+			return request
+		}
+		copied := reflect.New(value.Elem().Type())
+		copied.Elem().Set(value.Elem())
+		redactStructFields(copied.Elem())
+		return copied.Interface()
+	case reflect.Struct:
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		redactStructFields(copied)
+		return copied.Interface()
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return request
+		}
+		copied := reflect.MakeMap(value.Type())
+		for _, key := range value.MapKeys() {
+			item := value.MapIndex(key)
+			if connectivity.IsSensitiveField(key.String()) {
+				item = redactedValueOf(item)
+			}
+			copied.SetMapIndex(key, item)
+		}
+		return copied.Interface()
+	}
+	return request
+}
+
+func redactStructFields(value reflect.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+		if connectivity.IsSensitiveField(value.Type().Field(i).Name) {
+			field.Set(redactedValueOf(field))
+		} else if field.Kind() == reflect.Map && !field.IsNil() {
+			field.Set(reflect.ValueOf(redactDebugRequest(field.Interface())))
+		}
+	}
+}
+
+func redactedValueOf(value reflect.Value) reflect.Value {
+	item := value
+	if item.Kind() == reflect.Interface {
+		item = item.Elem()
+	}
+	if item.Kind() == reflect.String && item.Len() > 0 {
+		return reflect.ValueOf("******").Convert(item.Type())
+	}
+	return value
+}
+
 // Return a ComplexError which including extra error message, error occurred file and path
 func GetFunc(level int) string {
 	pc, _, _, ok := runtime.Caller(level)
@@ -748,3 +799,30 @@ func incrementalWait(firstDuration time.Duration, increaseDuration time.Duration
 		retryCount++
 	}
 }
+
+// customizeDiffAll runs the CustomizeDiff functions in order and stops at the first error.
+func customizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(d, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// productAvailableCustomizeDiff reports at plan time that a new resource can not be created because its product
+// is not available in the region of the provider.
+func productAvailableCustomizeDiff(serviceCode connectivity.ServiceCode) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*connectivity.AliyunClient)
+		if !ok || d.Id() != "" {
+			return nil
+		}
+		if !client.IsProductAvailable(serviceCode) {
+			return WrapError(Error("The product %s is not available in the region %s.", serviceCode, client.RegionId))
+		}
+		return nil
+	}
+}
@@ -2,7 +2,6 @@ package connectivity
 
 import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/actiontrail"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
@@ -45,7 +44,9 @@ import (
 	"github.com/denverdino/aliyungo/cs"
 	"github.com/dxh031/ali_mns"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/valyala/fasthttp"
 
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net/http"
@@ -66,7 +67,17 @@ type AliyunClient struct {
 	SecretKey                    string
 	SecurityToken                string
 	OtsInstanceName              string
+	DefaultTags                  map[string]string
+	IgnoreTagKeys                []string
+	IgnoreTagKeyPrefixes         []string
 	accountIdMutex               sync.RWMutex
+	serviceMutexesLock           sync.Mutex
+	serviceMutexes               map[ServiceCode]*sync.Mutex
+	rateLimiter                  *RateLimiter
+	regionValidation             sync.Once
+	regionError                  error
+	credentialVersionsLock       sync.Mutex
+	credentialVersions           map[string]int
 	config                       *Config
 	accountId                    string
 	ecsconn                      *ecs.Client
@@ -109,6 +120,7 @@ type AliyunClient struct {
 	bssopenapiconn               *bssopenapi.Client
 	emrconn                      *emr.Client
 	sagconn                      *smartag.Client
+	resourcemanagerconn          *sdk.Client
 }
 
 type ApiVersion string
@@ -133,292 +145,353 @@ const Provider = "Terraform-Provider"
 
 const Module = "Terraform-Module"
 
-var goSdkMutex = sync.RWMutex{} // The Go SDK is not thread-safe
 // The main version number that is being run at the moment.
 var providerVersion = "1.60.0"
 var terraformVersion = strings.TrimSuffix(terraform.VersionString(), "-dev")
 
-// Client for AliyunClient
+// Client for AliyunClient. The region is not validated here but when the first product client is built, see checkRegion.
 func (c *Config) Client() (*AliyunClient, error) {
-	// Get the auth and region. This can fail if keys/regions were not
-	// specified and we're attempting to use the environment.
-	if !c.SkipRegionValidation {
-		err := c.loadAndValidate()
-		if err != nil {
-			return nil, err
-		}
-	}
-
+	credential := c.currentCredential()
 	return &AliyunClient{
 		config:                       c,
 		Region:                       c.Region,
 		RegionId:                     c.RegionId,
-		AccessKey:                    c.AccessKey,
-		SecretKey:                    c.SecretKey,
-		SecurityToken:                c.SecurityToken,
+		AccessKey:                    credential.AccessKey,
+		SecretKey:                    credential.SecretKey,
+		SecurityToken:                credential.SecurityToken,
 		OtsInstanceName:              c.OtsInstanceName,
+		DefaultTags:                  c.DefaultTags,
+		IgnoreTagKeys:                c.IgnoreTagKeys,
+		IgnoreTagKeyPrefixes:         c.IgnoreTagKeyPrefixes,
 		accountId:                    c.AccountId,
 		tablestoreconnByInstanceName: make(map[string]*tablestore.TableStoreClient),
 		csprojectconnByKey:           make(map[string]*cs.ProjectClient),
+		serviceMutexes:               make(map[ServiceCode]*sync.Mutex),
+		rateLimiter:                  NewRateLimiter(c.RateLimits, c.ActionRateLimits),
 	}, nil
 }
 
+// initServiceClient runs init while holding the mutex of the specified service. It makes sure a product client is only
+// built once, without blocking the calls to the other products which have been initialized.
+// The temporary credential is renewed first if it is going to expire, and init rebuilds the client with the new one.
+// A custom endpoint is set as the domain of the product client instead of being added into the endpoint mapping table
+// of the SDK, which every request reads without a lock.
+func (client *AliyunClient) initServiceClient(serviceCode ServiceCode, init func() error) error {
+	if err := client.config.refreshCredential(); err != nil {
+		return err
+	}
+	if err := client.checkRegion(serviceCode); err != nil {
+		return err
+	}
+
+	client.serviceMutexesLock.Lock()
+	if client.serviceMutexes == nil {
+		client.serviceMutexes = make(map[ServiceCode]*sync.Mutex)
+	}
+	mutex, ok := client.serviceMutexes[serviceCode]
+	if !ok {
+		mutex = &sync.Mutex{}
+		client.serviceMutexes[serviceCode] = mutex
+	}
+	client.serviceMutexesLock.Unlock()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	return init()
+}
+
 func (client *AliyunClient) WithEcsClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the ECS client if necessary
-	if client.ecsconn == nil {
-		endpoint := client.config.EcsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ECSCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(ECSCode), endpoint)
-		}
-		ecsconn, err := ecs.NewClientWithOptions(client.config.RegionId, client.getSdkConfig().WithTimeout(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the ECS client: %#v", err)
-		}
+	var conn *ecs.Client
+	if err := client.initServiceClient(ECSCode, func() error {
+		// Initialize the ECS client if necessary
+		if client.credentialRenewed("ecsconn") || client.ecsconn == nil {
+			endpoint := client.config.EcsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, ECSCode)
+			}
+			ecsconn, err := ecs.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ECSCode).WithTimeout(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the ECS client: %#v", err)
+			}
+			ecsconn.Domain = endpoint
 
-		if _, err := ecsconn.DescribeRegions(ecs.CreateDescribeRegionsRequest()); err != nil {
-			return nil, err
-		}
-		ecsconn.AppendUserAgent(Terraform, terraformVersion)
-		ecsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			ecsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			_, err = ecsconn.DescribeRegions(ecs.CreateDescribeRegionsRequest())
+			if err != nil {
+				return err
+			}
+			ecsconn.AppendUserAgent(Terraform, terraformVersion)
+			ecsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(ECSCode, ecsconn)
+			if client.config.ConfigurationSource != "" {
+				ecsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.ecsconn = ecsconn
 		}
-		client.ecsconn = ecsconn
+		conn = client.ecsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.ecsconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithRdsClient(do func(*rds.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the RDS client if necessary
-	if client.rdsconn == nil {
-		endpoint := client.config.RdsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, RDSCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(RDSCode), endpoint)
-		}
-		rdsconn, err := rds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the RDS client: %#v", err)
-		}
+	var conn *rds.Client
+	if err := client.initServiceClient(RDSCode, func() error {
+		// Initialize the RDS client if necessary
+		if client.credentialRenewed("rdsconn") || client.rdsconn == nil {
+			endpoint := client.config.RdsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, RDSCode)
+			}
+			rdsconn, err := rds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(RDSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the RDS client: %#v", err)
+			}
+			rdsconn.Domain = endpoint
 
-		rdsconn.AppendUserAgent(Terraform, terraformVersion)
-		rdsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			rdsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			rdsconn.AppendUserAgent(Terraform, terraformVersion)
+			rdsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(RDSCode, rdsconn)
+			if client.config.ConfigurationSource != "" {
+				rdsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.rdsconn = rdsconn
 		}
-		client.rdsconn = rdsconn
+		conn = client.rdsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.rdsconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithSlbClient(do func(*slb.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the SLB client if necessary
-	if client.slbconn == nil {
-		endpoint := client.config.SlbEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, SLBCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(SLBCode), endpoint)
-		}
-		slbconn, err := slb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the SLB client: %#v", err)
-		}
+	var conn *slb.Client
+	if err := client.initServiceClient(SLBCode, func() error {
+		// Initialize the SLB client if necessary
+		if client.credentialRenewed("slbconn") || client.slbconn == nil {
+			endpoint := client.config.SlbEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, SLBCode)
+			}
+			slbconn, err := slb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(SLBCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the SLB client: %#v", err)
+			}
+			slbconn.Domain = endpoint
 
-		slbconn.AppendUserAgent(Terraform, terraformVersion)
-		slbconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			slbconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			slbconn.AppendUserAgent(Terraform, terraformVersion)
+			slbconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(SLBCode, slbconn)
+			if client.config.ConfigurationSource != "" {
+				slbconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.slbconn = slbconn
 		}
-		client.slbconn = slbconn
+		conn = client.slbconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.slbconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithVpcClient(do func(*vpc.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the VPC client if necessary
-	if client.vpcconn == nil {
-		endpoint := client.config.VpcEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, VPCCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(VPCCode), endpoint)
-		}
-		vpcconn, err := vpc.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the VPC client: %#v", err)
-		}
+	var conn *vpc.Client
+	if err := client.initServiceClient(VPCCode, func() error {
+		// Initialize the VPC client if necessary
+		if client.credentialRenewed("vpcconn") || client.vpcconn == nil {
+			endpoint := client.config.VpcEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, VPCCode)
+			}
+			vpcconn, err := vpc.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(VPCCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the VPC client: %#v", err)
+			}
+			vpcconn.Domain = endpoint
 
-		vpcconn.AppendUserAgent(Terraform, terraformVersion)
-		vpcconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			vpcconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			vpcconn.AppendUserAgent(Terraform, terraformVersion)
+			vpcconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(VPCCode, vpcconn)
+			if client.config.ConfigurationSource != "" {
+				vpcconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.vpcconn = vpcconn
 		}
-		client.vpcconn = vpcconn
+		conn = client.vpcconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.vpcconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithNasClient(do func(*nas.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the Nas client if necessary
-	if client.nasconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, NASCode)
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(NASCode), endpoint)
-		}
-		nasconn, err := nas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the NAS client: %#v", err)
-		}
-		nasconn.AppendUserAgent(Terraform, terraformVersion)
-		nasconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			nasconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+	var conn *nas.Client
+	if err := client.initServiceClient(NASCode, func() error {
+		// Initialize the Nas client if necessary
+		if client.credentialRenewed("nasconn") || client.nasconn == nil {
+			endpoint := client.config.NasEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, NASCode)
+			}
+			nasconn, err := nas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(NASCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the NAS client: %#v", err)
+			}
+			nasconn.Domain = endpoint
+			nasconn.AppendUserAgent(Terraform, terraformVersion)
+			nasconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(NASCode, nasconn)
+			if client.config.ConfigurationSource != "" {
+				nasconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.nasconn = nasconn
 		}
-		client.nasconn = nasconn
+		conn = client.nasconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.nasconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithCenClient(do func(*cbn.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the CEN client if necessary
-	if client.cenconn == nil {
-		endpoint := client.config.CenEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CENCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(CENCode), endpoint)
-		}
-		cenconn, err := cbn.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the CEN client: %#v", err)
-		}
+	var conn *cbn.Client
+	if err := client.initServiceClient(CENCode, func() error {
+		// Initialize the CEN client if necessary
+		if client.credentialRenewed("cenconn") || client.cenconn == nil {
+			endpoint := client.config.CenEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, CENCode)
+			}
+			cenconn, err := cbn.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CENCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the CEN client: %#v", err)
+			}
+			cenconn.Domain = endpoint
 
-		cenconn.AppendUserAgent(Terraform, terraformVersion)
-		cenconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			cenconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			cenconn.AppendUserAgent(Terraform, terraformVersion)
+			cenconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(CENCode, cenconn)
+			if client.config.ConfigurationSource != "" {
+				cenconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.cenconn = cenconn
 		}
-		client.cenconn = cenconn
+		conn = client.cenconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.cenconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithEssClient(do func(*ess.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the ESS client if necessary
-	if client.essconn == nil {
-		endpoint := client.config.EssEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ESSCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(ESSCode), endpoint)
-		}
-		essconn, err := ess.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the ESS client: %#v", err)
-		}
+	var conn *ess.Client
+	if err := client.initServiceClient(ESSCode, func() error {
+		// Initialize the ESS client if necessary
+		if client.credentialRenewed("essconn") || client.essconn == nil {
+			endpoint := client.config.EssEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, ESSCode)
+			}
+			essconn, err := ess.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ESSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the ESS client: %#v", err)
+			}
+			essconn.Domain = endpoint
 
-		essconn.AppendUserAgent(Terraform, terraformVersion)
-		essconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			essconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			essconn.AppendUserAgent(Terraform, terraformVersion)
+			essconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(ESSCode, essconn)
+			if client.config.ConfigurationSource != "" {
+				essconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.essconn = essconn
 		}
-		client.essconn = essconn
+		conn = client.essconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.essconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the OSS client if necessary
-	if client.ossconn == nil {
-		schma := "https"
-		endpoint := client.config.OssEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, OSSCode)
-		}
-		if endpoint == "" {
-			endpointItem, _ := client.describeEndpointForService(strings.ToLower(string(OSSCode)))
-			if endpointItem != nil {
-				if len(endpointItem.Protocols.Protocols) > 0 {
-					// HTTP or HTTPS
-					schma = strings.ToLower(endpointItem.Protocols.Protocols[0])
-					for _, p := range endpointItem.Protocols.Protocols {
-						if strings.ToLower(p) == "https" {
-							schma = strings.ToLower(p)
-							break
+	var conn *oss.Client
+	if err := client.initServiceClient(OSSCode, func() error {
+		// Initialize the OSS client if necessary
+		if client.credentialRenewed("ossconn") || client.ossconn == nil {
+			credential := client.config.currentCredential()
+			schma := "https"
+			endpoint := client.config.OssEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, OSSCode)
+			}
+			if endpoint == "" {
+				endpointItem, _ := client.describeEndpointForService(strings.ToLower(string(OSSCode)))
+				if endpointItem != nil {
+					if len(endpointItem.Protocols.Protocols) > 0 {
+						// HTTP or HTTPS
+						schma = strings.ToLower(endpointItem.Protocols.Protocols[0])
+						for _, p := range endpointItem.Protocols.Protocols {
+							if strings.ToLower(p) == "https" {
+								schma = strings.ToLower(p)
+								break
+							}
 						}
 					}
+					endpoint = endpointItem.Endpoint
+				} else {
+					endpoint = fmt.Sprintf("oss-%s.aliyuncs.com", client.RegionId)
 				}
-				endpoint = endpointItem.Endpoint
-			} else {
-				endpoint = fmt.Sprintf("oss-%s.aliyuncs.com", client.RegionId)
 			}
-		}
-		if !strings.HasPrefix(endpoint, "http") {
-			endpoint = fmt.Sprintf("%s://%s", schma, endpoint)
-		}
+			if !strings.HasPrefix(endpoint, "http") {
+				endpoint = fmt.Sprintf("%s://%s", schma, endpoint)
+			}
 
-		clientOptions := []oss.ClientOption{oss.UserAgent(client.getUserAgent()),
-			oss.SecurityToken(client.config.SecurityToken)}
-		proxyUrl := client.getHttpProxyUrl()
-		if proxyUrl != nil {
-			clientOptions = append(clientOptions, oss.Proxy(proxyUrl.String()))
-		}
+			clientOptions := []oss.ClientOption{oss.UserAgent(client.getUserAgent()),
+				oss.SecurityToken(credential.SecurityToken)}
+			proxyUrl := client.getHttpProxyUrl()
+			if proxyUrl != nil {
+				clientOptions = append(clientOptions, oss.Proxy(proxyUrl.String()))
+			}
+			if client.config.Recorder != nil {
+				clientOptions = append(clientOptions, oss.HTTPClient(&http.Client{Transport: client.getTransport(OSSCode)}))
+			}
 
-		ossconn, err := oss.New(endpoint, client.config.AccessKey, client.config.SecretKey, clientOptions...)
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the OSS client: %#v", err)
-		}
+			ossconn, err := oss.New(endpoint, credential.AccessKey, credential.SecretKey, clientOptions...)
+			if err != nil {
+				return fmt.Errorf("unable to initialize the OSS client: %#v", err)
+			}
 
-		client.ossconn = ossconn
+			client.ossconn = ossconn
+		}
+		conn = client.ossconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.ossconn)
+	client.rateLimiter.Wait(OSSCode, "")
+	return client.traceCall(OSSCode, func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AliyunClient) WithOssBucketByName(bucketName string, do func(*oss.Bucket) (interface{}, error)) (interface{}, error) {
 	return client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
-		bucket, err := client.ossconn.Bucket(bucketName)
+		bucket, err := ossClient.Bucket(bucketName)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get the bucket %s: %#v", bucketName, err)
 		}
@@ -427,613 +500,736 @@ func (client *AliyunClient) WithOssBucketByName(bucketName string, do func(*oss.
 }
 
 func (client *AliyunClient) WithDnsClient(do func(*alidns.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the DNS client if necessary
-	if client.dnsconn == nil {
-		endpoint := client.config.DnsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DNSCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(DNSCode), endpoint)
-		}
-
-		dnsconn, err := alidns.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the DNS client: %#v", err)
-		}
-		dnsconn.AppendUserAgent(Terraform, terraformVersion)
-		dnsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			dnsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+	var conn *alidns.Client
+	if err := client.initServiceClient(DNSCode, func() error {
+		// Initialize the DNS client if necessary
+		if client.credentialRenewed("dnsconn") || client.dnsconn == nil {
+			endpoint := client.config.DnsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, DNSCode)
+			}
+			dnsconn, err := alidns.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(DNSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the DNS client: %#v", err)
+			}
+			dnsconn.Domain = endpoint
+			dnsconn.AppendUserAgent(Terraform, terraformVersion)
+			dnsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(DNSCode, dnsconn)
+			if client.config.ConfigurationSource != "" {
+				dnsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.dnsconn = dnsconn
 		}
-		client.dnsconn = dnsconn
+		conn = client.dnsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.dnsconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithRamClient(do func(*ram.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the RAM client if necessary
-	if client.ramconn == nil {
-		endpoint := client.config.RamEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, RAMCode)
-		}
-		if strings.HasPrefix(endpoint, "http") {
-			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "http://"))
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(RAMCode), endpoint)
-		}
-
-		ramconn, err := ram.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the RAM client: %#v", err)
-		}
-		ramconn.AppendUserAgent(Terraform, terraformVersion)
-		ramconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			ramconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+	var conn *ram.Client
+	if err := client.initServiceClient(RAMCode, func() error {
+		// Initialize the RAM client if necessary
+		if client.credentialRenewed("ramconn") || client.ramconn == nil {
+			endpoint := client.config.RamEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, RAMCode)
+			}
+			if strings.HasPrefix(endpoint, "http") {
+				endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "http://"))
+			}
+			ramconn, err := ram.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(RAMCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the RAM client: %#v", err)
+			}
+			ramconn.Domain = endpoint
+			ramconn.AppendUserAgent(Terraform, terraformVersion)
+			ramconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(RAMCode, ramconn)
+			if client.config.ConfigurationSource != "" {
+				ramconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.ramconn = ramconn
 		}
-		client.ramconn = ramconn
+		conn = client.ramconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.ramconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithCsClient(do func(*cs.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the CS client if necessary
-	if client.csconn == nil {
-		csconn := cs.NewClientForAussumeRole(client.config.AccessKey, client.config.SecretKey, client.config.SecurityToken)
-		csconn.SetUserAgent(client.getUserAgent())
-		endpoint := client.config.CsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CONTAINCode)
-		}
-		if endpoint != "" {
-			if !strings.HasPrefix(endpoint, "http") {
-				endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://"))
+	var conn *cs.Client
+	if err := client.initServiceClient(CONTAINCode, func() error {
+		// Initialize the CS client if necessary
+		if client.credentialRenewed("csconn") || client.csconn == nil {
+			credential := client.config.currentCredential()
+			csconn := cs.NewClientForAussumeRole(credential.AccessKey, credential.SecretKey, credential.SecurityToken)
+			csconn.SetUserAgent(client.getUserAgent())
+			endpoint := client.config.CsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, CONTAINCode)
+			}
+			if endpoint != "" {
+				if !strings.HasPrefix(endpoint, "http") {
+					endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://"))
+				}
+				csconn.SetEndpoint(endpoint)
 			}
-			csconn.SetEndpoint(endpoint)
+			client.csconn = csconn
 		}
-		client.csconn = csconn
+		conn = client.csconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.csconn)
+	client.rateLimiter.Wait(CONTAINCode, "")
+	return client.traceCall(CONTAINCode, func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AliyunClient) WithCrClient(do func(*cr.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the CR client if necessary
-	if client.crconn == nil {
-		endpoint := client.config.CrEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CRCode)
+	var conn *cr.Client
+	if err := client.initServiceClient(CRCode, func() error {
+		// Initialize the CR client if necessary
+		if client.credentialRenewed("crconn") || client.crconn == nil {
+			endpoint := client.config.CrEndpoint
 			if endpoint == "" {
-				endpoint = fmt.Sprintf("cr.%s.aliyuncs.com", client.config.RegionId)
+				endpoint = loadEndpoint(client.config.RegionId, CRCode)
+				if endpoint == "" {
+					endpoint = fmt.Sprintf("cr.%s.aliyuncs.com", client.config.RegionId)
+				}
 			}
+			crconn, err := cr.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CRCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the CR client: %#v", err)
+			}
+			crconn.Domain = endpoint
+			crconn.AppendUserAgent(Terraform, terraformVersion)
+			crconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(CRCode, crconn)
+			if client.config.ConfigurationSource != "" {
+				crconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.crconn = crconn
 		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(CRCode), endpoint)
-		}
-		crconn, err := cr.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the CR client: %#v", err)
-		}
-		crconn.AppendUserAgent(Terraform, terraformVersion)
-		crconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			crconn.AppendUserAgent(Module, client.config.ConfigurationSource)
-		}
-		client.crconn = crconn
+		conn = client.crconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.crconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithCdnClient(do func(*cdn.CdnClient) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the CDN client if necessary
-	if client.cdnconn == nil {
-		cdnconn := cdn.NewClient(client.config.AccessKey, client.config.SecretKey)
-		cdnconn.SetBusinessInfo(businessInfoKey)
-		cdnconn.SetUserAgent(client.getUserAgent())
-		cdnconn.SetSecurityToken(client.config.SecurityToken)
-		endpoint := client.config.CdnEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CDNCode)
-		}
-		if endpoint != "" && !strings.HasPrefix(endpoint, "http") {
-			cdnconn.SetEndpoint(fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://")))
+	var conn *cdn.CdnClient
+	if err := client.initServiceClient(CDNCode, func() error {
+		// Initialize the CDN client if necessary
+		if client.credentialRenewed("cdnconn") || client.cdnconn == nil {
+			credential := client.config.currentCredential()
+			cdnconn := cdn.NewClient(credential.AccessKey, credential.SecretKey)
+			cdnconn.SetBusinessInfo(businessInfoKey)
+			cdnconn.SetUserAgent(client.getUserAgent())
+			cdnconn.SetSecurityToken(credential.SecurityToken)
+			endpoint := client.config.CdnEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, CDNCode)
+			}
+			if endpoint != "" && !strings.HasPrefix(endpoint, "http") {
+				cdnconn.SetEndpoint(fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://")))
+			}
+			client.cdnconn = cdnconn
 		}
-		client.cdnconn = cdnconn
+		conn = client.cdnconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	return do(client.cdnconn)
+
+	client.rateLimiter.Wait(CDNCode, "")
+	return client.traceCall(CDNCode, func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AliyunClient) WithCdnClient_new(do func(*cdn_new.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the CDN client if necessary
-	if client.cdnconn_new == nil {
-		endpoint := client.config.CdnEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CDNCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(CDNCode), endpoint)
-		}
-		cdnconn, err := cdn_new.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the CDN client: %#v", err)
-		}
+	var conn *cdn_new.Client
+	if err := client.initServiceClient(CDNCode, func() error {
+		// Initialize the CDN client if necessary
+		if client.credentialRenewed("cdnconn_new") || client.cdnconn_new == nil {
+			endpoint := client.config.CdnEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, CDNCode)
+			}
+			cdnconn, err := cdn_new.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CDNCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the CDN client: %#v", err)
+			}
+			cdnconn.Domain = endpoint
 
-		cdnconn.AppendUserAgent(Terraform, terraformVersion)
-		cdnconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			cdnconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			cdnconn.AppendUserAgent(Terraform, terraformVersion)
+			cdnconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(CDNCode, cdnconn)
+			if client.config.ConfigurationSource != "" {
+				cdnconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.cdnconn_new = cdnconn
 		}
-		client.cdnconn_new = cdnconn
+		conn = client.cdnconn_new
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.cdnconn_new)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithKmsClient(do func(*kms.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the KMS client if necessary
-	if client.kmsconn == nil {
+	var conn *kms.Client
+	if err := client.initServiceClient(KMSCode, func() error {
+		// Initialize the KMS client if necessary
+		if client.credentialRenewed("kmsconn") || client.kmsconn == nil {
 
-		endpoint := client.config.KmsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, KMSCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(KMSCode), endpoint)
-		}
-		kmsconn, err := kms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the kms client: %#v", err)
-		}
-		kmsconn.AppendUserAgent(Terraform, terraformVersion)
-		kmsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			kmsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			endpoint := client.config.KmsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, KMSCode)
+			}
+			kmsconn, err := kms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(KMSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the kms client: %#v", err)
+			}
+			kmsconn.Domain = endpoint
+			kmsconn.AppendUserAgent(Terraform, terraformVersion)
+			kmsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(KMSCode, kmsconn)
+			if client.config.ConfigurationSource != "" {
+				kmsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.kmsconn = kmsconn
 		}
-		client.kmsconn = kmsconn
+		conn = client.kmsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	return do(client.kmsconn)
+
+	return do(conn)
 }
 
 func (client *AliyunClient) WithOtsClient(do func(*ots.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the OTS client if necessary
-	if client.otsconn == nil {
-		endpoint := client.config.OtsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, OTSCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(OTSCode), endpoint)
-		}
-		otsconn, err := ots.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the OTS client: %#v", err)
-		}
+	var conn *ots.Client
+	if err := client.initServiceClient(OTSCode, func() error {
+		// Initialize the OTS client if necessary
+		if client.credentialRenewed("otsconn") || client.otsconn == nil {
+			endpoint := client.config.OtsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, OTSCode)
+			}
+			otsconn, err := ots.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(OTSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the OTS client: %#v", err)
+			}
+			otsconn.Domain = endpoint
 
-		otsconn.AppendUserAgent(Terraform, terraformVersion)
-		otsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			otsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			otsconn.AppendUserAgent(Terraform, terraformVersion)
+			otsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(OTSCode, otsconn)
+			if client.config.ConfigurationSource != "" {
+				otsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.otsconn = otsconn
 		}
-		client.otsconn = otsconn
+		conn = client.otsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.otsconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithCmsClient(do func(*cms.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the CMS client if necessary
-	if client.cmsconn == nil {
-		cmsconn, err := cms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(false))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the CMS client: %#v", err)
-		}
+	var conn *cms.Client
+	if err := client.initServiceClient(CMSCode, func() error {
+		// Initialize the CMS client if necessary
+		if client.credentialRenewed("cmsconn") || client.cmsconn == nil {
+			endpoint := client.config.CmsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, CMSCode)
+			}
+			cmsconn, err := cms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CMSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the CMS client: %#v", err)
+			}
+			cmsconn.Domain = endpoint
 
-		cmsconn.AppendUserAgent(Terraform, terraformVersion)
-		cmsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			cmsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			cmsconn.AppendUserAgent(Terraform, terraformVersion)
+			cmsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(CMSCode, cmsconn)
+			if client.config.ConfigurationSource != "" {
+				cmsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.cmsconn = cmsconn
 		}
-		client.cmsconn = cmsconn
+		conn = client.cmsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.cmsconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithPvtzClient(do func(*pvtz.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the PVTZ client if necessary
-	if client.pvtzconn == nil {
-		endpoint := client.config.PvtzEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, PVTZCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(PVTZCode), endpoint)
-		} else {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(PVTZCode), "pvtz.aliyuncs.com")
-		}
-		pvtzconn, err := pvtz.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the PVTZ client: %#v", err)
-		}
+	var conn *pvtz.Client
+	if err := client.initServiceClient(PVTZCode, func() error {
+		// Initialize the PVTZ client if necessary
+		if client.credentialRenewed("pvtzconn") || client.pvtzconn == nil {
+			endpoint := client.config.PvtzEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, PVTZCode)
+			}
+			if endpoint == "" {
+				endpoint = "pvtz.aliyuncs.com"
+			}
+			pvtzconn, err := pvtz.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(PVTZCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the PVTZ client: %#v", err)
+			}
+			pvtzconn.Domain = endpoint
 
-		pvtzconn.AppendUserAgent(Terraform, terraformVersion)
-		pvtzconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			pvtzconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			pvtzconn.AppendUserAgent(Terraform, terraformVersion)
+			pvtzconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(PVTZCode, pvtzconn)
+			if client.config.ConfigurationSource != "" {
+				pvtzconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.pvtzconn = pvtzconn
 		}
-		client.pvtzconn = pvtzconn
+		conn = client.pvtzconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.pvtzconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithStsClient(do func(*sts.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the STS client if necessary
-	if client.stsconn == nil {
-		endpoint := client.config.StsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, STSCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(STSCode), endpoint)
-		}
-		stsconn, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the STS client: %#v", err)
-		}
+	var conn *sts.Client
+	if err := client.initServiceClient(STSCode, func() error {
+		// Initialize the STS client if necessary
+		if client.credentialRenewed("stsconn") || client.stsconn == nil {
+			endpoint := client.config.StsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, STSCode)
+			}
+			stsconn, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(STSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the STS client: %#v", err)
+			}
+			stsconn.Domain = endpoint
 
-		stsconn.AppendUserAgent(Terraform, terraformVersion)
-		stsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			stsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			stsconn.AppendUserAgent(Terraform, terraformVersion)
+			stsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(STSCode, stsconn)
+			if client.config.ConfigurationSource != "" {
+				stsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.stsconn = stsconn
 		}
-		client.stsconn = stsconn
+		conn = client.stsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.stsconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the LOG client if necessary
-	if client.logconn == nil {
-		endpoint := client.config.LogEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, LOGCode)
+	var conn *sls.Client
+	if err := client.initServiceClient(LOGCode, func() error {
+		// Initialize the LOG client if necessary
+		if client.credentialRenewed("logconn") || client.logconn == nil {
+			credential := client.config.currentCredential()
+			endpoint := client.config.LogEndpoint
 			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.log.aliyuncs.com", client.config.RegionId)
+				endpoint = loadEndpoint(client.config.RegionId, LOGCode)
+				if endpoint == "" {
+					endpoint = fmt.Sprintf("%s.log.aliyuncs.com", client.config.RegionId)
+				}
+			}
+			if !strings.HasPrefix(endpoint, "http") {
+				endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://"))
+			}
+			// The Log Service client always sends the requests by the default HTTP client.
+			if client.config.Recorder != nil {
+				client.config.Recorder.interceptDefaultTransport()
+			}
+			client.logconn = &sls.Client{
+				AccessKeyID:     credential.AccessKey,
+				AccessKeySecret: credential.SecretKey,
+				Endpoint:        endpoint,
+				SecurityToken:   credential.SecurityToken,
+				UserAgent:       client.getUserAgent(),
 			}
 		}
-		if !strings.HasPrefix(endpoint, "http") {
-			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://"))
-		}
-		client.logconn = &sls.Client{
-			AccessKeyID:     client.config.AccessKey,
-			AccessKeySecret: client.config.SecretKey,
-			Endpoint:        endpoint,
-			SecurityToken:   client.config.SecurityToken,
-			UserAgent:       client.getUserAgent(),
-		}
+		conn = client.logconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.logconn)
+	client.rateLimiter.Wait(LOGCode, "")
+	return client.traceCall(LOGCode, func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AliyunClient) WithDrdsClient(do func(*drds.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the DRDS client if necessary
-	if client.drdsconn == nil {
-		endpoint := client.config.DrdsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DRDSCode)
+	var conn *drds.Client
+	if err := client.initServiceClient(DRDSCode, func() error {
+		// Initialize the DRDS client if necessary
+		if client.credentialRenewed("drdsconn") || client.drdsconn == nil {
+			endpoint := client.config.DrdsEndpoint
 			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.drds.aliyuncs.com", client.config.RegionId)
+				endpoint = loadEndpoint(client.config.RegionId, DRDSCode)
+				if endpoint == "" {
+					endpoint = fmt.Sprintf("%s.drds.aliyuncs.com", client.config.RegionId)
+				}
 			}
-		}
 
-		drdsconn, err := drds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the DRDS client: %#v", err)
+			drdsconn, err := drds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(DRDSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the DRDS client: %#v", err)
 
-		}
+			}
 
-		drdsconn.AppendUserAgent(Terraform, terraformVersion)
-		drdsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			drdsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			drdsconn.AppendUserAgent(Terraform, terraformVersion)
+			drdsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(DRDSCode, drdsconn)
+			if client.config.ConfigurationSource != "" {
+				drdsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.drdsconn = drdsconn
 		}
-		client.drdsconn = drdsconn
+		conn = client.drdsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.drdsconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithDdsClient(do func(*dds.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the DDS client if necessary
-	if client.ddsconn == nil {
-		endpoint := client.config.DdsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DDSCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(DDSCode), endpoint)
-		}
-		ddsconn, err := dds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the DDS client: %#v", err)
-		}
+	var conn *dds.Client
+	if err := client.initServiceClient(DDSCode, func() error {
+		// Initialize the DDS client if necessary
+		if client.credentialRenewed("ddsconn") || client.ddsconn == nil {
+			endpoint := client.config.DdsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, DDSCode)
+			}
+			ddsconn, err := dds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(DDSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the DDS client: %#v", err)
+			}
+			ddsconn.Domain = endpoint
 
-		ddsconn.AppendUserAgent(Terraform, terraformVersion)
-		ddsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			ddsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			ddsconn.AppendUserAgent(Terraform, terraformVersion)
+			ddsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(DDSCode, ddsconn)
+			if client.config.ConfigurationSource != "" {
+				ddsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.ddsconn = ddsconn
 		}
-		client.ddsconn = ddsconn
+		conn = client.ddsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.ddsconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the GPDB client if necessary
-	if client.gpdbconn == nil {
-		endpoint := client.config.GpdbEnpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, GPDBCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(GPDBCode), endpoint)
-		}
-		gpdbconn, err := gpdb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the GPDB client: %#v", err)
-		}
+	var conn *gpdb.Client
+	if err := client.initServiceClient(GPDBCode, func() error {
+		// Initialize the GPDB client if necessary
+		if client.credentialRenewed("gpdbconn") || client.gpdbconn == nil {
+			endpoint := client.config.GpdbEnpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, GPDBCode)
+			}
+			gpdbconn, err := gpdb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(GPDBCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the GPDB client: %#v", err)
+			}
+			gpdbconn.Domain = endpoint
 
-		gpdbconn.AppendUserAgent(Terraform, terraformVersion)
-		gpdbconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			gpdbconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			gpdbconn.AppendUserAgent(Terraform, terraformVersion)
+			gpdbconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(GPDBCode, gpdbconn)
+			if client.config.ConfigurationSource != "" {
+				gpdbconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.gpdbconn = gpdbconn
 		}
-		client.gpdbconn = gpdbconn
+		conn = client.gpdbconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.gpdbconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithRkvClient(do func(*r_kvstore.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the RKV client if necessary
-	if client.rkvconn == nil {
-		endpoint := client.config.KVStoreEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, KVSTORECode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, fmt.Sprintf("R-%s", string(KVSTORECode)), endpoint)
-		}
-		rkvconn, err := r_kvstore.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the RKV client: %#v", err)
-		}
+	var conn *r_kvstore.Client
+	if err := client.initServiceClient(KVSTORECode, func() error {
+		// Initialize the RKV client if necessary
+		if client.credentialRenewed("rkvconn") || client.rkvconn == nil {
+			endpoint := client.config.KVStoreEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, KVSTORECode)
+			}
+			rkvconn, err := r_kvstore.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(KVSTORECode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the RKV client: %#v", err)
+			}
+			rkvconn.Domain = endpoint
 
-		rkvconn.AppendUserAgent(Terraform, terraformVersion)
-		rkvconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			rkvconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			rkvconn.AppendUserAgent(Terraform, terraformVersion)
+			rkvconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(KVSTORECode, rkvconn)
+			if client.config.ConfigurationSource != "" {
+				rkvconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.rkvconn = rkvconn
 		}
-		client.rkvconn = rkvconn
+		conn = client.rkvconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.rkvconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the FC client if necessary
-	if client.fcconn == nil {
-		endpoint := client.config.FcEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, FCCode)
+	var conn *fc.Client
+	if err := client.initServiceClient(FCCode, func() error {
+		// Initialize the FC client if necessary
+		if client.credentialRenewed("fcconn") || client.fcconn == nil {
+			credential := client.config.currentCredential()
+			endpoint := client.config.FcEndpoint
 			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.fc.aliyuncs.com", client.config.RegionId)
+				endpoint = loadEndpoint(client.config.RegionId, FCCode)
+				if endpoint == "" {
+					endpoint = fmt.Sprintf("%s.fc.aliyuncs.com", client.config.RegionId)
+				}
+			}
+			if strings.HasPrefix(endpoint, "http") {
+				endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
+			}
+			accountId, err := client.AccountId()
+			if err != nil {
+				return err
 			}
-		}
-		if strings.HasPrefix(endpoint, "http") {
-			endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
-		}
-		accountId, err := client.AccountId()
-		if err != nil {
-			return nil, err
-		}
 
-		config := client.getSdkConfig()
-		clientOptions := []fc.ClientOption{fc.WithSecurityToken(client.config.SecurityToken), fc.WithTransport(config.HttpTransport),
-			fc.WithTimeout(30), fc.WithRetryCount(DefaultClientRetryCountSmall)}
-		fcconn, err := fc.NewClient(fmt.Sprintf("https://%s.%s", accountId, endpoint), string(ApiVersion20160815), client.config.AccessKey, client.config.SecretKey, clientOptions...)
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the FC client: %#v", err)
-		}
+			config := client.getSdkConfig(FCCode)
+			clientOptions := []fc.ClientOption{fc.WithSecurityToken(credential.SecurityToken), fc.WithTransport(config.HttpTransport),
+				fc.WithTimeout(30), fc.WithRetryCount(DefaultClientRetryCountSmall)}
+			fcconn, err := fc.NewClient(fmt.Sprintf("https://%s.%s", accountId, endpoint), string(ApiVersion20160815), credential.AccessKey, credential.SecretKey, clientOptions...)
+			if err != nil {
+				return fmt.Errorf("unable to initialize the FC client: %#v", err)
+			}
 
-		fcconn.Config.UserAgent = client.getUserAgent()
-		fcconn.Config.SecurityToken = client.config.SecurityToken
-		client.fcconn = fcconn
+			fcconn.Config.UserAgent = client.getUserAgent()
+			fcconn.Config.SecurityToken = credential.SecurityToken
+			client.fcconn = fcconn
+		}
+		conn = client.fcconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.fcconn)
+	return client.traceCall(FCCode, func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AliyunClient) WithCloudApiClient(do func(*cloudapi.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the Cloud API client if necessary
-	if client.cloudapiconn == nil {
-		endpoint := client.config.ApigatewayEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.RegionId, CLOUDAPICode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.RegionId, "CLOUDAPI", endpoint)
-		}
-		cloudapiconn, err := cloudapi.NewClientWithOptions(client.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the CloudAPI client: %#v", err)
-		}
+	var conn *cloudapi.Client
+	if err := client.initServiceClient(CLOUDAPICode, func() error {
+		// Initialize the Cloud API client if necessary
+		if client.credentialRenewed("cloudapiconn") || client.cloudapiconn == nil {
+			endpoint := client.config.ApigatewayEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.RegionId, CLOUDAPICode)
+			}
+			cloudapiconn, err := cloudapi.NewClientWithOptions(client.RegionId, client.getSdkConfig(CLOUDAPICode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the CloudAPI client: %#v", err)
+			}
+			cloudapiconn.Domain = endpoint
 
-		cloudapiconn.AppendUserAgent(Terraform, terraformVersion)
-		cloudapiconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			cloudapiconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			cloudapiconn.AppendUserAgent(Terraform, terraformVersion)
+			cloudapiconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(CLOUDAPICode, cloudapiconn)
+			if client.config.ConfigurationSource != "" {
+				cloudapiconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.cloudapiconn = cloudapiconn
 		}
-		client.cloudapiconn = cloudapiconn
+		conn = client.cloudapiconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.cloudapiconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithDataHubClient(do func(*datahub.DataHub) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the DataHub client if necessary
-	if client.dhconn == nil {
-		endpoint := client.config.DatahubEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.RegionId, DATAHUBCode)
-		}
-		if endpoint == "" {
-			if client.RegionId == string(APSouthEast1) {
-				endpoint = "dh-singapore.aliyuncs.com"
-			} else {
-				endpoint = fmt.Sprintf("dh-%s.aliyuncs.com", client.RegionId)
+	var conn *datahub.DataHub
+	if err := client.initServiceClient(DATAHUBCode, func() error {
+		// Initialize the DataHub client if necessary
+		if client.credentialRenewed("dhconn") || client.dhconn == nil {
+			credential := client.config.currentCredential()
+			endpoint := client.config.DatahubEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.RegionId, DATAHUBCode)
+			}
+			if endpoint == "" {
+				if client.RegionId == string(APSouthEast1) {
+					endpoint = "dh-singapore.aliyuncs.com"
+				} else {
+					endpoint = fmt.Sprintf("dh-%s.aliyuncs.com", client.RegionId)
+				}
+			}
+			if !strings.HasPrefix(endpoint, "http") {
+				endpoint = fmt.Sprintf("https://%s", endpoint)
 			}
-		}
-		if !strings.HasPrefix(endpoint, "http") {
-			endpoint = fmt.Sprintf("https://%s", endpoint)
-		}
 
-		account := datahub.NewStsCredential(client.config.AccessKey, client.config.SecretKey, client.config.SecurityToken)
-		config := &datahub.Config{
-			UserAgent: client.getUserAgent(),
-		}
+			account := datahub.NewStsCredential(credential.AccessKey, credential.SecretKey, credential.SecurityToken)
+			config := &datahub.Config{
+				UserAgent: client.getUserAgent(),
+			}
 
-		client.dhconn = datahub.NewClientWithConfig(endpoint, config, account)
+			client.dhconn = datahub.NewClientWithConfig(endpoint, config, account)
+		}
+		conn = client.dhconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.dhconn)
+	client.rateLimiter.Wait(DATAHUBCode, "")
+	return client.traceCall(DATAHUBCode, func() (interface{}, error) {
+		return do(conn)
+	})
+}
+
+// mnsStsClient sends the security token of an STS credential with every MNS request, which the MNS client does not support.
+type mnsStsClient struct {
+	ali_mns.MNSClient
+	securityToken string
+}
+
+func (c *mnsStsClient) Send(method ali_mns.Method, headers map[string]string, message interface{}, resource string) (*fasthttp.Response, error) {
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+	headers["security-token"] = c.securityToken
+	return c.MNSClient.Send(method, headers, message, resource)
 }
 
 func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the MNS client if necessary
-	if client.mnsconn == nil {
-		endpoint := client.config.MnsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, MNSCode)
+	var conn *ali_mns.MNSClient
+	if err := client.initServiceClient(MNSCode, func() error {
+		// Initialize the MNS client if necessary
+		if client.credentialRenewed("mnsconn") || client.mnsconn == nil {
+			credential := client.config.currentCredential()
+			endpoint := client.config.MnsEndpoint
 			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.aliyuncs.com", client.config.RegionId)
+				endpoint = loadEndpoint(client.config.RegionId, MNSCode)
+				if endpoint == "" {
+					endpoint = fmt.Sprintf("%s.aliyuncs.com", client.config.RegionId)
+				}
 			}
-		}
 
-		accountId, err := client.AccountId()
-		if err != nil {
-			return nil, err
-		}
-		if strings.HasPrefix(endpoint, "http") {
-			endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
-		}
-		mnsUrl := fmt.Sprintf("https://%s.mns.%s", accountId, endpoint)
+			accountId, err := client.AccountId()
+			if err != nil {
+				return err
+			}
+			if strings.HasPrefix(endpoint, "http") {
+				endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
+			}
+			mnsUrl := fmt.Sprintf("https://%s.mns.%s", accountId, endpoint)
 
-		mnsClient := ali_mns.NewAliMNSClient(mnsUrl, client.config.AccessKey, client.config.SecretKey)
+			mnsClient := ali_mns.NewAliMNSClient(mnsUrl, credential.AccessKey, credential.SecretKey)
+			if credential.SecurityToken != "" {
+				mnsClient = &mnsStsClient{MNSClient: mnsClient, securityToken: credential.SecurityToken}
+			}
 
-		client.mnsconn = &mnsClient
+			client.mnsconn = &mnsClient
+		}
+		conn = client.mnsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.mnsconn)
+	client.rateLimiter.Wait(MNSCode, "")
+	return client.traceCall(MNSCode, func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AliyunClient) WithElasticsearchClient(do func(*elasticsearch.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the Elasticsearch client if necessary
-	if client.elasticsearchconn == nil {
-		endpoint := client.config.ElasticsearchEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ELASTICSEARCHCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(ELASTICSEARCHCode), endpoint)
-		}
-		elasticsearchconn, err := elasticsearch.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the Elasticsearch client: %#v", err)
-		}
+	var conn *elasticsearch.Client
+	if err := client.initServiceClient(ELASTICSEARCHCode, func() error {
+		// Initialize the Elasticsearch client if necessary
+		if client.credentialRenewed("elasticsearchconn") || client.elasticsearchconn == nil {
+			endpoint := client.config.ElasticsearchEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, ELASTICSEARCHCode)
+			}
+			elasticsearchconn, err := elasticsearch.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ELASTICSEARCHCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the Elasticsearch client: %#v", err)
+			}
+			elasticsearchconn.Domain = endpoint
 
-		elasticsearchconn.AppendUserAgent(Terraform, terraformVersion)
-		elasticsearchconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			elasticsearchconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			elasticsearchconn.AppendUserAgent(Terraform, terraformVersion)
+			elasticsearchconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(ELASTICSEARCHCode, elasticsearchconn)
+			if client.config.ConfigurationSource != "" {
+				elasticsearchconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.elasticsearchconn = elasticsearchconn
 		}
-		client.elasticsearchconn = elasticsearchconn
+		conn = client.elasticsearchconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.elasticsearchconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithMnsQueueManager(do func(ali_mns.AliQueueManager) (interface{}, error)) (interface{}, error) {
@@ -1058,49 +1254,71 @@ func (client *AliyunClient) WithMnsSubscriptionManagerByTopicName(topicName stri
 }
 
 func (client *AliyunClient) WithTableStoreClient(instanceName string, do func(*tablestore.TableStoreClient) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
+	var tableStoreClient *tablestore.TableStoreClient
+	if err := client.initServiceClient(OTSCode, func() error {
+		// Initialize the TABLESTORE client if necessary
+		if client.credentialRenewed("tablestoreconn") {
+			client.tablestoreconnByInstanceName = make(map[string]*tablestore.TableStoreClient)
+		}
+		var ok bool
+		tableStoreClient, ok = client.tablestoreconnByInstanceName[instanceName]
+		if !ok {
+			credential := client.config.currentCredential()
+			endpoint := client.config.OtsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.RegionId, OTSCode)
+			}
+			if endpoint == "" {
+				endpoint = fmt.Sprintf("%s.%s.ots.aliyuncs.com", instanceName, client.RegionId)
+			}
+			if !strings.HasPrefix(endpoint, "https") && !strings.HasPrefix(endpoint, "http") {
+				endpoint = fmt.Sprintf("https://%s", endpoint)
+			}
 
-	// Initialize the TABLESTORE client if necessary
-	tableStoreClient, ok := client.tablestoreconnByInstanceName[instanceName]
-	if !ok {
-		endpoint := client.config.OtsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.RegionId, OTSCode)
-		}
-		if endpoint == "" {
-			endpoint = fmt.Sprintf("%s.%s.ots.aliyuncs.com", instanceName, client.RegionId)
-		}
-		if !strings.HasPrefix(endpoint, "https") && !strings.HasPrefix(endpoint, "http") {
-			endpoint = fmt.Sprintf("https://%s", endpoint)
+			tableStoreConfig := tablestore.NewDefaultTableStoreConfig()
+			if client.config.Recorder != nil {
+				tableStoreConfig.Transport = client.getTransport(OTSCode)
+			}
+			tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, credential.AccessKey, credential.SecretKey, credential.SecurityToken, tableStoreConfig)
+			client.tablestoreconnByInstanceName[instanceName] = tableStoreClient
 		}
-
-		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, client.config.AccessKey, client.config.SecretKey, client.config.SecurityToken, tablestore.NewDefaultTableStoreConfig())
-		client.tablestoreconnByInstanceName[instanceName] = tableStoreClient
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(tableStoreClient)
+	client.rateLimiter.Wait(OTSCode, "")
+	return client.traceCall(OTSCode, func() (interface{}, error) {
+		return do(tableStoreClient)
+	})
 }
 
 func (client *AliyunClient) WithCsProjectClient(clusterId, endpoint string, clusterCerts cs.ClusterCerts, do func(*cs.ProjectClient) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the PROJECT client if necessary
-	key := fmt.Sprintf("%s|%s|%s|%s|%s", clusterId, endpoint, clusterCerts.CA, clusterCerts.Cert, clusterCerts.Key)
-	csProjectClient, ok := client.csprojectconnByKey[key]
-	if !ok {
-		var err error
-		csProjectClient, err = cs.NewProjectClient(clusterId, endpoint, clusterCerts)
-		if err != nil {
-			return nil, fmt.Errorf("Getting Application Client failed by cluster id %s: %#v.", clusterCerts, err)
+	var csProjectClient *cs.ProjectClient
+	if err := client.initServiceClient(CONTAINCode, func() error {
+		// Initialize the PROJECT client if necessary
+		key := fmt.Sprintf("%s|%s|%s|%s|%s", clusterId, endpoint, clusterCerts.CA, clusterCerts.Cert, clusterCerts.Key)
+		var ok bool
+		csProjectClient, ok = client.csprojectconnByKey[key]
+		if !ok {
+			var err error
+			csProjectClient, err = cs.NewProjectClient(clusterId, endpoint, clusterCerts)
+			if err != nil {
+				return fmt.Errorf("Getting Application Client failed by cluster id %s: %#v.", clusterCerts, err)
+			}
+			csProjectClient.SetDebug(false)
+			csProjectClient.SetUserAgent(client.getUserAgent())
+			client.csprojectconnByKey[key] = csProjectClient
 		}
-		csProjectClient.SetDebug(false)
-		csProjectClient.SetUserAgent(client.getUserAgent())
-		client.csprojectconnByKey[key] = csProjectClient
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(csProjectClient)
+	client.rateLimiter.Wait(CONTAINCode, "")
+	return client.traceCall(CONTAINCode, func() (interface{}, error) {
+		return do(csProjectClient)
+	})
 }
 
 func (client *AliyunClient) NewCommonRequest(product, serviceCode, schema string, apiVersion ApiVersion) (*requests.CommonRequest, error) {
@@ -1152,13 +1370,13 @@ func (client *AliyunClient) AccountId() (string, error) {
 	return client.accountId, nil
 }
 
-func (client *AliyunClient) getSdkConfig() *sdk.Config {
+func (client *AliyunClient) getSdkConfig(serviceCode ServiceCode) *sdk.Config {
 	return sdk.NewConfig().
 		WithMaxRetryTime(DefaultClientRetryCountSmall).
 		WithTimeout(time.Duration(30) * time.Second).
 		WithGoRoutinePoolSize(10).
 		WithDebug(false).
-		WithHttpTransport(client.getTransport()).
+		WithHttpTransport(client.getTransport(serviceCode)).
 		WithScheme("HTTPS")
 }
 
@@ -1169,7 +1387,7 @@ func (client *AliyunClient) getUserAgent() string {
 	return fmt.Sprintf("%s/%s %s/%s", Terraform, terraformVersion, Provider, providerVersion)
 }
 
-func (client *AliyunClient) getTransport() *http.Transport {
+func (client *AliyunClient) getTransport(serviceCode ServiceCode) *http.Transport {
 	handshakeTimeout, err := strconv.Atoi(os.Getenv("TLSHandshakeTimeout"))
 	if err != nil {
 		handshakeTimeout = 120
@@ -1182,6 +1400,24 @@ func (client *AliyunClient) getTransport() *http.Transport {
 	if proxyUrl != nil {
 		transport.Proxy = http.ProxyURL(proxyUrl)
 	}
+	if client.rateLimiter.limited(serviceCode) {
+		// The SDK sets the connect timeout on the transport it holds, which is the wrapper, so the wrapped one gets the default
+		transport.DialContext = sdk.Timeout(5 * time.Second)
+		transport = roundTripperTransport(&rateLimitedRoundTripper{limiter: client.rateLimiter, serviceCode: serviceCode, next: transport})
+	}
+	if client.config.Recorder != nil {
+		return client.config.Recorder.recordedHttpTransport(transport)
+	}
+	return transport
+}
+
+// roundTripperTransport returns a transport which sends the requests through the round tripper. The SDK clients only
+// accept a *http.Transport, so the round tripper is registered as the one of the http and https protocols.
+// A non-nil TLSNextProto stops it registering HTTP/2 for the https protocol.
+func roundTripperTransport(roundTripper http.RoundTripper) *http.Transport {
+	transport := &http.Transport{TLSNextProto: make(map[string]func(string, *tls.Conn) http.RoundTripper)}
+	transport.RegisterProtocol("https", roundTripper)
+	transport.RegisterProtocol("http", roundTripper)
 	return transport
 }
 
@@ -1214,13 +1450,14 @@ func (client *AliyunClient) describeEndpointForService(serviceCode string) (*loc
 		args.Domain = "location-readonly.aliyuncs.com"
 	}
 
-	locationClient, err := location.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	locationClient, err := location.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(LOCATIONCode), client.config.getAuthCredential(true))
 	if err != nil {
 		return nil, fmt.Errorf("Unable to initialize the location client: %#v", err)
 
 	}
 	locationClient.AppendUserAgent(Terraform, terraformVersion)
 	locationClient.AppendUserAgent(Provider, providerVersion)
+	client.traceSdkClient(LOCATIONCode, locationClient)
 	if client.config.ConfigurationSource != "" {
 		locationClient.AppendUserAgent(Module, client.config.ConfigurationSource)
 	}
@@ -1245,16 +1482,15 @@ func (client *AliyunClient) getCallerIdentity() (*sts.GetCallerIdentityResponse,
 	if endpoint == "" {
 		endpoint = loadEndpoint(client.config.RegionId, STSCode)
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, string(STSCode), endpoint)
-	}
-	stsClient, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	stsClient, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(STSCode), client.config.getAuthCredential(true))
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the STS client: %#v", err)
 	}
+	stsClient.Domain = endpoint
 
 	stsClient.AppendUserAgent(Terraform, terraformVersion)
 	stsClient.AppendUserAgent(Provider, providerVersion)
+	client.traceSdkClient(STSCode, stsClient)
 	if client.config.ConfigurationSource != "" {
 		stsClient.AppendUserAgent(Module, client.config.ConfigurationSource)
 	}
@@ -1269,231 +1505,312 @@ func (client *AliyunClient) getCallerIdentity() (*sts.GetCallerIdentityResponse,
 }
 
 func (client *AliyunClient) WithActionTrailClient(do func(*actiontrail.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-	if client.actiontrailconn == nil {
-		endpoint := client.config.ActionTrailEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ACTIONTRAILCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(ACTIONTRAILCode), endpoint)
-		}
-		actiontrailconn, err := actiontrail.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the ACTIONTRAIL client: %#v", err)
-		}
+	var conn *actiontrail.Client
+	if err := client.initServiceClient(ACTIONTRAILCode, func() error {
+		if client.credentialRenewed("actiontrailconn") || client.actiontrailconn == nil {
+			endpoint := client.config.ActionTrailEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, ACTIONTRAILCode)
+			}
+			actiontrailconn, err := actiontrail.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ACTIONTRAILCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the ACTIONTRAIL client: %#v", err)
+			}
+			actiontrailconn.Domain = endpoint
 
-		actiontrailconn.AppendUserAgent(Terraform, terraformVersion)
-		actiontrailconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			actiontrailconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			actiontrailconn.AppendUserAgent(Terraform, terraformVersion)
+			actiontrailconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(ACTIONTRAILCode, actiontrailconn)
+			if client.config.ConfigurationSource != "" {
+				actiontrailconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.actiontrailconn = actiontrailconn
 		}
-		client.actiontrailconn = actiontrailconn
+		conn = client.actiontrailconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.actiontrailconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithCasClient(do func(*cas.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the CAS client if necessary
-	if client.casconn == nil {
-		casconn, err := cas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the CAS client: %#v", err)
-		}
+	var conn *cas.Client
+	if err := client.initServiceClient(CASCode, func() error {
+		// Initialize the CAS client if necessary
+		if client.credentialRenewed("casconn") || client.casconn == nil {
+			casconn, err := cas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CASCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the CAS client: %#v", err)
+			}
 
-		casconn.AppendUserAgent(Terraform, terraformVersion)
-		casconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			casconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			casconn.AppendUserAgent(Terraform, terraformVersion)
+			casconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(CASCode, casconn)
+			if client.config.ConfigurationSource != "" {
+				casconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.casconn = casconn
 		}
-		client.casconn = casconn
+		conn = client.casconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.casconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithDdoscooClient(do func(*ddoscoo.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
+	var conn *ddoscoo.Client
+	if err := client.initServiceClient(DDOSCOOCode, func() error {
+		// Initialize the ddoscoo client if necessary
+		if client.credentialRenewed("ddoscooconn") || client.ddoscooconn == nil {
+			endpoint := client.config.DdoscooEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, DDOSCOOCode)
+			}
+			ddoscooconn, err := ddoscoo.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(DDOSCOOCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the DDOSCOO client: %#v", err)
+			}
+			ddoscooconn.Domain = endpoint
+			ddoscooconn.AppendUserAgent(Terraform, terraformVersion)
+			ddoscooconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(DDOSCOOCode, ddoscooconn)
+			if client.config.ConfigurationSource != "" {
+				ddoscooconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.ddoscooconn = ddoscooconn
 
-	// Initialize the ddoscoo client if necessary
-	if client.ddoscooconn == nil {
-		ddoscooconn, err := ddoscoo.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the DDOSCOO client: %#v", err)
-		}
-		ddoscooconn.AppendUserAgent(Terraform, terraformVersion)
-		ddoscooconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			ddoscooconn.AppendUserAgent(Module, client.config.ConfigurationSource)
 		}
-		client.ddoscooconn = ddoscooconn
-
+		conn = client.ddoscooconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.ddoscooconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithDdosbgpClient(do func(*ddosbgp.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the ddosbgp client if necessary
-	if client.ddosbgpconn == nil {
-		ddosbgpconn, err := ddosbgp.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the DDOSBGP client: %#v", err)
-		}
+	var conn *ddosbgp.Client
+	if err := client.initServiceClient(DDOSBGPCode, func() error {
+		// Initialize the ddosbgp client if necessary
+		if client.credentialRenewed("ddosbgpconn") || client.ddosbgpconn == nil {
+			endpoint := client.config.DdosbgpEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, DDOSBGPCode)
+			}
+			ddosbgpconn, err := ddosbgp.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(DDOSBGPCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the DDOSBGP client: %#v", err)
+			}
+			ddosbgpconn.Domain = endpoint
 
-		ddosbgpconn.AppendUserAgent(Terraform, terraformVersion)
-		ddosbgpconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			ddosbgpconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			ddosbgpconn.AppendUserAgent(Terraform, terraformVersion)
+			ddosbgpconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(DDOSBGPCode, ddosbgpconn)
+			if client.config.ConfigurationSource != "" {
+				ddosbgpconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.ddosbgpconn = ddosbgpconn
 		}
-		client.ddosbgpconn = ddosbgpconn
+		conn = client.ddosbgpconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.ddosbgpconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithBssopenapiClient(do func(*bssopenapi.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the bssopenapi client if necessary
-	if client.bssopenapiconn == nil {
-		endpoint := client.config.BssOpenApiEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, BSSOPENAPICode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(BSSOPENAPICode), endpoint)
+	var conn *bssopenapi.Client
+	if err := client.initServiceClient(BSSOPENAPICode, func() error {
+		// Initialize the bssopenapi client if necessary
+		if client.credentialRenewed("bssopenapiconn") || client.bssopenapiconn == nil {
+			endpoint := client.config.BssOpenApiEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, BSSOPENAPICode)
+			}
+			bssopenapiconn, err := bssopenapi.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(BSSOPENAPICode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the BSSOPENAPI client: %#v", err)
+			}
+			bssopenapiconn.Domain = endpoint
+			bssopenapiconn.AppendUserAgent(Terraform, terraformVersion)
+			bssopenapiconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(BSSOPENAPICode, bssopenapiconn)
+			if client.config.ConfigurationSource != "" {
+				bssopenapiconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.bssopenapiconn = bssopenapiconn
 		}
+		conn = client.bssopenapiconn
+		return nil
+	}); err != nil {
+		return nil, err
+	}
 
-		bssopenapiconn, err := bssopenapi.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the BSSOPENAPI client: %#v", err)
-		}
-		bssopenapiconn.AppendUserAgent(Terraform, terraformVersion)
-		bssopenapiconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			bssopenapiconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+	return do(conn)
+}
+
+// WithResourceManagerClient runs do with a common SDK client, because the Go SDK has no Resource Manager client.
+// All of the Resource Manager APIs are called by the common requests.
+func (client *AliyunClient) WithResourceManagerClient(do func(*sdk.Client) (interface{}, error)) (interface{}, error) {
+	var conn *sdk.Client
+	if err := client.initServiceClient(RESOURCEMANAGERCode, func() error {
+		// Initialize the Resource Manager client if necessary
+		if client.credentialRenewed("resourcemanagerconn") || client.resourcemanagerconn == nil {
+			endpoint := client.config.ResourceManagerEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, RESOURCEMANAGERCode)
+			}
+			if endpoint == "" {
+				endpoint = "resourcemanager.aliyuncs.com"
+			}
+			resourcemanagerconn, err := sdk.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(RESOURCEMANAGERCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the Resource Manager client: %#v", err)
+			}
+			resourcemanagerconn.Domain = endpoint
+			resourcemanagerconn.AppendUserAgent(Terraform, terraformVersion)
+			resourcemanagerconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(RESOURCEMANAGERCode, resourcemanagerconn)
+			if client.config.ConfigurationSource != "" {
+				resourcemanagerconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.resourcemanagerconn = resourcemanagerconn
 		}
-		client.bssopenapiconn = bssopenapiconn
+		conn = client.resourcemanagerconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.bssopenapiconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithOnsClient(do func(*ons.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the ons client if necessary
-	if client.onsconn == nil {
-		endpoint := client.config.OnsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ONSCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(ONSCode), endpoint)
-		}
-		onsconn, err := ons.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the ONS client: %#v", err)
-		}
-		onsconn.AppendUserAgent(Terraform, terraformVersion)
-		onsconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			onsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+	var conn *ons.Client
+	if err := client.initServiceClient(ONSCode, func() error {
+		// Initialize the ons client if necessary
+		if client.credentialRenewed("onsconn") || client.onsconn == nil {
+			endpoint := client.config.OnsEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, ONSCode)
+			}
+			onsconn, err := ons.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ONSCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the ONS client: %#v", err)
+			}
+			onsconn.Domain = endpoint
+			onsconn.AppendUserAgent(Terraform, terraformVersion)
+			onsconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(ONSCode, onsconn)
+			if client.config.ConfigurationSource != "" {
+				onsconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.onsconn = onsconn
 		}
-		client.onsconn = onsconn
+		conn = client.onsconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.onsconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithAlikafkaClient(do func(*alikafka.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the alikafka client if necessary
-	if client.alikafkaconn == nil {
-		endpoint := client.config.AlikafkaEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ALIKAFKACode)
+	var conn *alikafka.Client
+	if err := client.initServiceClient(ALIKAFKACode, func() error {
+		// Initialize the alikafka client if necessary
+		if client.credentialRenewed("alikafkaconn") || client.alikafkaconn == nil {
+			endpoint := client.config.AlikafkaEndpoint
 			if endpoint == "" {
-				endpoint = "alikafka.aliyuncs.com"
+				endpoint = loadEndpoint(client.config.RegionId, ALIKAFKACode)
+				if endpoint == "" {
+					endpoint = "alikafka.aliyuncs.com"
+				}
 			}
+			alikafkaconn, err := alikafka.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ALIKAFKACode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the ALIKAFKA client: %#v", err)
+			}
+			alikafkaconn.Domain = endpoint
+			alikafkaconn.AppendUserAgent(Terraform, terraformVersion)
+			alikafkaconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(ALIKAFKACode, alikafkaconn)
+			if client.config.ConfigurationSource != "" {
+				alikafkaconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.alikafkaconn = alikafkaconn
 		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(ALIKAFKACode), endpoint)
-		}
-		alikafkaconn, err := alikafka.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the ALIKAFKA client: %#v", err)
-		}
-		alikafkaconn.AppendUserAgent(Terraform, terraformVersion)
-		alikafkaconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			alikafkaconn.AppendUserAgent(Module, client.config.ConfigurationSource)
-		}
-		client.alikafkaconn = alikafkaconn
+		conn = client.alikafkaconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.alikafkaconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithEmrClient(do func(*emr.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	if client.emrconn == nil {
-		emrConn, err := emr.NewClientWithOptions(client.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the E-MapReduce client: %#v", err)
-		}
-		emrConn.AppendUserAgent(Terraform, terraformVersion)
-		emrConn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			emrConn.AppendUserAgent(Module, client.config.ConfigurationSource)
+	var conn *emr.Client
+	if err := client.initServiceClient(EMRCode, func() error {
+		if client.credentialRenewed("emrconn") || client.emrconn == nil {
+			emrConn, err := emr.NewClientWithOptions(client.RegionId, client.getSdkConfig(EMRCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the E-MapReduce client: %#v", err)
+			}
+			emrConn.AppendUserAgent(Terraform, terraformVersion)
+			emrConn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(EMRCode, emrConn)
+			if client.config.ConfigurationSource != "" {
+				emrConn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.emrconn = emrConn
 		}
-		client.emrconn = emrConn
+		conn = client.emrconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.emrconn)
+	return do(conn)
 }
 
 func (client *AliyunClient) WithSagClient(do func(*smartag.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
-	// Initialize the SAG client if necessary
-	if client.sagconn == nil {
-		endpoint := client.config.SagEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, SAGCode)
-		}
-		if endpoint != "" {
-			endpoints.AddEndpointMapping(client.config.RegionId, string(SAGCode), endpoint)
-		}
-		sagconn, err := smartag.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
-		if err != nil {
-			return nil, fmt.Errorf("unable to initialize the SAG client: %#v", err)
-		}
+	var conn *smartag.Client
+	if err := client.initServiceClient(SAGCode, func() error {
+		// Initialize the SAG client if necessary
+		if client.credentialRenewed("sagconn") || client.sagconn == nil {
+			endpoint := client.config.SagEndpoint
+			if endpoint == "" {
+				endpoint = loadEndpoint(client.config.RegionId, SAGCode)
+			}
+			sagconn, err := smartag.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(SAGCode), client.config.getAuthCredential(true))
+			if err != nil {
+				return fmt.Errorf("unable to initialize the SAG client: %#v", err)
+			}
+			sagconn.Domain = endpoint
 
-		sagconn.AppendUserAgent(Terraform, terraformVersion)
-		sagconn.AppendUserAgent(Provider, providerVersion)
-		if client.config.ConfigurationSource != "" {
-			sagconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			sagconn.AppendUserAgent(Terraform, terraformVersion)
+			sagconn.AppendUserAgent(Provider, providerVersion)
+			client.traceSdkClient(SAGCode, sagconn)
+			if client.config.ConfigurationSource != "" {
+				sagconn.AppendUserAgent(Module, client.config.ConfigurationSource)
+			}
+			client.sagconn = sagconn
 		}
-		client.sagconn = sagconn
+		conn = client.sagconn
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return do(client.sagconn)
+	return do(conn)
 }
@@ -0,0 +1,192 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+const testApiCallLatency = 200 * time.Millisecond
+
+func testAliyunClientWithConns() *AliyunClient {
+	return &AliyunClient{
+		config:   &Config{RegionId: "cn-hangzhou", SkipRegionValidation: true},
+		RegionId: "cn-hangzhou",
+		ecsconn:  &ecs.Client{},
+		rdsconn:  &rds.Client{},
+		slbconn:  &slb.Client{},
+		ossconn:  &oss.Client{},
+	}
+}
+
+// runParallelServiceCalls invokes a slow fake API call on four different services at the same time
+// and returns how long it takes for all of them to finish.
+func runParallelServiceCalls(client *AliyunClient) time.Duration {
+	slowCall := func() (interface{}, error) {
+		time.Sleep(testApiCallLatency)
+		return nil, nil
+	}
+	calls := []func(){
+		func() { client.WithEcsClient(func(*ecs.Client) (interface{}, error) { return slowCall() }) },
+		func() { client.WithRdsClient(func(*rds.Client) (interface{}, error) { return slowCall() }) },
+		func() { client.WithSlbClient(func(*slb.Client) (interface{}, error) { return slowCall() }) },
+		func() { client.WithOssClient(func(*oss.Client) (interface{}, error) { return slowCall() }) },
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for _, call := range calls {
+		wg.Add(1)
+		go func(call func()) {
+			defer wg.Done()
+			call()
+		}(call)
+	}
+	wg.Wait()
+	return time.Since(start)
+}
+
+func TestAliyunClientParallelServiceCalls(t *testing.T) {
+	elapsed := runParallelServiceCalls(testAliyunClientWithConns())
+	if elapsed >= 2*testApiCallLatency {
+		t.Fatalf("calls to different services should overlap, but 4 calls of %s took %s.", testApiCallLatency, elapsed)
+	}
+}
+
+func TestAliyunClientInitServiceClientSerialized(t *testing.T) {
+	client := testAliyunClientWithConns()
+	var active, maxActive int
+	var countMutex sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.initServiceClient(KMSCode, func() error {
+				countMutex.Lock()
+				active++
+				if active > maxActive {
+					maxActive = active
+				}
+				countMutex.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				countMutex.Lock()
+				active--
+				countMutex.Unlock()
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+	if maxActive != 1 {
+		t.Fatalf("the initialization of one service should be serialized, got %d concurrent runs.", maxActive)
+	}
+}
+
+func BenchmarkAliyunClientParallelServiceCalls(b *testing.B) {
+	client := testAliyunClientWithConns()
+	for i := 0; i < b.N; i++ {
+		if elapsed := runParallelServiceCalls(client); elapsed >= 2*testApiCallLatency {
+			b.Fatalf("calls to different services should overlap, but 4 calls of %s took %s.", testApiCallLatency, elapsed)
+		}
+	}
+}
+
+func TestAliyunClientNestedServiceInit(t *testing.T) {
+	client := &AliyunClient{
+		config:   &Config{RegionId: "cn-hangzhou", SkipRegionValidation: true, AccessKey: "ak", SecretKey: "sk", RdsEndpoint: "rds.nested.example.com", SlbEndpoint: "slb.nested.example.com"},
+		RegionId: "cn-hangzhou",
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.WithSlbClient(func(*slb.Client) (interface{}, error) {
+			// The RDS client is initialized while the SLB call is in flight
+			return client.WithRdsClient(func(*rds.Client) (interface{}, error) { return nil, nil })
+		})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("the nested call got an error: %#v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("a nested service call should not wait for the outer call to finish.")
+	}
+}
+
+func BenchmarkAliyunClientInitServiceClient(b *testing.B) {
+	config := &Config{RegionId: "cn-hangzhou", SkipRegionValidation: true, AccessKey: "ak", SecretKey: "sk", RdsEndpoint: "rds.bench.example.com", SlbEndpoint: "slb.bench.example.com"}
+	noop := func() (interface{}, error) { return nil, nil }
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			// A new client has no connection yet, so every call goes through the initialization
+			client := &AliyunClient{config: config, RegionId: config.RegionId}
+			if _, err := client.WithRdsClient(func(*rds.Client) (interface{}, error) { return noop() }); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := client.WithSlbClient(func(*slb.Client) (interface{}, error) { return noop() }); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// TestAliyunClientCustomEndpointsConcurrent builds clients with different custom endpoints while the others are sending
+// requests through the SDK. Run it with -race, the SDK reads its endpoint mapping table without a lock.
+func TestAliyunClientCustomEndpointsConcurrent(t *testing.T) {
+	var received int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&received, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"RequestId":"test"}`)
+	}))
+	defer server.Close()
+	port := server.URL[strings.LastIndex(server.URL, ":"):]
+	hosts := []string{"127.0.0.1" + port, "localhost" + port}
+
+	const count = 20
+	errs := make(chan error, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(endpoint string) {
+			defer wg.Done()
+			client := &AliyunClient{
+				config:   &Config{RegionId: "cn-hangzhou", SkipRegionValidation: true, AccessKey: "ak", SecretKey: "sk", RdsEndpoint: endpoint},
+				RegionId: "cn-hangzhou",
+			}
+			_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+				request := rds.CreateDescribeRegionsRequest()
+				request.Scheme = "http"
+				return rdsClient.DescribeRegions(request)
+			})
+			errs <- err
+		}(hosts[i%len(hosts)])
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("the request to the custom endpoint got an error: %#v", err)
+		}
+	}
+	if received != count {
+		t.Fatalf("expected %d requests sent to the custom endpoints, got %d.", count, received)
+	}
+}
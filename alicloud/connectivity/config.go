@@ -32,69 +32,89 @@ type Config struct {
 	RamRoleSessionName       string
 	RamRolePolicy            string
 	RamRoleSessionExpiration int
-
-	EcsEndpoint           string
-	RdsEndpoint           string
-	SlbEndpoint           string
-	VpcEndpoint           string
-	CenEndpoint           string
-	EssEndpoint           string
-	OssEndpoint           string
-	OnsEndpoint           string
-	AlikafkaEndpoint      string
-	DnsEndpoint           string
-	RamEndpoint           string
-	CsEndpoint            string
-	CrEndpoint            string
-	CdnEndpoint           string
-	KmsEndpoint           string
-	OtsEndpoint           string
-	CmsEndpoint           string
-	PvtzEndpoint          string
-	StsEndpoint           string
-	LogEndpoint           string
-	DrdsEndpoint          string
-	DdsEndpoint           string
-	GpdbEnpoint           string
-	KVStoreEndpoint       string
-	FcEndpoint            string
-	ApigatewayEndpoint    string
-	DatahubEndpoint       string
-	MnsEndpoint           string
-	LocationEndpoint      string
-	ElasticsearchEndpoint string
-	NasEndpoint           string
-	ActionTrailEndpoint   string
-	BssOpenApiEndpoint    string
-	DdoscooEndpoint       string
-	DdosbgpEndpoint       string
-	SagEndpoint           string
+	AssumeRoleHops           []AssumeRoleHop
+
+	OIDCProviderArn       string
+	OIDCTokenFile         string
+	OIDCToken             string
+	OIDCRoleArn           string
+	OIDCSessionName       string
+	OIDCPolicy            string
+	OIDCSessionExpiration int
+
+	CredentialProcess string
+
+	EcsEndpoint             string
+	RdsEndpoint             string
+	SlbEndpoint             string
+	VpcEndpoint             string
+	CenEndpoint             string
+	EssEndpoint             string
+	OssEndpoint             string
+	OnsEndpoint             string
+	AlikafkaEndpoint        string
+	DnsEndpoint             string
+	RamEndpoint             string
+	CsEndpoint              string
+	CrEndpoint              string
+	CdnEndpoint             string
+	KmsEndpoint             string
+	OtsEndpoint             string
+	CmsEndpoint             string
+	PvtzEndpoint            string
+	StsEndpoint             string
+	LogEndpoint             string
+	DrdsEndpoint            string
+	DdsEndpoint             string
+	GpdbEnpoint             string
+	KVStoreEndpoint         string
+	FcEndpoint              string
+	ApigatewayEndpoint      string
+	DatahubEndpoint         string
+	MnsEndpoint             string
+	LocationEndpoint        string
+	ElasticsearchEndpoint   string
+	NasEndpoint             string
+	ActionTrailEndpoint     string
+	BssOpenApiEndpoint      string
+	ResourceManagerEndpoint string
+	DdoscooEndpoint         string
+	DdosbgpEndpoint         string
+	SagEndpoint             string
 
 	SkipRegionValidation bool
 	ConfigurationSource  string
-}
 
-func (c *Config) loadAndValidate() error {
-	err := c.validateRegion()
-	if err != nil {
-		return err
-	}
+	RetryPolicy          RetryPolicy
+	ProductRetryPolicies map[ServiceCode]RetryPolicy
 
-	return nil
-}
+	RateLimits       map[ServiceCode]int
+	ActionRateLimits map[string]int
 
-func (c *Config) validateRegion() error {
+	// DefaultTags are merged into the tags of every taggable resource, and the tags matched by
+	// IgnoreTagKeys or IgnoreTagKeyPrefixes are neither read nor managed by the resources.
+	DefaultTags          map[string]string
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
 
-	for _, valid := range ValidRegions {
-		if c.Region == valid {
-			return nil
-		}
-	}
+	// Recorder records the API calls into a cassette, or replays them, if it is set. It is only used by the tests.
+	Recorder *Recorder
 
-	return fmt.Errorf("Invalid Alibaba Cloud region: %s", c.RegionId)
+	// credentialProvider renews the temporary credential of the ECS role or the assumed RAM role.
+	// The access keys above are the source credential and they are used directly if it is nil.
+	credentialProvider *CredentialProvider
 }
 
 func (c *Config) getAuthCredential(stsSupported bool) auth.Credential {
+	// The credentials of the assumed roles, OIDC, the credential process and the ECS role all come from the provider,
+	// whether the product supports the security token or not.
+	if c.credentialProvider != nil {
+		credential := c.currentCredential()
+		if credential.SecurityToken == "" {
+			return credentials.NewAccessKeyCredential(credential.AccessKey, credential.SecretKey)
+		}
+		return credentials.NewStsTokenCredential(credential.AccessKey, credential.SecretKey, credential.SecurityToken)
+	}
 	if c.AccessKey != "" && c.SecretKey != "" {
 		if stsSupported && c.SecurityToken != "" {
 			return credentials.NewStsTokenCredential(c.AccessKey, c.SecretKey, c.SecurityToken)
@@ -119,13 +139,7 @@ func (c *Config) getAuthCredential(stsSupported bool) auth.Credential {
 // and their go sdk does support ecs role name.
 // This method is a temporary solution and it should be removed after all go sdk support ecs role name
 // The related PR: https://github.com/terraform-providers/terraform-provider-alicloud/pull/731
-func (c *Config) getAuthCredentialByEcsRoleName() (accessKey, secretKey, token string, err error) {
-	if c.AccessKey != "" {
-		return c.AccessKey, c.SecretKey, c.SecurityToken, nil
-	}
-	if c.EcsRoleName == "" {
-		return
-	}
+func (c *Config) getAuthCredentialByEcsRoleName() (credential Credential, err error) {
 	requestUrl := securityCredURL + c.EcsRoleName
 	httpRequest, err := http.NewRequest(requests.GET, requestUrl, strings.NewReader(""))
 	if err != nil {
@@ -181,19 +195,38 @@ func (c *Config) getAuthCredentialByEcsRoleName() (accessKey, secretKey, token s
 		return
 	}
 
+	expiration, err := jmespath.Search("Expiration", data)
+	if err != nil {
+		err = fmt.Errorf("refresh Ecs sts token err, fail to get Expiration: %s", err.Error())
+		return
+	}
+
 	if accessKeyId == nil || accessKeySecret == nil || securityToken == nil {
 		err = fmt.Errorf("there is no any available accesskey, secret and security token for Ecs role %s", c.EcsRoleName)
 		return
 	}
 
-	return accessKeyId.(string), accessKeySecret.(string), securityToken.(string), nil
+	credential = Credential{
+		AccessKey:     accessKeyId.(string),
+		SecretKey:     accessKeySecret.(string),
+		SecurityToken: securityToken.(string),
+	}
+	if expiration, ok := expiration.(string); ok {
+		credential.Expiration = parseCredentialExpiration(expiration)
+	}
+	return credential, nil
 }
 
+// MakeConfigByEcsRoleName makes the provider use the credential of the ECS role when no access key is specified.
+// The credential is fetched from the ECS metadata service again before it expires.
 func (c *Config) MakeConfigByEcsRoleName() error {
-	accessKey, secretKey, token, err := c.getAuthCredentialByEcsRoleName()
+	if c.AccessKey != "" || c.EcsRoleName == "" || c.credentialProvider != nil {
+		return nil
+	}
+	provider, err := NewCredentialProvider(c.getAuthCredentialByEcsRoleName)
 	if err != nil {
 		return err
 	}
-	c.AccessKey, c.SecretKey, c.SecurityToken = accessKey, secretKey, token
+	c.credentialProvider = provider
 	return nil
 }
@@ -0,0 +1,409 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+)
+
+// CredentialRefreshWindow is how long before its expiration a temporary credential is renewed. It leaves enough time
+// for the API calls which have been signed with the old credential.
+const CredentialRefreshWindow = 5 * time.Minute
+
+// The layout of the expiration returned by STS and the ECS metadata service, like 2019-10-17T11:52:19Z.
+const credentialExpirationLayout = "2006-01-02T15:04:05Z"
+
+// credentialProcessTimeout is how long the external credential process can run.
+const credentialProcessTimeout = time.Minute
+
+// Credential is a set of access keys. The temporary ones issued by STS have a security token and an expiration.
+type Credential struct {
+	AccessKey     string
+	SecretKey     string
+	SecurityToken string
+	Expiration    time.Time
+}
+
+func (c Credential) expiresWithin(now time.Time, window time.Duration) bool {
+	return !c.Expiration.IsZero() && !now.Add(window).Before(c.Expiration)
+}
+
+// CredentialProvider caches a temporary credential and renews it before it expires, so the long running applies
+// do not fail with InvalidSecurityToken.Expired.
+type CredentialProvider struct {
+	mutex      sync.Mutex
+	credential Credential
+	version    int
+	retrieve   func() (Credential, error)
+	now        func() time.Time
+}
+
+// NewCredentialProvider returns a provider which uses retrieve to get a new credential. The first credential is
+// retrieved at once, so a wrong configuration is reported when the provider is configured.
+func NewCredentialProvider(retrieve func() (Credential, error)) (*CredentialProvider, error) {
+	provider := &CredentialProvider{
+		retrieve: retrieve,
+		now:      time.Now,
+	}
+	credential, err := retrieve()
+	if err != nil {
+		return nil, err
+	}
+	provider.credential = credential
+	return provider, nil
+}
+
+// Credential returns the cached credential and renews it first if it is going to expire. The returned version
+// increases every time the credential is renewed.
+func (p *CredentialProvider) Credential() (Credential, int, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.credential.expiresWithin(p.now(), CredentialRefreshWindow) {
+		log.Printf("[INFO] The credential %s expires at %s, renewing it.", p.credential.AccessKey, p.credential.Expiration.Format(time.RFC3339))
+		credential, err := p.retrieve()
+		if err != nil {
+			return p.credential, p.version, fmt.Errorf("renewing the credential which expires at %s got an error: %#v", p.credential.Expiration.Format(time.RFC3339), err)
+		}
+		p.credential = credential
+		p.version++
+	}
+	return p.credential, p.version, nil
+}
+
+func (p *CredentialProvider) current() (Credential, int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.credential, p.version
+}
+
+// currentCredential returns the credential used to build the clients. It does not renew the credential,
+// which is done by refreshCredential before a client is used.
+func (c *Config) currentCredential() Credential {
+	if c.credentialProvider == nil {
+		return Credential{AccessKey: c.AccessKey, SecretKey: c.SecretKey, SecurityToken: c.SecurityToken}
+	}
+	credential, _ := c.credentialProvider.current()
+	return credential
+}
+
+func (c *Config) credentialVersion() int {
+	if c == nil || c.credentialProvider == nil {
+		return 0
+	}
+	_, version := c.credentialProvider.current()
+	return version
+}
+
+// refreshCredential renews the temporary credential if it is going to expire.
+func (c *Config) refreshCredential() error {
+	if c == nil || c.credentialProvider == nil {
+		return nil
+	}
+	_, _, err := c.credentialProvider.Credential()
+	return err
+}
+
+// AssumeRoleHop is a RAM role assumed by the credential of the previous hop.
+type AssumeRoleHop struct {
+	RoleArn           string
+	SessionName       string
+	Policy            string
+	ExternalId        string
+	SessionExpiration int
+}
+
+// assumeRoleHops returns the roles specified by the provider block assume_role { ... } or the RamRoleArn profile.
+func (c *Config) assumeRoleHops() []AssumeRoleHop {
+	if len(c.AssumeRoleHops) > 0 {
+		return c.AssumeRoleHops
+	}
+	if c.RamRoleArn == "" {
+		return nil
+	}
+	return []AssumeRoleHop{{
+		RoleArn:           c.RamRoleArn,
+		SessionName:       c.RamRoleSessionName,
+		Policy:            c.RamRolePolicy,
+		SessionExpiration: c.RamRoleSessionExpiration,
+	}}
+}
+
+// MakeConfigByAssumeRole makes the provider use the credential of the RAM roles specified by assumeRoleHops, which are
+// assumed again before the credential expires. The access keys or the credential of the other methods are used to
+// assume the first role, and the credential of each role is used to assume the next one.
+func (c *Config) MakeConfigByAssumeRole() error {
+	hops := c.assumeRoleHops()
+	if len(hops) == 0 {
+		return nil
+	}
+	source := c.credentialProvider
+	static := Credential{AccessKey: c.AccessKey, SecretKey: c.SecretKey, SecurityToken: c.SecurityToken}
+	provider, err := NewCredentialProvider(func() (Credential, error) {
+		credential := static
+		if source != nil {
+			var err error
+			if credential, _, err = source.Credential(); err != nil {
+				return Credential{}, err
+			}
+		}
+		arn := ""
+		for i, hop := range hops {
+			var err error
+			credential, arn, err = c.assumeRole(credential, hop)
+			if err != nil {
+				return Credential{}, fmt.Errorf("assuming the role %s of the hop %d got an error: %#v", hop.RoleArn, i+1, err)
+			}
+			log.Printf("[DEBUG] Assumed the role %s of the hop %d: %s", hop.RoleArn, i+1, arn)
+		}
+		log.Printf("[INFO] Assumed the role session %s after %d hops, which expires at %s.", arn, len(hops), credential.Expiration.Format(time.RFC3339))
+		return credential, nil
+	})
+	if err != nil {
+		return err
+	}
+	c.credentialProvider = provider
+	return nil
+}
+
+// assumeRole returns the credential of the RAM role assumed by the specified credential and the ARN of the role session.
+func (c *Config) assumeRole(credential Credential, hop AssumeRoleHop) (Credential, string, error) {
+	request := sts.CreateAssumeRoleRequest()
+	request.RoleArn = hop.RoleArn
+	request.RoleSessionName = hop.SessionName
+	if request.RoleSessionName == "" {
+		request.RoleSessionName = "terraform"
+	}
+	if hop.SessionExpiration > 0 {
+		request.DurationSeconds = requests.NewInteger(hop.SessionExpiration)
+	}
+	request.Policy = hop.Policy
+	if hop.ExternalId != "" {
+		// The SDK does not have the field ExternalId yet
+		request.QueryParams["ExternalId"] = hop.ExternalId
+	}
+	request.Scheme, request.Domain = c.stsEndpoint()
+
+	var client *sts.Client
+	var err error
+	if credential.SecurityToken == "" {
+		client, err = sts.NewClientWithAccessKey(c.RegionId, credential.AccessKey, credential.SecretKey)
+	} else {
+		client, err = sts.NewClientWithStsToken(c.RegionId, credential.AccessKey, credential.SecretKey, credential.SecurityToken)
+	}
+	if err != nil {
+		return Credential{}, "", err
+	}
+
+	response, err := client.AssumeRole(request)
+	if err != nil {
+		return Credential{}, "", err
+	}
+
+	return Credential{
+		AccessKey:     response.Credentials.AccessKeyId,
+		SecretKey:     response.Credentials.AccessKeySecret,
+		SecurityToken: response.Credentials.SecurityToken,
+		Expiration:    parseCredentialExpiration(response.Credentials.Expiration),
+	}, response.AssumedRoleUser.Arn, nil
+}
+
+// stsEndpoint returns the scheme and the domain of the STS API used to get the temporary credentials.
+func (c *Config) stsEndpoint() (string, string) {
+	endpoint := c.StsEndpoint
+	if endpoint == "" {
+		endpoint = loadEndpoint(c.RegionId, STSCode)
+	}
+	if endpoint == "" {
+		endpoint = "sts.aliyuncs.com"
+	}
+	scheme := "https"
+	if strings.HasPrefix(endpoint, "http://") {
+		scheme = "http"
+	}
+	endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
+	return scheme, strings.TrimSuffix(endpoint, "/")
+}
+
+func parseCredentialExpiration(expiration string) time.Time {
+	if expiration == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(credentialExpirationLayout, expiration)
+	if err != nil {
+		log.Printf("[WARN] Parsing the credential expiration %s got an error: %#v.", expiration, err)
+		return time.Time{}
+	}
+	return t
+}
+
+// credentialRenewed reports whether the credential has been renewed since the client specified by key was built,
+// and records the current credential version for it. It must be called before checking whether the client exists,
+// so the version is recorded when the client is built for the first time.
+func (client *AliyunClient) credentialRenewed(key string) bool {
+	version := client.config.credentialVersion()
+
+	client.credentialVersionsLock.Lock()
+	defer client.credentialVersionsLock.Unlock()
+	if client.credentialVersions == nil {
+		client.credentialVersions = make(map[string]int)
+	}
+	last, ok := client.credentialVersions[key]
+	client.credentialVersions[key] = version
+	return ok && last != version
+}
+
+// MakeConfigByCredentialProcess makes the provider use the credential printed by the external command CredentialProcess.
+// The command prints a JSON object in the format of the External mode of the Aliyun CLI, like
+// {"mode": "StsToken", "access_key_id": "...", "access_key_secret": "...", "sts_token": "...", "expiration": "..."},
+// and it is run again before the credential expires if the expiration is given.
+func (c *Config) MakeConfigByCredentialProcess() error {
+	if c.CredentialProcess == "" || c.credentialProvider != nil {
+		return nil
+	}
+	provider, err := NewCredentialProvider(c.runCredentialProcess)
+	if err != nil {
+		return err
+	}
+	c.credentialProvider = provider
+	return nil
+}
+
+func (c *Config) runCredentialProcess() (Credential, error) {
+	args := strings.Fields(c.CredentialProcess)
+	if len(args) == 0 {
+		return Credential{}, fmt.Errorf("the credential process is empty")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), credentialProcessTimeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return Credential{}, fmt.Errorf("running the credential process %s got an error: %s %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	var result struct {
+		Mode            string `json:"mode"`
+		AccessKeyId     string `json:"access_key_id"`
+		AccessKeySecret string `json:"access_key_secret"`
+		StsToken        string `json:"sts_token"`
+		Expiration      string `json:"expiration"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return Credential{}, fmt.Errorf("parsing the output of the credential process %s got an error: %#v", args[0], err)
+	}
+	if result.AccessKeyId == "" || result.AccessKeySecret == "" {
+		return Credential{}, fmt.Errorf("the credential process %s does not print access_key_id and access_key_secret", args[0])
+	}
+	if result.Mode == "StsToken" && result.StsToken == "" {
+		return Credential{}, fmt.Errorf("the credential process %s does not print sts_token in the StsToken mode", args[0])
+	}
+	return Credential{
+		AccessKey:     result.AccessKeyId,
+		SecretKey:     result.AccessKeySecret,
+		SecurityToken: result.StsToken,
+		Expiration:    parseCredentialExpiration(result.Expiration),
+	}, nil
+}
+
+// MakeConfigByOIDC makes the provider use the credential of the RAM role assumed with an OIDC token, like the service
+// account token of a Kubernetes pod. The token file is read again every time the role is assumed, since it is rotated.
+func (c *Config) MakeConfigByOIDC() error {
+	if c.OIDCProviderArn == "" || c.credentialProvider != nil {
+		return nil
+	}
+	if c.OIDCRoleArn == "" {
+		return fmt.Errorf("the role_arn is required to assume a role with the OIDC provider %s", c.OIDCProviderArn)
+	}
+	if c.OIDCToken == "" && c.OIDCTokenFile == "" {
+		return fmt.Errorf("one of oidc_token and oidc_token_file is required to assume a role with the OIDC provider %s", c.OIDCProviderArn)
+	}
+	provider, err := NewCredentialProvider(c.assumeRoleWithOIDC)
+	if err != nil {
+		return err
+	}
+	c.credentialProvider = provider
+	return nil
+}
+
+// assumeRoleWithOIDC calls the STS API AssumeRoleWithOIDC. The API does not need any credential, so the request is
+// sent without a signature instead of by the SDK client.
+func (c *Config) assumeRoleWithOIDC() (Credential, error) {
+	token := c.OIDCToken
+	if c.OIDCTokenFile != "" {
+		data, err := ioutil.ReadFile(c.OIDCTokenFile)
+		if err != nil {
+			return Credential{}, fmt.Errorf("reading the OIDC token file %s got an error: %#v", c.OIDCTokenFile, err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+
+	sessionName := c.OIDCSessionName
+	if sessionName == "" {
+		sessionName = "terraform"
+	}
+	params := url.Values{}
+	params.Set("Action", "AssumeRoleWithOIDC")
+	params.Set("Format", "JSON")
+	params.Set("Version", "2015-04-01")
+	params.Set("Timestamp", time.Now().UTC().Format(credentialExpirationLayout))
+	params.Set("OIDCProviderArn", c.OIDCProviderArn)
+	params.Set("RoleArn", c.OIDCRoleArn)
+	params.Set("OIDCToken", token)
+	params.Set("RoleSessionName", sessionName)
+	if c.OIDCPolicy != "" {
+		params.Set("Policy", c.OIDCPolicy)
+	}
+	if c.OIDCSessionExpiration > 0 {
+		params.Set("DurationSeconds", strconv.Itoa(c.OIDCSessionExpiration))
+	}
+
+	scheme, domain := c.stsEndpoint()
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	httpResponse, err := httpClient.PostForm(fmt.Sprintf("%s://%s/", scheme, domain), params)
+	if err != nil {
+		return Credential{}, fmt.Errorf("AssumeRoleWithOIDC got an error: %#v", err)
+	}
+	defer httpResponse.Body.Close()
+	body, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return Credential{}, fmt.Errorf("reading the response of AssumeRoleWithOIDC got an error: %#v", err)
+	}
+
+	var response struct {
+		RequestId   string
+		Code        string
+		Message     string
+		Credentials sts.Credentials
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return Credential{}, fmt.Errorf("parsing the response of AssumeRoleWithOIDC got an error: %#v, body: %s", err, string(body))
+	}
+	if httpResponse.StatusCode != http.StatusOK || response.Credentials.AccessKeyId == "" {
+		return Credential{}, fmt.Errorf("AssumeRoleWithOIDC failed, status: %d, code: %s, message: %s, request id: %s",
+			httpResponse.StatusCode, response.Code, response.Message, response.RequestId)
+	}
+
+	return Credential{
+		AccessKey:     response.Credentials.AccessKeyId,
+		SecretKey:     response.Credentials.AccessKeySecret,
+		SecurityToken: response.Credentials.SecurityToken,
+		Expiration:    parseCredentialExpiration(response.Credentials.Expiration),
+	}, nil
+}
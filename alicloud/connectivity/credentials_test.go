@@ -0,0 +1,277 @@
+package connectivity
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/dxh031/ali_mns"
+	"github.com/valyala/fasthttp"
+)
+
+// testCredentialProvider returns a provider whose credentials are valid for one hour, and a function to move its clock.
+func testCredentialProvider(t *testing.T) (*CredentialProvider, func(time.Duration), *int) {
+	now := time.Date(2019, 10, 17, 0, 0, 0, 0, time.UTC)
+	retrieved := 0
+	provider, err := NewCredentialProvider(func() (Credential, error) {
+		retrieved++
+		return Credential{
+			AccessKey:     fmt.Sprintf("STS.key%d", retrieved),
+			SecretKey:     "secret",
+			SecurityToken: "token",
+			Expiration:    now.Add(time.Hour),
+		}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider.now = func() time.Time { return now }
+	return provider, func(d time.Duration) { now = now.Add(d) }, &retrieved
+}
+
+func TestCredentialProviderRenew(t *testing.T) {
+	provider, advance, retrieved := testCredentialProvider(t)
+
+	advance(30 * time.Minute)
+	credential, version, err := provider.Credential()
+	if err != nil || credential.AccessKey != "STS.key1" || version != 0 || *retrieved != 1 {
+		t.Fatalf("the credential should not be renewed long before the expiration, got %s version %d, %#v.", credential.AccessKey, version, err)
+	}
+
+	advance(26 * time.Minute)
+	credential, version, err = provider.Credential()
+	if err != nil || credential.AccessKey != "STS.key2" || version != 1 || *retrieved != 2 {
+		t.Fatalf("the credential should be renewed within the refresh window, got %s version %d, %#v.", credential.AccessKey, version, err)
+	}
+}
+
+func TestCredentialProviderStatic(t *testing.T) {
+	provider, err := NewCredentialProvider(func() (Credential, error) {
+		return Credential{AccessKey: "key", SecretKey: "secret"}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider.now = func() time.Time { return time.Now().Add(100 * 365 * 24 * time.Hour) }
+	if _, version, _ := provider.Credential(); version != 0 {
+		t.Fatalf("a credential without expiration should never be renewed, got version %d.", version)
+	}
+}
+
+func TestAliyunClientRebuildWithRenewedCredential(t *testing.T) {
+	provider, advance, _ := testCredentialProvider(t)
+	config := &Config{RegionId: "cn-hangzhou", SkipRegionValidation: true, OssEndpoint: "oss-cn-hangzhou.aliyuncs.com", credentialProvider: provider}
+	client := &AliyunClient{config: config, RegionId: config.RegionId}
+
+	accessKey := func() string {
+		raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+			return ossClient.Config.AccessKeyID, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raw.(string)
+	}
+
+	if key := accessKey(); key != "STS.key1" {
+		t.Fatalf("expected the OSS client built with STS.key1, got %s.", key)
+	}
+	advance(10 * time.Minute)
+	if key := accessKey(); key != "STS.key1" {
+		t.Fatalf("the OSS client should be reused before the credential is renewed, got %s.", key)
+	}
+	advance(50 * time.Minute)
+	if key := accessKey(); key != "STS.key2" {
+		t.Fatalf("the OSS client should be rebuilt with the renewed credential, got %s.", key)
+	}
+}
+
+func TestParseCredentialExpiration(t *testing.T) {
+	expiration := parseCredentialExpiration("2019-10-17T11:52:19Z")
+	if !expiration.Equal(time.Date(2019, 10, 17, 11, 52, 19, 0, time.UTC)) {
+		t.Fatalf("unexpected expiration %s.", expiration)
+	}
+	if !parseCredentialExpiration("").IsZero() || !parseCredentialExpiration("invalid").IsZero() {
+		t.Fatalf("an empty or invalid expiration should be zero.")
+	}
+}
+
+func TestCredentialProcess(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credential-process")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := filepath.Join(dir, "credential.sh")
+	output := `{"mode": "StsToken", "access_key_id": "STS.process", "access_key_secret": "secret", "sts_token": "token", "expiration": "2019-10-17T11:52:19Z"}`
+	if err := ioutil.WriteFile(script, []byte("#!/bin/sh\necho '"+output+"'\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &Config{CredentialProcess: script}
+	if err := config.MakeConfigByCredentialProcess(); err != nil {
+		t.Fatalf("running the credential process got an error: %#v.", err)
+	}
+	credential := config.currentCredential()
+	if credential.AccessKey != "STS.process" || credential.SecurityToken != "token" || credential.Expiration.IsZero() {
+		t.Fatalf("unexpected credential %#v.", credential)
+	}
+
+	config = &Config{CredentialProcess: filepath.Join(dir, "not-exist")}
+	if err := config.MakeConfigByCredentialProcess(); err == nil {
+		t.Fatalf("expected an error for a missing credential process.")
+	}
+}
+
+func TestAssumeRoleWithOIDC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oidc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("token1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("Action") != "AssumeRoleWithOIDC" || r.Form.Get("RoleArn") != "acs:ram::123:role/ci" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"Code": "InvalidParameter", "Message": "unexpected request", "RequestId": "1"}`))
+			return
+		}
+		tokens = append(tokens, r.Form.Get("OIDCToken"))
+		w.Write([]byte(fmt.Sprintf(`{"RequestId": "2", "Credentials": {"AccessKeyId": "STS.oidc%d", "AccessKeySecret": "secret", "SecurityToken": "token", "Expiration": "2019-10-17T11:52:19Z"}}`, len(tokens))))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		RegionId:        "cn-hangzhou",
+		StsEndpoint:     server.URL,
+		OIDCProviderArn: "acs:ram::123:oidc-provider/ack",
+		OIDCTokenFile:   tokenFile,
+		OIDCRoleArn:     "acs:ram::123:role/ci",
+	}
+	if err := config.MakeConfigByOIDC(); err != nil {
+		t.Fatalf("AssumeRoleWithOIDC got an error: %#v.", err)
+	}
+	if credential := config.currentCredential(); credential.AccessKey != "STS.oidc1" {
+		t.Fatalf("unexpected credential %#v.", credential)
+	}
+
+	// The rotated token is read when the credential is renewed.
+	if err := ioutil.WriteFile(tokenFile, []byte("token2"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	config.credentialProvider.now = func() time.Time { return time.Date(2019, 10, 17, 11, 50, 0, 0, time.UTC) }
+	if err := config.refreshCredential(); err != nil {
+		t.Fatalf("renewing the OIDC credential got an error: %#v.", err)
+	}
+	if credential := config.currentCredential(); credential.AccessKey != "STS.oidc2" || tokens[1] != "token2" {
+		t.Fatalf("expected the credential assumed with the rotated token, got %#v and tokens %v.", credential, tokens)
+	}
+
+	config.OIDCRoleArn = "acs:ram::123:role/other"
+	config.credentialProvider = nil
+	if err := config.MakeConfigByOIDC(); err == nil {
+		t.Fatalf("expected an error returned by AssumeRoleWithOIDC.")
+	}
+}
+
+func TestAssumeRoleHops(t *testing.T) {
+	var requests []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		request := map[string]string{}
+		for _, key := range []string{"Action", "AccessKeyId", "SecurityToken", "RoleArn", "RoleSessionName", "ExternalId"} {
+			request[key] = r.Form.Get(key)
+		}
+		requests = append(requests, request)
+		hop := len(requests)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"RequestId": "%d", "Credentials": {"AccessKeyId": "STS.hop%d", "AccessKeySecret": "secret", "SecurityToken": "token%d", "Expiration": "2019-10-17T11:52:19Z"}, "AssumedRoleUser": {"Arn": "%s/%s"}}`,
+			hop, hop, hop, strings.Replace(request["RoleArn"], ":role/", ":assumed-role/", 1), request["RoleSessionName"])))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		RegionId:    "cn-hangzhou",
+		AccessKey:   "ci-key",
+		SecretKey:   "ci-secret",
+		StsEndpoint: server.URL,
+		AssumeRoleHops: []AssumeRoleHop{
+			{RoleArn: "acs:ram::111:role/security", SessionName: "ci"},
+			{RoleArn: "acs:ram::222:role/workload", ExternalId: "landing-zone"},
+		},
+	}
+	if err := config.MakeConfigByAssumeRole(); err != nil {
+		t.Fatalf("assuming the roles got an error: %#v.", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 AssumeRole calls, got %d.", len(requests))
+	}
+	if requests[0]["AccessKeyId"] != "ci-key" || requests[0]["RoleArn"] != "acs:ram::111:role/security" || requests[0]["ExternalId"] != "" {
+		t.Fatalf("the first hop should be assumed by the access key, got %v.", requests[0])
+	}
+	if requests[1]["AccessKeyId"] != "STS.hop1" || requests[1]["SecurityToken"] != "token1" || requests[1]["RoleSessionName"] != "terraform" || requests[1]["ExternalId"] != "landing-zone" {
+		t.Fatalf("the second hop should be assumed by the credential of the first hop, got %v.", requests[1])
+	}
+	if credential := config.currentCredential(); credential.AccessKey != "STS.hop2" || credential.SecurityToken != "token2" {
+		t.Fatalf("expected the credential of the last hop, got %#v.", credential)
+	}
+}
+
+type testMnsClient struct {
+	ali_mns.MNSClient
+	headers map[string]string
+}
+
+func (c *testMnsClient) Send(method ali_mns.Method, headers map[string]string, message interface{}, resource string) (*fasthttp.Response, error) {
+	c.headers = headers
+	return nil, nil
+}
+
+func TestMnsStsClientSecurityToken(t *testing.T) {
+	sent := &testMnsClient{}
+	var mnsClient ali_mns.MNSClient = &mnsStsClient{MNSClient: sent, securityToken: "token"}
+	mnsClient.Send(ali_mns.GET, nil, nil, "queues")
+	if sent.headers["security-token"] != "token" {
+		t.Fatalf("the MNS request should carry the security token, got the headers %v.", sent.headers)
+	}
+}
+
+func TestCmsClientUsesResolvedCredential(t *testing.T) {
+	provider, _, _ := testCredentialProvider(t)
+	// Like the credential process and OIDC, the provider block sets no access key
+	config := &Config{RegionId: "cn-hangzhou", SkipRegionValidation: true, CmsEndpoint: "metrics.cn-hangzhou.aliyuncs.com", credentialProvider: provider}
+	client := &AliyunClient{config: config, RegionId: config.RegionId}
+
+	raw, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
+		request := cms.CreateDescribeAlertHistoryListRequest()
+		err := cmsClient.BuildRequestWithSigner(request, nil)
+		return request.GetQueryParams(), err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := raw.(map[string]string)
+	if params["AccessKeyId"] != "STS.key1" || params["SecurityToken"] != "token" {
+		t.Fatalf("the CMS request should be signed by the resolved credential, got %v.", params)
+	}
+
+	// The products without the security token support get the resolved credential as well
+	if credential, ok := config.getAuthCredential(false).(*credentials.StsTokenCredential); !ok || credential.AccessKeyId != "STS.key1" {
+		t.Fatalf("expected the resolved credential, got %#v.", config.getAuthCredential(false))
+	}
+}
@@ -1,56 +1,76 @@
 package connectivity
 
 import (
+	"bytes"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
+	"gopkg.in/yaml.v2"
 )
 
 // ServiceCode Load endpoints from endpoints.xml or environment variables to meet specified application scenario, like private cloud.
 type ServiceCode string
 
 const (
-	ECSCode           = ServiceCode("ECS")
-	ESSCode           = ServiceCode("ESS")
-	RAMCode           = ServiceCode("RAM")
-	VPCCode           = ServiceCode("VPC")
-	SLBCode           = ServiceCode("SLB")
-	RDSCode           = ServiceCode("RDS")
-	OSSCode           = ServiceCode("OSS")
-	ONSCode           = ServiceCode("ONS")
-	ALIKAFKACode      = ServiceCode("ALIKAFKA")
-	CONTAINCode       = ServiceCode("CS")
-	CRCode            = ServiceCode("CR")
-	DOMAINCode        = ServiceCode("DOMAIN")
-	CDNCode           = ServiceCode("CDN")
-	CMSCode           = ServiceCode("CMS")
-	KMSCode           = ServiceCode("KMS")
-	OTSCode           = ServiceCode("OTS")
-	DNSCode           = ServiceCode("DNS")
-	PVTZCode          = ServiceCode("PVTZ")
-	LOGCode           = ServiceCode("LOG")
-	FCCode            = ServiceCode("FC")
-	DDSCode           = ServiceCode("DDS")
-	GPDBCode          = ServiceCode("GPDB")
-	STSCode           = ServiceCode("STS")
-	CENCode           = ServiceCode("CEN")
-	KVSTORECode       = ServiceCode("KVSTORE")
-	DATAHUBCode       = ServiceCode("DATAHUB")
-	MNSCode           = ServiceCode("MNS")
-	CLOUDAPICode      = ServiceCode("APIGATEWAY")
-	DRDSCode          = ServiceCode("DRDS")
-	LOCATIONCode      = ServiceCode("LOCATION")
-	ELASTICSEARCHCode = ServiceCode("ELASTICSEARCH")
-	NASCode           = ServiceCode("NAS")
-	ACTIONTRAILCode   = ServiceCode("ACTIONTRAIL")
-	BSSOPENAPICode    = ServiceCode("BSSOPENAPI")
-	DDOSCOOCode       = ServiceCode("DDOSCOO")
-	DDOSBGPCode       = ServiceCode("DDOSBGP")
-	SAGCode           = ServiceCode("SAG")
+	ECSCode             = ServiceCode("ECS")
+	ESSCode             = ServiceCode("ESS")
+	RAMCode             = ServiceCode("RAM")
+	VPCCode             = ServiceCode("VPC")
+	SLBCode             = ServiceCode("SLB")
+	RDSCode             = ServiceCode("RDS")
+	OSSCode             = ServiceCode("OSS")
+	ONSCode             = ServiceCode("ONS")
+	ALIKAFKACode        = ServiceCode("ALIKAFKA")
+	CONTAINCode         = ServiceCode("CS")
+	CRCode              = ServiceCode("CR")
+	DOMAINCode          = ServiceCode("DOMAIN")
+	CDNCode             = ServiceCode("CDN")
+	CMSCode             = ServiceCode("CMS")
+	KMSCode             = ServiceCode("KMS")
+	OTSCode             = ServiceCode("OTS")
+	DNSCode             = ServiceCode("DNS")
+	PVTZCode            = ServiceCode("PVTZ")
+	LOGCode             = ServiceCode("LOG")
+	FCCode              = ServiceCode("FC")
+	DDSCode             = ServiceCode("DDS")
+	GPDBCode            = ServiceCode("GPDB")
+	STSCode             = ServiceCode("STS")
+	CENCode             = ServiceCode("CEN")
+	KVSTORECode         = ServiceCode("KVSTORE")
+	DATAHUBCode         = ServiceCode("DATAHUB")
+	MNSCode             = ServiceCode("MNS")
+	CLOUDAPICode        = ServiceCode("APIGATEWAY")
+	DRDSCode            = ServiceCode("DRDS")
+	LOCATIONCode        = ServiceCode("LOCATION")
+	ELASTICSEARCHCode   = ServiceCode("ELASTICSEARCH")
+	NASCode             = ServiceCode("NAS")
+	ACTIONTRAILCode     = ServiceCode("ACTIONTRAIL")
+	BSSOPENAPICode      = ServiceCode("BSSOPENAPI")
+	DDOSCOOCode         = ServiceCode("DDOSCOO")
+	DDOSBGPCode         = ServiceCode("DDOSBGP")
+	SAGCode             = ServiceCode("SAG")
+	EMRCode             = ServiceCode("EMR")
+	CASCode             = ServiceCode("CAS")
+	RESOURCEMANAGERCode = ServiceCode("RESOURCEMANAGER")
 )
 
+// ServiceCodes contains all of the products whose endpoint can be customized.
+var ServiceCodes = []ServiceCode{
+	ECSCode, ESSCode, RAMCode, VPCCode, SLBCode, RDSCode, OSSCode, ONSCode, ALIKAFKACode, CONTAINCode, CRCode,
+	DOMAINCode, CDNCode, CMSCode, KMSCode, OTSCode, DNSCode, PVTZCode, LOGCode, FCCode, DDSCode, GPDBCode, STSCode,
+	CENCode, KVSTORECode, DATAHUBCode, MNSCode, CLOUDAPICode, DRDSCode, LOCATIONCode, ELASTICSEARCHCode, NASCode,
+	ACTIONTRAILCode, BSSOPENAPICode, DDOSCOOCode, DDOSBGPCode, SAGCode, EMRCode, CASCode,
+	RESOURCEMANAGERCode,
+}
+
 type Endpoints struct {
 	Endpoint []Endpoint `xml:"Endpoint"`
 }
@@ -74,35 +94,315 @@ type Product struct {
 	DomainName  string `xml:"DomainName"`
 }
 
-func loadEndpoint(region string, serviceCode ServiceCode) string {
-	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", string(serviceCode))))
-	if endpoint != "" {
-		return endpoint
+// EndpointCatalog is a set of custom endpoints loaded from an endpoint file.
+// The endpoint of a product in a region is resolved from the region overrides first, then the product endpoints
+// which apply to all regions, and then the fallback template, in which {product} and {region} are replaced.
+type EndpointCatalog struct {
+	Regions  map[string]map[string]string `json:"regions" yaml:"regions"`
+	Products map[string]string            `json:"products" yaml:"products"`
+	Fallback string                       `json:"fallback" yaml:"fallback"`
+}
+
+// The sources of a resolved endpoint.
+const (
+	EndpointSourceProvider    = "provider"
+	EndpointSourceEnvironment = "environment"
+	EndpointSourceRegion      = "catalog_region"
+	EndpointSourceProduct     = "catalog_product"
+	EndpointSourceFallback    = "catalog_fallback"
+	EndpointSourceDefault     = "default"
+)
+
+var endpointCatalogCache = struct {
+	sync.Mutex
+	loaded  map[string]bool
+	catalog map[string]*EndpointCatalog
+}{loaded: make(map[string]bool), catalog: make(map[string]*EndpointCatalog)}
+
+// endpointFilePaths returns the candidate endpoint files. The files in the current path are used first,
+// and then the one specified by the environment variable TF_ENDPOINT_PATH.
+func endpointFilePaths() []string {
+	paths := []string{"./endpoints.xml", "./endpoints.json", "./endpoints.yaml", "./endpoints.yml"}
+	if path := strings.TrimSpace(os.Getenv("TF_ENDPOINT_PATH")); path != "" {
+		paths = append(paths, path)
 	}
+	return paths
+}
 
-	// Load current path endpoint file endpoints.xml, if failed, it will load from environment variables TF_ENDPOINT_PATH
-	data, err := ioutil.ReadFile("./endpoints.xml")
-	if err != nil || len(data) <= 0 {
-		d, e := ioutil.ReadFile(os.Getenv("TF_ENDPOINT_PATH"))
-		if e != nil {
-			return ""
+// loadEndpointCatalog returns the catalog of the first available endpoint file. Each file is only read and parsed once.
+func loadEndpointCatalog() *EndpointCatalog {
+	endpointCatalogCache.Lock()
+	defer endpointCatalogCache.Unlock()
+
+	for _, path := range endpointFilePaths() {
+		if !endpointCatalogCache.loaded[path] {
+			catalog, err := parseEndpointFile(path)
+			if err != nil && !os.IsNotExist(err) {
+				log.Printf("[WARN] Loading the endpoint file %s got an error: %#v.", path, err)
+			}
+			endpointCatalogCache.loaded[path] = true
+			endpointCatalogCache.catalog[path] = catalog
+		}
+		if catalog := endpointCatalogCache.catalog[path]; catalog != nil {
+			return catalog
 		}
-		data = d
 	}
-	var endpoints Endpoints
-	err = xml.Unmarshal(data, &endpoints)
+	return nil
+}
+
+func resetEndpointCatalogCache() {
+	endpointCatalogCache.Lock()
+	defer endpointCatalogCache.Unlock()
+	endpointCatalogCache.loaded = make(map[string]bool)
+	endpointCatalogCache.catalog = make(map[string]*EndpointCatalog)
+}
+
+// parseEndpointFile parses an endpoint file in XML, JSON or YAML. The format is decided by the file extension,
+// and the content is detected when the extension is unknown.
+func parseEndpointFile(path string) (*EndpointCatalog, error) {
+	data, err := ioutil.ReadFile(path)
 	if err != nil {
-		return ""
+		return nil, err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+	if format != "xml" && format != "json" && format != "yaml" && format != "yml" {
+		switch bytes.TrimSpace(data)[0] {
+		case '<':
+			format = "xml"
+		case '{':
+			format = "json"
+		default:
+			format = "yaml"
+		}
 	}
-	for _, endpoint := range endpoints.Endpoint {
-		if endpoint.RegionIds.RegionId == string(region) {
+
+	catalog := &EndpointCatalog{}
+	switch format {
+	case "xml":
+		var endpoints Endpoints
+		if err := xml.Unmarshal(data, &endpoints); err != nil {
+			return nil, err
+		}
+		catalog.Regions = make(map[string]map[string]string)
+		for _, endpoint := range endpoints.Endpoint {
+			region := strings.TrimSpace(endpoint.RegionIds.RegionId)
+			if _, ok := catalog.Regions[region]; !ok {
+				catalog.Regions[region] = make(map[string]string)
+			}
 			for _, product := range endpoint.Products.Product {
-				if strings.ToLower(product.ProductName) == strings.ToLower(string(serviceCode)) {
-					return strings.TrimSpace(product.DomainName)
-				}
+				catalog.Regions[region][product.ProductName] = product.DomainName
 			}
 		}
+	case "json":
+		if err := json.Unmarshal(data, catalog); err != nil {
+			return nil, err
+		}
+	default:
+		if err := yaml.Unmarshal(data, catalog); err != nil {
+			return nil, err
+		}
+	}
+	return catalog, nil
+}
+
+// Resolve returns the endpoint of the product in the region and where it comes from. It returns an empty endpoint if
+// the catalog does not contain the product.
+func (catalog *EndpointCatalog) Resolve(region string, serviceCode ServiceCode) (string, string) {
+	if catalog == nil {
+		return "", ""
+	}
+	for r, products := range catalog.Regions {
+		if strings.TrimSpace(r) != region {
+			continue
+		}
+		if endpoint := lookupProductEndpoint(products, serviceCode); endpoint != "" {
+			return endpoint, EndpointSourceRegion
+		}
+	}
+	if endpoint := lookupProductEndpoint(catalog.Products, serviceCode); endpoint != "" {
+		return endpoint, EndpointSourceProduct
+	}
+	if fallback := strings.TrimSpace(catalog.Fallback); fallback != "" {
+		endpoint := strings.Replace(fallback, "{product}", strings.ToLower(string(serviceCode)), -1)
+		return strings.Replace(endpoint, "{region}", region, -1), EndpointSourceFallback
 	}
+	return "", ""
+}
 
+func lookupProductEndpoint(products map[string]string, serviceCode ServiceCode) string {
+	for name, endpoint := range products {
+		if strings.ToLower(strings.TrimSpace(name)) == strings.ToLower(string(serviceCode)) {
+			return strings.TrimSpace(endpoint)
+		}
+	}
 	return ""
 }
+
+// resolveEndpoint returns the custom endpoint of the product in the region and its source. The environment variable
+// <ServiceCode>_ENDPOINT takes precedence over the endpoint files.
+func resolveEndpoint(region string, serviceCode ServiceCode) (string, string) {
+	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", string(serviceCode))))
+	if endpoint != "" {
+		return endpoint, EndpointSourceEnvironment
+	}
+	return loadEndpointCatalog().Resolve(region, serviceCode)
+}
+
+func loadEndpoint(region string, serviceCode ServiceCode) string {
+	endpoint, _ := resolveEndpoint(region, serviceCode)
+	return endpoint
+}
+
+// configuredEndpoint returns the endpoint of the product set in the provider block endpoints { ... }.
+func (c *Config) configuredEndpoint(serviceCode ServiceCode) string {
+	switch serviceCode {
+	case ECSCode:
+		return c.EcsEndpoint
+	case ESSCode:
+		return c.EssEndpoint
+	case RAMCode:
+		return c.RamEndpoint
+	case VPCCode:
+		return c.VpcEndpoint
+	case SLBCode:
+		return c.SlbEndpoint
+	case RDSCode:
+		return c.RdsEndpoint
+	case OSSCode:
+		return c.OssEndpoint
+	case ONSCode:
+		return c.OnsEndpoint
+	case ALIKAFKACode:
+		return c.AlikafkaEndpoint
+	case CONTAINCode:
+		return c.CsEndpoint
+	case CRCode:
+		return c.CrEndpoint
+	case CDNCode:
+		return c.CdnEndpoint
+	case CMSCode:
+		return c.CmsEndpoint
+	case KMSCode:
+		return c.KmsEndpoint
+	case OTSCode:
+		return c.OtsEndpoint
+	case DNSCode:
+		return c.DnsEndpoint
+	case PVTZCode:
+		return c.PvtzEndpoint
+	case LOGCode:
+		return c.LogEndpoint
+	case FCCode:
+		return c.FcEndpoint
+	case DDSCode:
+		return c.DdsEndpoint
+	case GPDBCode:
+		return c.GpdbEnpoint
+	case STSCode:
+		return c.StsEndpoint
+	case CENCode:
+		return c.CenEndpoint
+	case KVSTORECode:
+		return c.KVStoreEndpoint
+	case DATAHUBCode:
+		return c.DatahubEndpoint
+	case MNSCode:
+		return c.MnsEndpoint
+	case CLOUDAPICode:
+		return c.ApigatewayEndpoint
+	case DRDSCode:
+		return c.DrdsEndpoint
+	case LOCATIONCode:
+		return c.LocationEndpoint
+	case ELASTICSEARCHCode:
+		return c.ElasticsearchEndpoint
+	case NASCode:
+		return c.NasEndpoint
+	case ACTIONTRAILCode:
+		return c.ActionTrailEndpoint
+	case BSSOPENAPICode:
+		return c.BssOpenApiEndpoint
+	case DDOSCOOCode:
+		return c.DdoscooEndpoint
+	case DDOSBGPCode:
+		return c.DdosbgpEndpoint
+	case SAGCode:
+		return c.SagEndpoint
+	case RESOURCEMANAGERCode:
+		return c.ResourceManagerEndpoint
+	}
+	return ""
+}
+
+// ResolveEndpoint returns the endpoint of the product and its source. The endpoint set in the provider block
+// takes precedence over the environment variable and the endpoint files. Without any custom endpoint, the default
+// endpoint of the SDK is returned, which is empty when the product is not in the endpoint table of the SDK.
+func (client *AliyunClient) ResolveEndpoint(serviceCode ServiceCode) (string, string) {
+	if endpoint := strings.TrimSpace(client.config.configuredEndpoint(serviceCode)); endpoint != "" {
+		return endpoint, EndpointSourceProvider
+	}
+	endpoint, source := resolveEndpoint(client.RegionId, serviceCode)
+	if endpoint == "" {
+		return sdkDefaultEndpoint(client.RegionId, serviceCode), EndpointSourceDefault
+	}
+	return endpoint, source
+}
+
+// sdkProductCodes are the product codes in the endpoint table of the SDK, which differ from the service codes of some
+// products. The products out of the table, like LOG and DATAHUB, have their own endpoint rules.
+var sdkProductCodes = map[ServiceCode]string{
+	ECSCode:           "ecs",
+	ESSCode:           "ess",
+	RAMCode:           "ram",
+	VPCCode:           "vpc",
+	SLBCode:           "slb",
+	RDSCode:           "rds",
+	OSSCode:           "oss",
+	ONSCode:           "ons",
+	ALIKAFKACode:      "alikafka",
+	CONTAINCode:       "cs",
+	CRCode:            "cr",
+	DOMAINCode:        "domain",
+	CDNCode:           "cdn",
+	CMSCode:           "cms",
+	KMSCode:           "kms",
+	OTSCode:           "ots",
+	DNSCode:           "alidns",
+	PVTZCode:          "pvtz",
+	FCCode:            "fc",
+	DDSCode:           "dds",
+	GPDBCode:          "gpdb",
+	STSCode:           "sts",
+	CENCode:           "cbn",
+	KVSTORECode:       "r-kvstore",
+	CLOUDAPICode:      "cloudapi",
+	DRDSCode:          "drds",
+	ELASTICSEARCHCode: "elasticsearch",
+	NASCode:           "nas",
+	ACTIONTRAILCode:   "actiontrail",
+	BSSOPENAPICode:    "bssopenapi",
+	DDOSCOOCode:       "ddoscoo",
+	DDOSBGPCode:       "ddosbgp",
+	SAGCode:           "smartag",
+	EMRCode:           "emr",
+	CASCode:           "cas",
+}
+
+// sdkDefaultEndpoint returns the endpoint of the product in the region resolved by the endpoint table of the SDK.
+// The location service is not called, so it is empty when the table has no endpoint of the product.
+func sdkDefaultEndpoint(region string, serviceCode ServiceCode) string {
+	product, ok := sdkProductCodes[serviceCode]
+	if !ok {
+		return ""
+	}
+	endpoint, err := endpoints.Resolve(&endpoints.ResolveParam{Product: product, RegionId: region})
+	if err != nil {
+		log.Printf("[DEBUG] The SDK has no default endpoint of %s in the region %s: %#v", serviceCode, region, err)
+		return ""
+	}
+	return endpoint
+}
@@ -0,0 +1,163 @@
+package connectivity
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ddosbgp"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ddoscoo"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
+)
+
+const testEndpointsXml = `<?xml version="1.0" encoding="utf-8"?>
+<Endpoints>
+  <Endpoint name="cn-hangzhou">
+    <RegionIds><RegionId>cn-hangzhou</RegionId></RegionIds>
+    <Products>
+      <Product><ProductName>ECS</ProductName><DomainName>ecs.xml.example.com</DomainName></Product>
+    </Products>
+  </Endpoint>
+</Endpoints>
+`
+
+const testEndpointsJson = `{
+  "products": {"ecs": "ecs.json.example.com", "vpc": "vpc.json.example.com"},
+  "regions": {"cn-beijing": {"ECS": "ecs-beijing.json.example.com"}},
+  "fallback": "{product}.{region}.json.example.com"
+}`
+
+const testEndpointsYaml = `
+products:
+  ecs: ecs.yaml.example.com
+regions:
+  cn-beijing:
+    ecs: ecs-beijing.yaml.example.com
+`
+
+func writeTestEndpointFile(t *testing.T, name, content string) string {
+	dir, err := ioutil.TempDir("", "endpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestParseEndpointFile(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		region   string
+		code     ServiceCode
+		endpoint string
+		source   string
+	}{
+		{"endpoints.xml", testEndpointsXml, "cn-hangzhou", ECSCode, "ecs.xml.example.com", EndpointSourceRegion},
+		{"endpoints.xml", testEndpointsXml, "cn-hangzhou", VPCCode, "", ""},
+		{"endpoints.json", testEndpointsJson, "cn-beijing", ECSCode, "ecs-beijing.json.example.com", EndpointSourceRegion},
+		{"endpoints.json", testEndpointsJson, "cn-hangzhou", ECSCode, "ecs.json.example.com", EndpointSourceProduct},
+		{"endpoints.json", testEndpointsJson, "cn-beijing", VPCCode, "vpc.json.example.com", EndpointSourceProduct},
+		{"endpoints.json", testEndpointsJson, "cn-shanghai", RDSCode, "rds.cn-shanghai.json.example.com", EndpointSourceFallback},
+		{"endpoints.yaml", testEndpointsYaml, "cn-beijing", ECSCode, "ecs-beijing.yaml.example.com", EndpointSourceRegion},
+		{"endpoints.yml", testEndpointsYaml, "cn-hangzhou", ECSCode, "ecs.yaml.example.com", EndpointSourceProduct},
+		{"endpoints", testEndpointsJson, "cn-hangzhou", ECSCode, "ecs.json.example.com", EndpointSourceProduct},
+		{"endpoints", testEndpointsYaml, "cn-hangzhou", ECSCode, "ecs.yaml.example.com", EndpointSourceProduct},
+	}
+	for _, c := range cases {
+		path := writeTestEndpointFile(t, c.name, c.content)
+		defer os.RemoveAll(filepath.Dir(path))
+
+		catalog, err := parseEndpointFile(path)
+		if err != nil {
+			t.Fatalf("parsing %s got an error: %#v.", c.name, err)
+		}
+		endpoint, source := catalog.Resolve(c.region, c.code)
+		if endpoint != c.endpoint || source != c.source {
+			t.Fatalf("%s: expected %s endpoint in %s to be %q from %q, got %q from %q.",
+				c.name, c.code, c.region, c.endpoint, c.source, endpoint, source)
+		}
+	}
+}
+
+func TestResolveEndpointPrecedence(t *testing.T) {
+	path := writeTestEndpointFile(t, "endpoints.json", testEndpointsJson)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	os.Setenv("TF_ENDPOINT_PATH", path)
+	defer os.Unsetenv("TF_ENDPOINT_PATH")
+	resetEndpointCatalogCache()
+	defer resetEndpointCatalogCache()
+
+	client := &AliyunClient{config: &Config{RegionId: "cn-beijing", SkipRegionValidation: true}, RegionId: "cn-beijing"}
+	if endpoint, source := client.ResolveEndpoint(ECSCode); endpoint != "ecs-beijing.json.example.com" || source != EndpointSourceRegion {
+		t.Fatalf("expected the region override, got %q from %q.", endpoint, source)
+	}
+
+	os.Setenv("ECS_ENDPOINT", "ecs.env.example.com")
+	defer os.Unsetenv("ECS_ENDPOINT")
+	if endpoint, source := client.ResolveEndpoint(ECSCode); endpoint != "ecs.env.example.com" || source != EndpointSourceEnvironment {
+		t.Fatalf("expected the environment variable, got %q from %q.", endpoint, source)
+	}
+
+	client.config.EcsEndpoint = "ecs.provider.example.com"
+	if endpoint, source := client.ResolveEndpoint(ECSCode); endpoint != "ecs.provider.example.com" || source != EndpointSourceProvider {
+		t.Fatalf("expected the provider endpoint, got %q from %q.", endpoint, source)
+	}
+
+	// The catalog is cached, so the changes of the file are not read again.
+	if err := ioutil.WriteFile(path, []byte(testEndpointsYaml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if endpoint, _ := client.ResolveEndpoint(VPCCode); endpoint != "vpc.json.example.com" {
+		t.Fatalf("expected the cached endpoint, got %q.", endpoint)
+	}
+}
+
+func TestResolveEndpointDefault(t *testing.T) {
+	resetEndpointCatalogCache()
+	defer resetEndpointCatalogCache()
+
+	client := &AliyunClient{config: &Config{RegionId: "cn-beijing", SkipRegionValidation: true}, RegionId: "cn-beijing"}
+	if endpoint, source := client.ResolveEndpoint(VPCCode); endpoint != "vpc.aliyuncs.com" || source != EndpointSourceDefault {
+		t.Fatalf("expected the default endpoint of the SDK, got %q from %q.", endpoint, source)
+	}
+	if endpoint, source := client.ResolveEndpoint(KVSTORECode); endpoint == "" || source != EndpointSourceDefault {
+		t.Fatalf("expected the default endpoint of the SDK product r-kvstore, got %q from %q.", endpoint, source)
+	}
+	if endpoint, source := client.ResolveEndpoint(DATAHUBCode); endpoint != "" || source != EndpointSourceDefault {
+		t.Fatalf("expected no default endpoint of the product out of the SDK table, got %q from %q.", endpoint, source)
+	}
+}
+
+func TestProviderEndpointsUsedByClients(t *testing.T) {
+	client := &AliyunClient{
+		config: &Config{RegionId: "cn-shenzhen", SkipRegionValidation: true, AccessKey: "ak", SecretKey: "sk", NasEndpoint: "nas.provider.example.com",
+			DdoscooEndpoint: "ddoscoo.provider.example.com", DdosbgpEndpoint: "ddosbgp.provider.example.com", CmsEndpoint: "cms.provider.example.com"},
+		RegionId: "cn-shenzhen",
+	}
+	domains := make(map[ServiceCode]string)
+	client.WithNasClient(func(conn *nas.Client) (interface{}, error) { domains[NASCode] = conn.Domain; return nil, nil })
+	client.WithDdoscooClient(func(conn *ddoscoo.Client) (interface{}, error) { domains[DDOSCOOCode] = conn.Domain; return nil, nil })
+	client.WithDdosbgpClient(func(conn *ddosbgp.Client) (interface{}, error) { domains[DDOSBGPCode] = conn.Domain; return nil, nil })
+	client.WithCmsClient(func(conn *cms.Client) (interface{}, error) { domains[CMSCode] = conn.Domain; return nil, nil })
+
+	for _, code := range []ServiceCode{NASCode, DDOSCOOCode, DDOSBGPCode, CMSCode} {
+		expected, source := client.ResolveEndpoint(code)
+		if source != EndpointSourceProvider {
+			t.Fatalf("expected the provider endpoint of %s, got %q from %q.", code, expected, source)
+		}
+		if domains[code] != expected {
+			t.Fatalf("the %s client should use the provider endpoint %q, got %q.", code, expected, domains[code])
+		}
+		if endpoint := endpoints.GetEndpointFromMap("cn-shenzhen", string(code)); endpoint != "" {
+			t.Fatalf("the %s endpoint should not be added into the endpoint mapping table of the SDK, got %q.", code, endpoint)
+		}
+	}
+}
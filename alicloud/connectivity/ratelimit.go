@@ -0,0 +1,113 @@
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter throttles the API calls on the client side before the server returns Throttling errors.
+// It keeps a token bucket for each product and each action which has a limit, and a call waits for a token
+// from both of the product bucket and the action bucket.
+type RateLimiter struct {
+	productLimits map[ServiceCode]int
+	actionLimits  map[string]int
+	limiters      map[string]*rate.Limiter
+	mutex         sync.Mutex
+}
+
+// NewRateLimiter returns a rate limiter. The limits are the max numbers of calls per second,
+// and the keys of actionLimits are in the format <product>:<action>, like ECS:RunInstances.
+func NewRateLimiter(productLimits map[ServiceCode]int, actionLimits map[string]int) *RateLimiter {
+	limiter := &RateLimiter{
+		productLimits: make(map[ServiceCode]int),
+		actionLimits:  make(map[string]int),
+		limiters:      make(map[string]*rate.Limiter),
+	}
+	for code, limit := range productLimits {
+		if limit > 0 {
+			limiter.productLimits[ServiceCode(strings.ToUpper(string(code)))] = limit
+		}
+	}
+	for key, limit := range actionLimits {
+		if limit > 0 {
+			limiter.actionLimits[strings.ToUpper(key)] = limit
+		}
+	}
+	return limiter
+}
+
+func RateLimitActionKey(serviceCode ServiceCode, action string) string {
+	return strings.ToUpper(fmt.Sprintf("%s:%s", serviceCode, action))
+}
+
+// Wait blocks until the call of the action of the product is allowed. An empty action only applies the product limit.
+func (r *RateLimiter) Wait(serviceCode ServiceCode, action string) {
+	if r == nil {
+		return
+	}
+	code := ServiceCode(strings.ToUpper(string(serviceCode)))
+	if limit, ok := r.productLimits[code]; ok {
+		r.wait(string(code), limit)
+	}
+	if action == "" {
+		return
+	}
+	key := RateLimitActionKey(code, action)
+	if limit, ok := r.actionLimits[key]; ok {
+		r.wait(key, limit)
+	}
+}
+
+func (r *RateLimiter) wait(key string, limit int) {
+	r.mutex.Lock()
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(limit), limit)
+		r.limiters[key] = limiter
+	}
+	r.mutex.Unlock()
+
+	if err := limiter.Wait(context.Background()); err != nil {
+		log.Printf("[WARN] Waiting for the rate limit of %s got an error: %#v.", key, err)
+	}
+}
+
+// limited reports whether the calls of the product or any of its actions are limited.
+func (r *RateLimiter) limited(serviceCode ServiceCode) bool {
+	if r == nil {
+		return false
+	}
+	code := ServiceCode(strings.ToUpper(string(serviceCode)))
+	if _, ok := r.productLimits[code]; ok {
+		return true
+	}
+	for key := range r.actionLimits {
+		if strings.HasPrefix(key, string(code)+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimitedRoundTripper takes the tokens of a product and its action before a request is sent, including the requests
+// retried by the SDK. It runs on the goroutine sending the request and holds no lock while it waits.
+type rateLimitedRoundTripper struct {
+	limiter     *RateLimiter
+	serviceCode ServiceCode
+	next        http.RoundTripper
+}
+
+func (t *rateLimitedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	action := ""
+	if req.URL != nil {
+		action = req.URL.Query().Get("Action")
+	}
+	t.limiter.Wait(t.serviceCode, action)
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,80 @@
+package connectivity
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterProductLimit(t *testing.T) {
+	limiter := NewRateLimiter(map[ServiceCode]int{"ecs": 5}, nil)
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		limiter.Wait(ECSCode, "DescribeInstances")
+	}
+	// The first 5 calls use the burst and the next 5 calls need 1 second.
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("10 ECS calls with a limit of 5 per second should take about 1 second, got %s.", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 10; i++ {
+		limiter.Wait(VPCCode, "DescribeVpcs")
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("the products without a limit should not wait, got %s.", elapsed)
+	}
+}
+
+func TestRateLimiterActionLimit(t *testing.T) {
+	limiter := NewRateLimiter(nil, map[string]int{RateLimitActionKey(ECSCode, "RunInstances"): 2})
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		limiter.Wait(ECSCode, "RunInstances")
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("4 RunInstances calls with a limit of 2 per second should take about 1 second, got %s.", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 10; i++ {
+		limiter.Wait(ECSCode, "DescribeInstances")
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("the other actions of the product should not wait, got %s.", elapsed)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRateLimitedRoundTripper(t *testing.T) {
+	limiter := NewRateLimiter(nil, map[string]int{"ECS:RUNINSTANCES": 1})
+	if !limiter.limited(ECSCode) || limiter.limited(RDSCode) {
+		t.Fatalf("only the ECS calls should be limited.")
+	}
+	sent := 0
+	roundTripper := &rateLimitedRoundTripper{limiter: limiter, serviceCode: ECSCode, next: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})}
+
+	request, _ := http.NewRequest("POST", "https://ecs.aliyuncs.com/?Action=RunInstances&Version=2014-05-26", nil)
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if _, err := roundTripper.RoundTrip(request); err != nil {
+			t.Fatalf("expected no error, got %#v.", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("the second RunInstances request should wait for about 1 second, got %s.", elapsed)
+	}
+	if sent != 2 {
+		t.Fatalf("expected 2 requests sent, got %d.", sent)
+	}
+}
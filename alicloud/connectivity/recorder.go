@@ -0,0 +1,379 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v2"
+)
+
+// RecorderMode decides whether a Recorder sends the requests and records them into its cassette, or answers them with
+// the recorded responses without any network access.
+type RecorderMode string
+
+const (
+	RecorderModeRecording = RecorderMode("recording")
+	RecorderModeReplaying = RecorderMode("replaying")
+)
+
+// volatileRequestParams change in every request or depend on the credential, so they are neither recorded nor compared.
+var volatileRequestParams = map[string]bool{
+	"ClientToken":    true,
+	"Signature":      true,
+	"SignatureNonce": true,
+	"Timestamp":      true,
+	"AccessKeyId":    true,
+	"SecurityToken":  true,
+}
+
+const base64BodyEncoding = "base64"
+
+// Cassette is the file written by a Recorder. Values keeps the generated values, like the random names used by a test,
+// which have to be the same when the requests are replayed.
+type Cassette struct {
+	Values       map[string]string `yaml:"values,omitempty"`
+	Interactions []*Interaction    `yaml:"interactions"`
+}
+
+type Interaction struct {
+	Request  RecordedRequest  `yaml:"request"`
+	Response RecordedResponse `yaml:"response"`
+}
+
+type RecordedRequest struct {
+	Method       string `yaml:"method"`
+	URL          string `yaml:"url"`
+	Body         string `yaml:"body,omitempty"`
+	BodyEncoding string `yaml:"body_encoding,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode   int                 `yaml:"status_code"`
+	Headers      map[string][]string `yaml:"headers,omitempty"`
+	Body         string              `yaml:"body,omitempty"`
+	BodyEncoding string              `yaml:"body_encoding,omitempty"`
+}
+
+// Recorder is a HTTP transport which records the API calls into a cassette, and replays them later. It is injected into
+// the clients by setting Config.Recorder, so that the CRUD logic of the resources can be tested without credentials.
+type Recorder struct {
+	mutex    sync.Mutex
+	path     string
+	mode     RecorderMode
+	cassette *Cassette
+	replayed []bool
+
+	defaultTransportIntercepted bool
+}
+
+// NewRecorder returns a recorder of the cassette file. In the replaying mode, the cassette must exist.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	recorder := &Recorder{
+		path:     path,
+		mode:     mode,
+		cassette: &Cassette{Values: make(map[string]string)},
+	}
+	if mode != RecorderModeReplaying {
+		return recorder, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading the cassette %s got an error: %#v", path, err)
+	}
+	if err := yaml.Unmarshal(data, recorder.cassette); err != nil {
+		return nil, fmt.Errorf("parsing the cassette %s got an error: %#v", path, err)
+	}
+	if recorder.cassette.Values == nil {
+		recorder.cassette.Values = make(map[string]string)
+	}
+	recorder.replayed = make([]bool, len(recorder.cassette.Interactions))
+	return recorder, nil
+}
+
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// Value returns the value of the key kept in the cassette. In the recording mode, the value is generated and kept.
+func (r *Recorder) Value(key string, generate func() string) string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if value, ok := r.cassette.Values[key]; ok {
+		return value
+	}
+	if r.mode == RecorderModeReplaying {
+		log.Printf("[WARN] The value %s is not recorded in the cassette %s, and a new one is generated.", key, r.path)
+	}
+	value := generate()
+	r.cassette.Values[key] = value
+	return value
+}
+
+// Stop writes the cassette in the recording mode, and restores the http.DefaultTransport if it was intercepted.
+func (r *Recorder) Stop() error {
+	r.restoreDefaultTransport()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.mode == RecorderModeReplaying {
+		for i, replayed := range r.replayed {
+			if !replayed {
+				log.Printf("[WARN] The interaction %s %s in the cassette %s is not replayed.", r.cassette.Interactions[i].Request.Method, r.cassette.Interactions[i].Request.URL, r.path)
+			}
+		}
+		return nil
+	}
+
+	data, err := yaml.Marshal(r.cassette)
+	if err != nil {
+		return fmt.Errorf("marshaling the cassette %s got an error: %#v", r.path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("creating the directory of the cassette %s got an error: %#v", r.path, err)
+	}
+	if err := ioutil.WriteFile(r.path, data, 0644); err != nil {
+		return fmt.Errorf("writing the cassette %s got an error: %#v", r.path, err)
+	}
+	return nil
+}
+
+// RoundTripper returns a transport which records the requests sent by the transport, or replays them.
+func (r *Recorder) RoundTripper(transport http.RoundTripper) http.RoundTripper {
+	return &recordedTransport{recorder: r, transport: transport}
+}
+
+// recordedHttpTransport returns a transport which sends the requests through the recorder and the transport.
+func (r *Recorder) recordedHttpTransport(transport *http.Transport) *http.Transport {
+	return roundTripperTransport(r.RoundTripper(transport))
+}
+
+// UseRecorder makes the product clients built later send their requests through the recorder.
+func (client *AliyunClient) UseRecorder(recorder *Recorder) {
+	client.config.Recorder = recorder
+}
+
+var defaultTransportMutex sync.Mutex
+var originalDefaultTransport http.RoundTripper
+
+// interceptDefaultTransport makes the http.DefaultTransport go through the recorder until it is stopped. It is used by
+// the clients which always use the default HTTP client, like the Log Service client.
+func (r *Recorder) interceptDefaultTransport() {
+	defaultTransportMutex.Lock()
+	defer defaultTransportMutex.Unlock()
+	if r.defaultTransportIntercepted {
+		return
+	}
+	if originalDefaultTransport == nil {
+		originalDefaultTransport = http.DefaultTransport
+	}
+	http.DefaultTransport = r.RoundTripper(originalDefaultTransport)
+	r.defaultTransportIntercepted = true
+}
+
+func (r *Recorder) restoreDefaultTransport() {
+	defaultTransportMutex.Lock()
+	defer defaultTransportMutex.Unlock()
+	if r.defaultTransportIntercepted {
+		http.DefaultTransport = originalDefaultTransport
+		r.defaultTransportIntercepted = false
+	}
+}
+
+func (r *Recorder) record(request RecordedRequest, response *http.Response, body []byte) {
+	interaction := &Interaction{
+		Request: request,
+		Response: RecordedResponse{
+			StatusCode: response.StatusCode,
+			Headers:    response.Header,
+		},
+	}
+	interaction.Response.Body, interaction.Response.BodyEncoding = encodeRecordedBody(body)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+}
+
+// replay answers the request with the first recorded interaction of the same request which has not been replayed,
+// so the polling requests get the responses in the recorded order. Once all of them have been replayed, the last one
+// is used for the extra polling requests.
+func (r *Recorder) replay(httpRequest *http.Request, request RecordedRequest) (*http.Response, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	matched := -1
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.Request != request {
+			continue
+		}
+		matched = i
+		if !r.replayed[i] {
+			break
+		}
+	}
+	if matched >= 0 {
+		interaction := r.cassette.Interactions[matched]
+		r.replayed[matched] = true
+
+		body, err := decodeRecordedBody(interaction.Response.Body, interaction.Response.BodyEncoding)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header(interaction.Response.Headers),
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       httpRequest,
+		}, nil
+	}
+	return nil, fmt.Errorf("there is no interaction for the request %s %s in the cassette %s", request.Method, request.URL, r.path)
+}
+
+type recordedTransport struct {
+	recorder  *Recorder
+	transport http.RoundTripper
+}
+
+func (t *recordedTransport) RoundTrip(httpRequest *http.Request) (*http.Response, error) {
+	var body []byte
+	if httpRequest.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(httpRequest.Body)
+		httpRequest.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		httpRequest.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	request := newRecordedRequest(httpRequest, body)
+
+	if t.recorder.mode == RecorderModeReplaying {
+		return t.recorder.replay(httpRequest, request)
+	}
+
+	response, err := t.transport.RoundTrip(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+	t.recorder.record(request, response, redactBody(responseBody))
+	return response, nil
+}
+
+// newRecordedRequest returns the request without the volatile parameters, which identifies the request in the cassette.
+// The sensitive values are redacted, and the same is done to the requests being replayed, so they still match.
+func newRecordedRequest(httpRequest *http.Request, body []byte) RecordedRequest {
+	uri := *httpRequest.URL
+	uri.RawQuery = recordedParams(uri.Query()).Encode()
+	request := RecordedRequest{
+		Method: httpRequest.Method,
+		URL:    uri.String(),
+	}
+	if strings.HasPrefix(httpRequest.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			body = []byte(recordedParams(form).Encode())
+		}
+	} else {
+		body = redactBody(body)
+	}
+	request.Body, request.BodyEncoding = encodeRecordedBody(body)
+	return request
+}
+
+// recordedParams removes the volatile parameters and redacts the sensitive ones, like Password and AccessKeySecret.
+func recordedParams(values url.Values) url.Values {
+	for key := range values {
+		if volatileRequestParams[key] {
+			values.Del(key)
+		} else if IsSensitiveField(key) {
+			values.Set(key, redactedValue)
+		}
+	}
+	return values
+}
+
+var xmlFieldPattern = regexp.MustCompile(`<([A-Za-z][A-Za-z0-9_]*)>[^<]*</([A-Za-z][A-Za-z0-9_]*)>`)
+
+// redactBody redacts the values of the sensitive fields of a JSON or XML body, like the AccessKeySecret and
+// the SecurityToken returned by STS. The other bodies are kept as they are.
+func redactBody(body []byte) []byte {
+	var data interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err == nil {
+		// The body is only rewritten when a value is redacted, so the others are recorded as they are
+		if !redactJsonValue(data) {
+			return body
+		}
+		if redacted, err := json.Marshal(data); err == nil {
+			return redacted
+		}
+		return body
+	}
+	return xmlFieldPattern.ReplaceAllFunc(body, func(field []byte) []byte {
+		names := xmlFieldPattern.FindSubmatch(field)
+		if string(names[1]) != string(names[2]) || !IsSensitiveField(string(names[1])) {
+			return field
+		}
+		return []byte(fmt.Sprintf("<%s>%s</%s>", names[1], redactedValue, names[1]))
+	})
+}
+
+// redactJsonValue redacts the sensitive string fields of the decoded JSON value in place, and reports whether any is redacted.
+func redactJsonValue(value interface{}) bool {
+	redacted := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if _, ok := field.(string); ok && IsSensitiveField(key) {
+				v[key] = redactedValue
+				redacted = true
+			} else if redactJsonValue(field) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if redactJsonValue(item) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+func encodeRecordedBody(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), base64BodyEncoding
+}
+
+func decodeRecordedBody(body, encoding string) ([]byte, error) {
+	if encoding == base64BodyEncoding {
+		return base64.StdEncoding.DecodeString(body)
+	}
+	return []byte(body), nil
+}
@@ -0,0 +1,186 @@
+package connectivity
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+// testRecordedCalls calls an RPC API by a SDK client built with getSdkConfig twice, and an OSS API once.
+func testRecordedCalls(t *testing.T, endpoint, accessKey string, recorder *Recorder) []string {
+	config := &Config{RegionId: "cn-hangzhou", SkipRegionValidation: true, AccessKey: accessKey, SecretKey: accessKey, OssEndpoint: "http://" + endpoint}
+	client := &AliyunClient{config: config, RegionId: config.RegionId, rateLimiter: NewRateLimiter(nil, nil)}
+	client.UseRecorder(recorder)
+
+	sdkClient, err := sdk.NewClientWithOptions(config.RegionId, client.getSdkConfig(ECSCode), config.getAuthCredential(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []string
+	for i := 0; i < 2; i++ {
+		request := requests.NewCommonRequest()
+		request.Scheme = "http"
+		request.Domain = endpoint
+		request.Product = "Ecs"
+		request.Version = "2014-05-26"
+		request.ApiName = "DescribeInstanceStatus"
+		request.QueryParams["InstanceId"] = "i-123"
+		response, err := sdkClient.ProcessCommonRequest(request)
+		if err != nil {
+			t.Fatalf("calling DescribeInstanceStatus got an error: %#v.", err)
+		}
+		results = append(results, response.GetHttpContentString())
+	}
+
+	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return ossClient.GetBucketACL("tf-test-bucket")
+	})
+	if err != nil {
+		t.Fatalf("calling GetBucketACL got an error: %#v.", err)
+	}
+	return append(results, raw.(oss.GetBucketACLResult).ACL)
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "TestRecorderRecordAndReplay.yaml")
+
+	polled := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("Action") == "DescribeInstanceStatus" {
+			polled++
+			status := "Starting"
+			if polled > 1 {
+				status = "Running"
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(fmt.Sprintf(`{"RequestId": "%d", "Status": "%s"}`, polled, status)))
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><AccessControlPolicy><Owner><ID>1</ID><DisplayName>1</DisplayName></Owner><AccessControlList><Grant>private</Grant></AccessControlList></AccessControlPolicy>`))
+	}))
+	endpoint := strings.TrimPrefix(server.URL, "http://")
+
+	recorder, err := NewRecorder(cassette, RecorderModeRecording)
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := recorder.Value("name", func() string { return "tf-testacc-123" })
+	recorded := testRecordedCalls(t, endpoint, "recorded-key", recorder)
+	if err := recorder.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	data, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "recorded-key") || strings.Contains(string(data), "Signature=") {
+		t.Fatalf("the credential and the signature should not be recorded:\n%s", data)
+	}
+
+	recorder, err = NewRecorder(cassette, RecorderModeReplaying)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value := recorder.Value("name", func() string { return "tf-testacc-456" }); value != name {
+		t.Fatalf("expected the recorded value %s, got %s.", name, value)
+	}
+	replayed := testRecordedCalls(t, endpoint, "replayed-key", recorder)
+	if strings.Join(replayed, "|") != strings.Join(recorded, "|") {
+		t.Fatalf("the replayed responses %v are different from the recorded %v.", replayed, recorded)
+	}
+	if !strings.Contains(replayed[0], "Starting") || !strings.Contains(replayed[1], "Running") || replayed[2] != "private" {
+		t.Fatalf("the polling responses should be replayed in the recorded order, got %v.", replayed)
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewRecorder(filepath.Join(dir, "not-exist.yaml"), RecorderModeReplaying); err == nil {
+		t.Fatalf("expected an error for a missing cassette.")
+	}
+}
+
+func TestRecorderRedactsSensitiveValues(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "TestRecorderRedactsSensitiveValues.yaml")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"RequestId": "1", "Credentials": {"AccessKeyId": "STS.id", "AccessKeySecret": "sts-secret", "SecurityToken": "sts-token"}}`))
+	}))
+	defer server.Close()
+
+	call := func(recorder *Recorder) string {
+		client := &AliyunClient{config: &Config{RegionId: "cn-hangzhou"}, RegionId: "cn-hangzhou", rateLimiter: NewRateLimiter(nil, nil)}
+		client.UseRecorder(recorder)
+		request, _ := http.NewRequest("GET", server.URL+"/?Action=AssumeRole&Password=db-password&RoleArn=acs:ram::1:role/test", nil)
+		response, err := (&http.Client{Transport: client.getTransport(STSCode)}).Do(request)
+		if err != nil {
+			t.Fatalf("calling AssumeRole got an error: %#v.", err)
+		}
+		defer response.Body.Close()
+		body, _ := ioutil.ReadAll(response.Body)
+		return string(body)
+	}
+
+	recorder, err := NewRecorder(cassette, RecorderModeRecording)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := call(recorder); !strings.Contains(body, "sts-secret") {
+		t.Fatalf("the recorded call should get the origin response, got %s.", body)
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"db-password", "sts-secret", "sts-token"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("the cassette should not contain %s:\n%s", secret, data)
+		}
+	}
+
+	recorder, err = NewRecorder(cassette, RecorderModeReplaying)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := call(recorder); !strings.Contains(body, "STS.id") {
+		t.Fatalf("the redacted request should still match the recorded one, got %s.", body)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	xml := `<AssumeRoleResponse><Credentials><AccessKeySecret>secret</AccessKeySecret><Expiration>2019</Expiration></Credentials></AssumeRoleResponse>`
+	if redacted := string(redactBody([]byte(xml))); strings.Contains(redacted, ">secret<") || !strings.Contains(redacted, "<Expiration>2019</Expiration>") {
+		t.Fatalf("only the sensitive XML fields should be redacted, got %s.", redacted)
+	}
+	plain := `{"RequestId": "1"}`
+	if redacted := string(redactBody([]byte(plain))); redacted != plain {
+		t.Fatalf("a body without sensitive fields should be kept as it is, got %s.", redacted)
+	}
+}
@@ -0,0 +1,243 @@
+package connectivity
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/drds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/gpdb"
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+)
+
+// describeRegionsFunc returns the ids of the regions in which a product is available.
+type describeRegionsFunc func(client *AliyunClient) ([]string, error)
+
+// productRegionApis are the DescribeRegions APIs of the products whose availability can be checked.
+// The regions of ECS are the regions of Alibaba Cloud, and they are used to validate the region of the provider.
+// It is set by init because the product clients validate the region by it.
+var productRegionApis map[ServiceCode]describeRegionsFunc
+
+func init() {
+	productRegionApis = map[ServiceCode]describeRegionsFunc{
+		ECSCode: func(client *AliyunClient) ([]string, error) {
+			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+				return ecsClient.DescribeRegions(ecs.CreateDescribeRegionsRequest())
+			})
+			if err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, region := range raw.(*ecs.DescribeRegionsResponse).Regions.Region {
+				ids = append(ids, region.RegionId)
+			}
+			return ids, nil
+		},
+		RDSCode: func(client *AliyunClient) ([]string, error) {
+			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+				return rdsClient.DescribeRegions(rds.CreateDescribeRegionsRequest())
+			})
+			if err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, region := range raw.(*rds.DescribeRegionsResponse).Regions.RDSRegion {
+				ids = append(ids, region.RegionId)
+			}
+			return ids, nil
+		},
+		KVSTORECode: func(client *AliyunClient) ([]string, error) {
+			raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+				return rkvClient.DescribeRegions(r_kvstore.CreateDescribeRegionsRequest())
+			})
+			if err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, region := range raw.(*r_kvstore.DescribeRegionsResponse).RegionIds.KVStoreRegion {
+				ids = append(ids, region.RegionId)
+			}
+			return ids, nil
+		},
+		SLBCode: func(client *AliyunClient) ([]string, error) {
+			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
+				return slbClient.DescribeRegions(slb.CreateDescribeRegionsRequest())
+			})
+			if err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, region := range raw.(*slb.DescribeRegionsResponse).Regions.Region {
+				ids = append(ids, region.RegionId)
+			}
+			return ids, nil
+		},
+		DDSCode: func(client *AliyunClient) ([]string, error) {
+			raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+				return ddsClient.DescribeRegions(dds.CreateDescribeRegionsRequest())
+			})
+			if err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, region := range raw.(*dds.DescribeRegionsResponse).Regions.DdsRegion {
+				ids = append(ids, region.RegionId)
+			}
+			return ids, nil
+		},
+		GPDBCode: func(client *AliyunClient) ([]string, error) {
+			raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
+				return gpdbClient.DescribeRegions(gpdb.CreateDescribeRegionsRequest())
+			})
+			if err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, region := range raw.(*gpdb.DescribeRegionsResponse).Regions.Region {
+				ids = append(ids, region.RegionId)
+			}
+			return ids, nil
+		},
+		DRDSCode: func(client *AliyunClient) ([]string, error) {
+			raw, err := client.WithDrdsClient(func(drdsClient *drds.Client) (interface{}, error) {
+				return drdsClient.DescribeRegions(drds.CreateDescribeRegionsRequest())
+			})
+			if err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, region := range raw.(*drds.DescribeRegionsResponse).DrdsRegions.DrdsRegion {
+				ids = append(ids, region.RegionId)
+			}
+			return ids, nil
+		},
+		CLOUDAPICode: func(client *AliyunClient) ([]string, error) {
+			raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
+				return cloudApiClient.DescribeRegions(cloudapi.CreateDescribeRegionsRequest())
+			})
+			if err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, region := range raw.(*cloudapi.DescribeRegionsResponse).Regions.Region {
+				ids = append(ids, region.RegionId)
+			}
+			return ids, nil
+		},
+	}
+}
+
+// regionCache keeps the regions returned by the DescribeRegions APIs, so every API is called at most once per
+// product and account in a provider process. The lock only guards the map, it is not held while calling the API.
+var regionCache = struct {
+	sync.Mutex
+	regions map[string][]Region
+}{regions: make(map[string][]Region)}
+
+func resetRegionCache() {
+	regionCache.Lock()
+	defer regionCache.Unlock()
+	regionCache.regions = make(map[string][]Region)
+}
+
+// regionCacheIdentity identifies the account whose regions are cached. The access key is empty or temporary when the
+// credential comes from a RAM role, an OIDC role, an ECS role or an external process, so they are used instead.
+func (c *Config) regionCacheIdentity() string {
+	if hops := c.assumeRoleHops(); len(hops) > 0 {
+		return hops[len(hops)-1].RoleArn
+	}
+	switch {
+	case c.OIDCRoleArn != "":
+		return c.OIDCRoleArn
+	case c.EcsRoleName != "":
+		return "ecs-role:" + c.EcsRoleName
+	case c.CredentialProcess != "":
+		return "process:" + c.CredentialProcess
+	}
+	return c.AccessKey
+}
+
+// DescribeRegions returns the regions in which the product is available for the current account.
+func (client *AliyunClient) DescribeRegions(serviceCode ServiceCode) ([]Region, error) {
+	describe, ok := productRegionApis[serviceCode]
+	if !ok {
+		return nil, fmt.Errorf("the regions of the product %s can not be described", serviceCode)
+	}
+
+	key := fmt.Sprintf("%s:%s:%s", serviceCode, client.config.regionCacheIdentity(), client.config.configuredEndpoint(serviceCode))
+	regionCache.Lock()
+	regions, ok := regionCache.regions[key]
+	regionCache.Unlock()
+	if ok {
+		return regions, nil
+	}
+
+	ids, err := describe(client)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			regions = append(regions, Region(id))
+		}
+	}
+	regionCache.Lock()
+	regionCache.regions[key] = regions
+	regionCache.Unlock()
+	return regions, nil
+}
+
+// checkRegion validates the region of the provider the first time a product client is built, so the provider can be
+// configured without any API call. The ECS client is not checked because the regions are described by it, and it
+// calls DescribeRegions itself when it is built.
+func (client *AliyunClient) checkRegion(serviceCode ServiceCode) error {
+	if client.config.SkipRegionValidation || serviceCode == ECSCode {
+		return nil
+	}
+	client.regionValidation.Do(func() {
+		client.regionError = client.validateRegion()
+	})
+	return client.regionError
+}
+
+// validateRegion checks the region of the provider against the regions returned by the ECS DescribeRegions API.
+// The static ValidRegions is only used when the API can not be reached, like working offline.
+func (client *AliyunClient) validateRegion() error {
+	regions, err := client.DescribeRegions(ECSCode)
+	if err != nil {
+		log.Printf("[WARN] Describing the regions got an error and the built-in region list is used instead: %#v", err)
+		regions = ValidRegions
+	}
+	for _, valid := range regions {
+		if client.Region == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid Alibaba Cloud region: %s", client.RegionId)
+}
+
+// IsProductAvailable reports whether the product is available in the region of the provider. It always returns true
+// when skip_region_validation is set or the product regions can not be described.
+func (client *AliyunClient) IsProductAvailable(serviceCode ServiceCode) bool {
+	if client.config.SkipRegionValidation {
+		return true
+	}
+	regions, err := client.DescribeRegions(serviceCode)
+	if err != nil {
+		log.Printf("[WARN] Describing the regions of %s got an error and its availability is not checked: %#v", serviceCode, err)
+		return true
+	}
+	for _, region := range regions {
+		if client.Region == region {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package connectivity
+
+import (
+	"fmt"
+	"testing"
+)
+
+func testRegionClient(region Region, describe describeRegionsFunc) (*AliyunClient, *int) {
+	calls := 0
+	productRegionApis["TEST"] = func(client *AliyunClient) ([]string, error) {
+		calls++
+		return describe(client)
+	}
+	productRegionApis[ECSCode] = productRegionApis["TEST"]
+	return &AliyunClient{
+		config:   &Config{AccessKey: "access-key", Region: region, RegionId: string(region)},
+		Region:   region,
+		RegionId: string(region),
+	}, &calls
+}
+
+func TestAliyunClientDescribeRegionsCached(t *testing.T) {
+	defer func(ecsApi describeRegionsFunc) {
+		productRegionApis[ECSCode] = ecsApi
+		delete(productRegionApis, "TEST")
+		resetRegionCache()
+	}(productRegionApis[ECSCode])
+	resetRegionCache()
+
+	client, calls := testRegionClient(Region("cn-newregion-1"), func(*AliyunClient) ([]string, error) {
+		return []string{"cn-hangzhou", "cn-newregion-1", "cn-newregion-1"}, nil
+	})
+	for i := 0; i < 3; i++ {
+		regions, err := client.DescribeRegions("TEST")
+		if err != nil {
+			t.Fatalf("DescribeRegions got an error: %#v", err)
+		}
+		if len(regions) != 2 {
+			t.Fatalf("expected the duplicated regions to be removed, got %v", regions)
+		}
+	}
+	if *calls != 1 {
+		t.Fatalf("expected the DescribeRegions API to be called once, got %d", *calls)
+	}
+
+	if err := client.validateRegion(); err != nil {
+		t.Fatalf("expected the region returned by DescribeRegions to be valid, got %#v", err)
+	}
+	if !client.IsProductAvailable("TEST") {
+		t.Fatalf("expected the product to be available in %s", client.RegionId)
+	}
+	if _, err := client.DescribeRegions("UNKNOWN"); err == nil {
+		t.Fatalf("expected an error for a product without DescribeRegions API")
+	}
+}
+
+func TestAliyunClientValidateRegionFallback(t *testing.T) {
+	defer func(ecsApi describeRegionsFunc) {
+		productRegionApis[ECSCode] = ecsApi
+		delete(productRegionApis, "TEST")
+		resetRegionCache()
+	}(productRegionApis[ECSCode])
+
+	offline := func(*AliyunClient) ([]string, error) {
+		return nil, fmt.Errorf("dial tcp: lookup ecs.aliyuncs.com: no such host")
+	}
+
+	resetRegionCache()
+	client, _ := testRegionClient(Hangzhou, offline)
+	if err := client.validateRegion(); err != nil {
+		t.Fatalf("expected the built-in region to be valid offline, got %#v", err)
+	}
+	if !client.IsProductAvailable("TEST") {
+		t.Fatalf("expected the availability not to be checked offline")
+	}
+
+	resetRegionCache()
+	client, _ = testRegionClient(Region("cn-newregion-1"), offline)
+	if err := client.validateRegion(); err == nil {
+		t.Fatalf("expected the unknown region to be invalid offline")
+	}
+
+	resetRegionCache()
+	client, _ = testRegionClient(Region("cn-newregion-1"), func(*AliyunClient) ([]string, error) {
+		return []string{"cn-hangzhou"}, nil
+	})
+	if client.IsProductAvailable("TEST") {
+		t.Fatalf("expected the product not to be available in %s", client.RegionId)
+	}
+	client.config.SkipRegionValidation = true
+	if !client.IsProductAvailable("TEST") {
+		t.Fatalf("expected the availability not to be checked when skip_region_validation is set")
+	}
+}
+
+func TestAliyunClientCheckRegionLazily(t *testing.T) {
+	defer func(ecsApi describeRegionsFunc) {
+		productRegionApis[ECSCode] = ecsApi
+		delete(productRegionApis, "TEST")
+		resetRegionCache()
+	}(productRegionApis[ECSCode])
+	resetRegionCache()
+
+	// The lock of the cache is not held while describing, so the API can describe the regions of another product.
+	client, calls := testRegionClient(Region("cn-newregion-1"), func(client *AliyunClient) ([]string, error) {
+		if _, err := client.DescribeRegions(ECSCode); err != nil {
+			return nil, err
+		}
+		return []string{"cn-hangzhou"}, nil
+	})
+	productRegionApis[ECSCode] = func(*AliyunClient) ([]string, error) {
+		return []string{"cn-hangzhou"}, nil
+	}
+	if *calls != 0 {
+		t.Fatalf("expected no API call before a product client is built, got %d", *calls)
+	}
+	for i := 0; i < 2; i++ {
+		if err := client.checkRegion(SLBCode); err == nil {
+			t.Fatalf("expected the unknown region to be invalid")
+		}
+	}
+	if err := client.checkRegion(ECSCode); err != nil {
+		t.Fatalf("expected the ECS client not to be checked, got %#v", err)
+	}
+	if client.IsProductAvailable("TEST") {
+		t.Fatalf("expected the product not to be available in %s", client.RegionId)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected the DescribeRegions API of the product to be called once, got %d", *calls)
+	}
+}
+
+func TestConfigRegionCacheIdentity(t *testing.T) {
+	config := &Config{AccessKey: "access-key"}
+	if identity := config.regionCacheIdentity(); identity != "access-key" {
+		t.Fatalf("expected the access key to identify the account, got %s", identity)
+	}
+	config = &Config{OIDCRoleArn: "acs:ram::1:role/oidc"}
+	if identity := config.regionCacheIdentity(); identity != "acs:ram::1:role/oidc" {
+		t.Fatalf("expected the OIDC role to identify the account, got %s", identity)
+	}
+	config = &Config{AccessKey: "access-key", AssumeRoleHops: []AssumeRoleHop{{RoleArn: "acs:ram::1:role/first"}, {RoleArn: "acs:ram::2:role/last"}}}
+	if identity := config.regionCacheIdentity(); identity != "acs:ram::2:role/last" {
+		t.Fatalf("expected the last assumed role to identify the account, got %s", identity)
+	}
+	if identity := (&Config{EcsRoleName: "ecs-role"}).regionCacheIdentity(); identity == "" {
+		t.Fatalf("expected the ECS role to identify the account")
+	}
+}
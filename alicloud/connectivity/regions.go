@@ -14,12 +14,15 @@ const (
 	Zhangjiakou = Region("cn-zhangjiakou")
 	Huhehaote   = Region("cn-huhehaote")
 	ChengDu     = Region("cn-chengdu")
+	Wulanchabu  = Region("cn-wulanchabu")
+	Heyuan      = Region("cn-heyuan")
 
 	APSouthEast1 = Region("ap-southeast-1")
 	APNorthEast1 = Region("ap-northeast-1")
 	APSouthEast2 = Region("ap-southeast-2")
 	APSouthEast3 = Region("ap-southeast-3")
 	APSouthEast5 = Region("ap-southeast-5")
+	APSouthEast6 = Region("ap-southeast-6")
 
 	APSouth1 = Region("ap-south-1")
 
@@ -35,10 +38,11 @@ const (
 	ShanghaiFinance = Region("cn-shanghai-finance-1")
 )
 
+// ValidRegions is only used to validate the region when the DescribeRegions API can not be reached, like working offline.
 var ValidRegions = []Region{
-	Hangzhou, Qingdao, Beijing, Shenzhen, Hongkong, Shanghai, Zhangjiakou, Huhehaote, ChengDu,
+	Hangzhou, Qingdao, Beijing, Shenzhen, Hongkong, Shanghai, Zhangjiakou, Huhehaote, ChengDu, Wulanchabu, Heyuan,
 	USWest1, USEast1,
-	APNorthEast1, APSouthEast1, APSouthEast2, APSouthEast3, APSouthEast5,
+	APNorthEast1, APSouthEast1, APSouthEast2, APSouthEast3, APSouthEast5, APSouthEast6,
 	APSouth1,
 	MEEast1,
 	EUCentral1, EUWest1,
@@ -47,9 +51,7 @@ var ValidRegions = []Region{
 var EcsClassicSupportedRegions = []Region{Shenzhen, Shanghai, Beijing, Qingdao, Hangzhou, Hongkong, USWest1, APSouthEast1}
 var EcsSpotNoSupportedRegions = []Region{APSouth1}
 var SlbGuaranteedSupportedRegions = []Region{Qingdao, Beijing, Hangzhou, Shanghai, Shenzhen, Zhangjiakou, Huhehaote, APSouthEast1, USEast1}
-var DrdsSupportedRegions = []Region{Beijing, Shenzhen, Hangzhou, Qingdao, Hongkong, Shanghai, Huhehaote, Zhangjiakou}
 var DrdsClassicNoSupportedRegions = []Region{Hongkong}
-var GpdbSupportedRegions = []Region{Beijing, Shenzhen, Hangzhou, Shanghai, Hongkong}
 
 // Some Ram resources only one can be owned by one account at the same time,
 // skipped here to avoid multi regions concurrency conflict.
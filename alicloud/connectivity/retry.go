@@ -0,0 +1,90 @@
+package connectivity
+
+import (
+	"math/rand"
+	"time"
+)
+
+const DefaultRetryMaxAttempts = 10
+
+const DefaultRetryMaxBackoff = 30 * time.Second
+
+// RetryPolicy describes how the failed API calls are retried. It is set by the provider block retry { ... },
+// and every product can override it by product_override { ... }.
+type RetryPolicy struct {
+	// The max number of attempts, including the first one, before giving up.
+	MaxAttempts int
+	// The upper limit of the waiting time between two attempts.
+	MaxBackoff time.Duration
+	// Whether to randomize the waiting time to spread the retries of the concurrent calls.
+	Jitter bool
+	// The error codes which should be retried besides the default throttling and service busy codes.
+	ExtraRetryableCodes []string
+	// The Jitter of an override policy, nil means inheriting the Jitter of the policy it is merged into.
+	JitterOverride *bool
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		MaxBackoff:  DefaultRetryMaxBackoff,
+		Jitter:      true,
+	}
+}
+
+// Merge returns a new policy which uses the non-zero fields of the override and inherits the others from the policy.
+// The extra retryable codes of both policies are combined.
+func (p RetryPolicy) Merge(override RetryPolicy) RetryPolicy {
+	merged := p
+	if override.MaxAttempts > 0 {
+		merged.MaxAttempts = override.MaxAttempts
+	}
+	if override.MaxBackoff > 0 {
+		merged.MaxBackoff = override.MaxBackoff
+	}
+	if override.JitterOverride != nil {
+		merged.Jitter = *override.JitterOverride
+	}
+	merged.ExtraRetryableCodes = append(append([]string{}, p.ExtraRetryableCodes...), override.ExtraRetryableCodes...)
+	return merged
+}
+
+// Backoff returns the waiting time before the next attempt after the specified attempt failed.
+// The waiting time starts from base and doubles after each failed attempt, but never exceeds MaxBackoff.
+// If Jitter is enabled, the waiting time is a random value between a half of it and itself.
+func (p RetryPolicy) Backoff(attempt int, base time.Duration) time.Duration {
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+	backoff := base
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	if p.Jitter && backoff > 1 {
+		half := backoff / 2
+		backoff = half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+	}
+	return backoff
+}
+
+// GetRetryPolicy returns the retry policy of the specified product.
+func (client *AliyunClient) GetRetryPolicy(serviceCode ServiceCode) RetryPolicy {
+	if client == nil || client.config == nil {
+		return DefaultRetryPolicy()
+	}
+	policy := client.config.RetryPolicy
+	if policy.MaxAttempts <= 0 {
+		policy = DefaultRetryPolicy()
+	}
+	if override, ok := client.config.ProductRetryPolicies[serviceCode]; ok {
+		policy = policy.Merge(override)
+	}
+	return policy
+}
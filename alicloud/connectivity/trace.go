@@ -0,0 +1,297 @@
+package connectivity
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The structured trace is enabled by TF_ALICLOUD_TRACE=json. Every API call is written as one JSON line into the file
+// specified by TF_ALICLOUD_TRACE_FILE, or DefaultTraceFile in the current path.
+const (
+	TraceEnv         = "TF_ALICLOUD_TRACE"
+	TraceFileEnv     = "TF_ALICLOUD_TRACE_FILE"
+	DefaultTraceFile = "terraform-provider-alicloud-trace.jsonl"
+)
+
+const redactedValue = "******"
+
+const maxTraceAttempts = 1024
+
+// sensitiveFieldKeywords are the parts of the request parameter names whose values must not be written into the trace
+// or the debug logs, like Password, KmsEncryptedPassword, SecurityToken and Signature.
+var sensitiveFieldKeywords = []string{"password", "secret", "token", "signature", "privatekey", "plaintext", "credential", "authkey"}
+
+// insensitiveFields are the common request parameters which match the keywords but carry no secret.
+var insensitiveFields = map[string]bool{
+	"SignatureMethod":  true,
+	"SignatureNonce":   true,
+	"SignatureType":    true,
+	"SignatureVersion": true,
+}
+
+// IsSensitiveField reports whether the value of the request parameter or field should be redacted.
+func IsSensitiveField(name string) bool {
+	if insensitiveFields[name] {
+		return false
+	}
+	name = strings.ToLower(name)
+	for _, keyword := range sensitiveFieldKeywords {
+		if strings.Contains(name, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactParams returns a copy of the request parameters in which the sensitive values are redacted.
+// The AccessKeyId is partially kept to tell the credentials apart.
+func RedactParams(params map[string]string) map[string]string {
+	redacted := make(map[string]string, len(params))
+	for key, value := range params {
+		switch {
+		case IsSensitiveField(key):
+			redacted[key] = redactedValue
+		case key == "AccessKeyId" && len(value) > 8:
+			redacted[key] = value[:4] + redactedValue + value[len(value)-4:]
+		default:
+			redacted[key] = value
+		}
+	}
+	return redacted
+}
+
+// TraceRecord is a line of the structured trace.
+type TraceRecord struct {
+	Time       string            `json:"time"`
+	Product    string            `json:"product"`
+	Action     string            `json:"action,omitempty"`
+	Path       string            `json:"path,omitempty"`
+	Caller     string            `json:"caller,omitempty"`
+	Region     string            `json:"region,omitempty"`
+	RequestId  string            `json:"request_id,omitempty"`
+	StatusCode int               `json:"status_code,omitempty"`
+	LatencyMs  int64             `json:"latency_ms"`
+	RetryCount int               `json:"retry_count"`
+	ErrorCode  string            `json:"error_code,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	Params     map[string]string `json:"params,omitempty"`
+}
+
+// Tracer writes the trace records. It counts the failed attempts of the same request, including the retries of the SDK
+// and the provider, so the retry count of a record is the number of the failed attempts before it.
+type Tracer struct {
+	mutex    sync.Mutex
+	out      io.Writer
+	attempts map[string]int
+	now      func() time.Time
+}
+
+func NewTracer(out io.Writer) *Tracer {
+	return &Tracer{
+		out:      out,
+		attempts: make(map[string]int),
+		now:      time.Now,
+	}
+}
+
+var tracerOnce sync.Once
+var defaultTracer *Tracer
+
+// getTracer returns the tracer configured by the environment variables, or nil if the trace is disabled.
+func getTracer() *Tracer {
+	tracerOnce.Do(func() {
+		if strings.ToLower(strings.TrimSpace(os.Getenv(TraceEnv))) != "json" {
+			return
+		}
+		path := strings.TrimSpace(os.Getenv(TraceFileEnv))
+		if path == "" {
+			path = DefaultTraceFile
+		}
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Printf("[WARN] Opening the trace file %s got an error: %#v. The trace is disabled.", path, err)
+			return
+		}
+		defaultTracer = NewTracer(file)
+	})
+	return defaultTracer
+}
+
+// Write writes the record as a JSON line. The attempt key identifies the same request across its attempts;
+// an empty key means the retries can not be counted.
+func (t *Tracer) Write(record TraceRecord, attemptKey string) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	record.Time = t.now().UTC().Format(time.RFC3339Nano)
+	if attemptKey != "" {
+		record.RetryCount = t.attempts[attemptKey]
+		if record.ErrorCode == "" && record.Error == "" && (record.StatusCode == 0 || record.StatusCode < 400) {
+			delete(t.attempts, attemptKey)
+		} else {
+			// The requests which are never retried would stay in the map forever, so it is dropped once it grows too large.
+			if len(t.attempts) >= maxTraceAttempts {
+				t.attempts = make(map[string]int)
+			}
+			t.attempts[attemptKey] = record.RetryCount + 1
+		}
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		log.Printf("[WARN] Marshaling the trace record got an error: %#v.", err)
+		return
+	}
+	if _, err := t.out.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] Writing the trace record got an error: %#v.", err)
+	}
+}
+
+// sdkTraceTemplate is the log template of the Go SDK clients. The SDK writes a log line for each HTTP attempt, and the
+// fields are separated by the unit separator, since their values are not escaped.
+const sdkTraceTemplate = "\x1e{method}\x1f{uri}\x1f{code}\x1f{cost}\x1f{error}\x1f{res_body}"
+
+// sdkTraceWriter receives the log lines of a Go SDK client and turns them into trace records.
+type sdkTraceWriter struct {
+	tracer      *Tracer
+	serviceCode ServiceCode
+	regionId    string
+}
+
+func (w *sdkTraceWriter) Write(p []byte) (int, error) {
+	line := string(p)
+	start := strings.Index(line, "\x1e")
+	if start < 0 {
+		return len(p), nil
+	}
+	fields := strings.SplitN(strings.TrimSuffix(line[start+1:], "\n"), "\x1f", 6)
+	if len(fields) < 6 {
+		return len(p), nil
+	}
+	record := TraceRecord{
+		Product: string(w.serviceCode),
+		Region:  w.regionId,
+		Error:   fields[4],
+	}
+	record.StatusCode, _ = strconv.Atoi(fields[2])
+	if cost, err := time.ParseDuration(fields[3]); err == nil {
+		record.LatencyMs = int64(cost / time.Millisecond)
+	}
+
+	attemptKey := ""
+	if uri, err := url.Parse(fields[1]); err == nil {
+		params := make(map[string]string)
+		for key, values := range uri.Query() {
+			params[key] = strings.Join(values, ",")
+		}
+		record.Action = params["Action"]
+		if record.Action == "" {
+			record.Path = uri.Path
+		}
+		if region := params["RegionId"]; region != "" {
+			record.Region = region
+		}
+		attemptKey = sdkAttemptKey(w.serviceCode, fields[0], uri.Path, params)
+		record.Params = RedactParams(params)
+	}
+
+	var body struct {
+		RequestId string
+		Code      string
+	}
+	if fields[5] != "" && json.Unmarshal([]byte(fields[5]), &body) == nil {
+		record.RequestId = body.RequestId
+		if record.StatusCode >= 400 {
+			record.ErrorCode = body.Code
+		}
+	}
+	w.tracer.Write(record, attemptKey)
+	return len(p), nil
+}
+
+// sdkAttemptKey identifies a request by its parameters, except the ones which change in every attempt.
+func sdkAttemptKey(serviceCode ServiceCode, method, path string, params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if key == "Signature" || key == "SignatureNonce" || key == "Timestamp" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	digest := md5.New()
+	fmt.Fprintf(digest, "%s %s %s", serviceCode, method, path)
+	for _, key := range keys {
+		fmt.Fprintf(digest, "&%s=%s", key, params[key])
+	}
+	return fmt.Sprintf("%x", digest.Sum(nil))
+}
+
+type sdkLogger interface {
+	SetLogger(level string, channel string, out io.Writer, template string)
+}
+
+// traceSdkClient makes the Go SDK client write its API calls into the trace if it is enabled.
+func (client *AliyunClient) traceSdkClient(serviceCode ServiceCode, sdkClient sdkLogger) {
+	tracer := getTracer()
+	if tracer == nil {
+		return
+	}
+	sdkClient.SetLogger("", "", &sdkTraceWriter{tracer: tracer, serviceCode: serviceCode, regionId: client.RegionId}, sdkTraceTemplate)
+}
+
+// traceCall runs do and writes it into the trace if it is enabled. It is used by the clients which are not built on the
+// Go SDK, whose calls can not be observed per HTTP request, so the function calling the client is recorded instead
+// of the action.
+func (client *AliyunClient) traceCall(serviceCode ServiceCode, do func() (interface{}, error)) (interface{}, error) {
+	tracer := getTracer()
+	if tracer == nil {
+		return do()
+	}
+	caller := ""
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			caller = fn.Name()[strings.LastIndex(fn.Name(), "/")+1:]
+		}
+	}
+
+	start := tracer.now()
+	raw, err := do()
+	record := TraceRecord{
+		Product:   string(serviceCode),
+		Caller:    caller,
+		Region:    client.RegionId,
+		LatencyMs: int64(tracer.now().Sub(start) / time.Millisecond),
+	}
+	if err != nil {
+		record.Error = err.Error()
+		record.ErrorCode, record.RequestId = traceErrorDetail(err)
+	}
+	tracer.Write(record, "")
+	return raw, err
+}
+
+// traceErrorDetail returns the error code and the request ID of the errors returned by the clients.
+func traceErrorDetail(err error) (string, string) {
+	code, requestId := "", ""
+	if e, ok := err.(interface{ ErrorCode() string }); ok {
+		code = e.ErrorCode()
+	}
+	if e, ok := err.(interface{ RequestId() string }); ok {
+		requestId = e.RequestId()
+	}
+	return code, requestId
+}
@@ -0,0 +1,137 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+)
+
+func readTraceRecords(t *testing.T, out *bytes.Buffer) []TraceRecord {
+	var records []TraceRecord
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record TraceRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("the trace line %q is not a JSON object: %#v.", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestRedactParams(t *testing.T) {
+	redacted := RedactParams(map[string]string{
+		"Password":             "Test12345",
+		"KmsEncryptedPassword": "encrypted",
+		"SecurityToken":        "token",
+		"Signature":            "signature",
+		"AccessKeyId":          "LTAI1234567890abcd",
+		"InstanceId":           "i-123",
+	})
+	for _, key := range []string{"Password", "KmsEncryptedPassword", "SecurityToken", "Signature"} {
+		if redacted[key] != redactedValue {
+			t.Fatalf("expected %s redacted, got %s.", key, redacted[key])
+		}
+	}
+	if redacted["AccessKeyId"] != "LTAI******abcd" || redacted["InstanceId"] != "i-123" {
+		t.Fatalf("unexpected redacted params %v.", redacted)
+	}
+}
+
+func TestTraceSdkClient(t *testing.T) {
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"RequestId": "request-1", "Code": "ServiceUnavailable", "Message": "The request has failed due to a temporary failure of the server."}`))
+			return
+		}
+		w.Write([]byte(`{"RequestId": "request-2"}`))
+	}))
+	defer server.Close()
+
+	out := &bytes.Buffer{}
+	sdkClient, err := sdk.NewClientWithAccessKey("cn-hangzhou", "LTAI1234567890abcd", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdkClient.SetLogger("", "", &sdkTraceWriter{tracer: NewTracer(out), serviceCode: ECSCode, regionId: "cn-hangzhou"}, sdkTraceTemplate)
+
+	call := func() error {
+		request := requests.NewCommonRequest()
+		request.Scheme = "http"
+		request.Domain = strings.TrimPrefix(server.URL, "http://")
+		request.Product = "Ecs"
+		request.Version = "2014-05-26"
+		request.ApiName = "ModifyInstanceAttribute"
+		request.QueryParams["RegionId"] = "cn-beijing"
+		request.QueryParams["InstanceId"] = "i-123"
+		request.QueryParams["Password"] = "Test12345"
+		_, err := sdkClient.ProcessCommonRequest(request)
+		return err
+	}
+	// The SDK retries the unavailable service by itself, and it only logs the response body of the last attempt.
+	if err := call(); err != nil {
+		t.Fatalf("the retried call got an error: %#v.", err)
+	}
+
+	records := readTraceRecords(t, out)
+	if len(records) != 2 {
+		t.Fatalf("expected 2 trace records, got %d: %s", len(records), out.String())
+	}
+	failed, succeeded := records[0], records[1]
+	if failed.Product != "ECS" || failed.Action != "ModifyInstanceAttribute" || failed.Region != "cn-beijing" ||
+		failed.StatusCode != http.StatusServiceUnavailable || failed.RetryCount != 0 {
+		t.Fatalf("unexpected trace record of the failed call %#v.", failed)
+	}
+	if succeeded.RequestId != "request-2" || succeeded.ErrorCode != "" || succeeded.RetryCount != 1 {
+		t.Fatalf("unexpected trace record of the retried call %#v.", succeeded)
+	}
+	if strings.Contains(out.String(), "Test12345") || succeeded.Params["Password"] != redactedValue || succeeded.Params["Signature"] != redactedValue || succeeded.Params["SignatureMethod"] != "HMAC-SHA1" {
+		t.Fatalf("the sensitive parameters should be redacted: %s", out.String())
+	}
+}
+
+type testTraceError struct{}
+
+func (e testTraceError) Error() string     { return "NoSuchBucket: the bucket does not exist" }
+func (e testTraceError) ErrorCode() string { return "NoSuchBucket" }
+func (e testTraceError) RequestId() string { return "request-3" }
+
+func TestTraceCall(t *testing.T) {
+	out := &bytes.Buffer{}
+	tracer := NewTracer(out)
+	tracerOnce.Do(func() {})
+	defaultTracer = tracer
+	defer func() { defaultTracer = nil }()
+
+	client := &AliyunClient{RegionId: "cn-hangzhou"}
+	// The caller is the function which invokes the WithXxxClient, two frames above traceCall.
+	withClient := func(serviceCode ServiceCode, err error) {
+		client.traceCall(serviceCode, func() (interface{}, error) { return nil, err })
+	}
+	withClient(OSSCode, testTraceError{})
+	withClient(LOGCode, errors.New("timeout"))
+
+	records := readTraceRecords(t, out)
+	if len(records) != 2 {
+		t.Fatalf("expected 2 trace records, got %d: %s", len(records), out.String())
+	}
+	if records[0].Product != "OSS" || records[0].ErrorCode != "NoSuchBucket" || records[0].RequestId != "request-3" || records[0].Region != "cn-hangzhou" {
+		t.Fatalf("unexpected trace record %#v.", records[0])
+	}
+	if records[1].Product != "LOG" || records[1].Error != "timeout" || !strings.Contains(records[1].Caller, "TestTraceCall") {
+		t.Fatalf("unexpected trace record %#v.", records[1])
+	}
+}
@@ -0,0 +1,59 @@
+package alicloud
+
+import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func dataSourceAlicloudCallerIdentity() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudCallerIdentityRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed values
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := sts.CreateGetCallerIdentityRequest()
+	raw, err := client.WithStsClient(func(stsClient *sts.Client) (interface{}, error) {
+		return stsClient.GetCallerIdentity(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_caller_identity", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*sts.GetCallerIdentityResponse)
+
+	d.SetId(response.AccountId)
+	d.Set("account_id", response.AccountId)
+	d.Set("arn", response.Arn)
+	d.Set("identity_type", response.IdentityType)
+	d.Set("user_id", response.UserId)
+	d.Set("principal_id", response.PrincipalId)
+	return nil
+}
@@ -0,0 +1,34 @@
+package alicloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudCallerIdentityDataSource_basic(t *testing.T) {
+	recorder := testAccRecorder(t)
+	testAccRecordedTest(t, recorder, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudCallerIdentityDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_caller_identity.current"),
+					resource.TestMatchResourceAttr("data.alicloud_caller_identity.current", "account_id", regexp.MustCompile("^[0-9]+$")),
+					resource.TestMatchResourceAttr("data.alicloud_caller_identity.current", "arn", regexp.MustCompile("^acs:ram::[0-9]+:")),
+					resource.TestCheckResourceAttrSet("data.alicloud_caller_identity.current", "identity_type"),
+					resource.TestCheckResourceAttrSet("data.alicloud_caller_identity.current", "principal_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudCallerIdentityDataSourceBasic = `
+data "alicloud_caller_identity" "current" {
+}
+`
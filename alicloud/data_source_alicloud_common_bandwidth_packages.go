@@ -131,7 +131,7 @@ func dataSourceAlicloudCommonBandwidthPackagesRead(d *schema.ResourceData, meta
 			WrapError(err)
 		}
 	}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.VPCCode)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
@@ -60,7 +60,7 @@ func dataSourceAlicloudCRNamespaces() *schema.Resource {
 func dataSourceAlicloudCRNamespacesRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	crService := CrService{client}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CRCode)
 
 	var (
 		request  *cr.GetNamespaceListRequest
@@ -290,7 +290,7 @@ func dataSourceAlicloudCSKubernetesClustersRead(d *schema.ResourceData, meta int
 	var allClusterTypes []cs.ClusterType
 	var requestInfo *cs.Client
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	var response interface{}
 	if err := invoker.Run(func() error {
 		raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -513,8 +513,8 @@ func csKubernetesClusterDescriptionAttributes(d *schema.ResourceData, clusterTyp
 		var masterNodes []map[string]interface{}
 		var workerNodes []map[string]interface{}
 
-		invoker := NewInvoker()
 		client := meta.(*connectivity.AliyunClient)
+		invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 		pageNumber := 1
 		for {
 			var result []cs.KubernetesNodeType
@@ -249,7 +249,7 @@ func dataSourceAlicloudCSManagerKubernetesClustersRead(d *schema.ResourceData, m
 	var allClusterTypes []cs.ClusterType
 
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	var response interface{}
 	if err := invoker.Run(func() error {
 		raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -351,8 +351,8 @@ func csManagedKubernetesClusterDescriptionAttributes(d *schema.ResourceData, clu
 
 		var workerNodes []map[string]interface{}
 
-		invoker := NewInvoker()
 		client := meta.(*connectivity.AliyunClient)
+		invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 		pageNumber := 1
 		for {
 			var result []cs.KubernetesNodeType
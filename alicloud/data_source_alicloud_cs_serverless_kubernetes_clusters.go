@@ -121,7 +121,7 @@ func dataSourceAlicloudCSServerlessKubernetesClustersRead(d *schema.ResourceData
 	var allClusterTypes []*cs.ServerlessClusterResponse
 
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	var response interface{}
 	if err := invoker.Run(func() error {
 		raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -220,7 +220,7 @@ func csServerlessKubernetesClusterDescriptionAttributes(d *schema.ResourceData,
 		//set default value
 		mapping["enndpoint_public_access_enabled"] = false
 
-		invoker := NewInvoker()
+		invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 		client := meta.(*connectivity.AliyunClient)
 
 		var response interface{}
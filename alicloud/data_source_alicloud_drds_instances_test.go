@@ -77,7 +77,7 @@ func TestAccAlicloudDRDSInstancesDataSource(t *testing.T) {
 	}
 
 	preCheck := func() {
-		testAccPreCheckWithRegions(t, true, connectivity.DrdsSupportedRegions)
+		testAccPreCheckWithProduct(t, connectivity.DRDSCode)
 		testAccPreCheckWithAccountSiteType(t, DomesticSite)
 	}
 
@@ -0,0 +1,243 @@
+package alicloud
+
+import (
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func dataSourceAlicloudEcsDedicatedHosts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudEcsDedicatedHostsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dedicated_host_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"hosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dedicated_host_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action_on_maintenance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_placement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"charge_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expired_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_release_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cores": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"sockets": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"instance_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudEcsDedicatedHostsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := ecs.CreateDescribeDedicatedHostsRequest()
+	request.RegionId = client.RegionId
+	request.ZoneId = d.Get("zone_id").(string)
+	request.Status = d.Get("status").(string)
+	request.DedicatedHostType = d.Get("dedicated_host_type").(string)
+	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
+		request.DedicatedHostIds = convertListToJsonString(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		var tags []ecs.DescribeDedicatedHostsTag
+		for key, value := range v.(map[string]interface{}) {
+			tags = append(tags, ecs.DescribeDedicatedHostsTag{
+				Key:   key,
+				Value: value.(string),
+			})
+		}
+		request.Tag = &tags
+	}
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+
+	var hosts []ecs.DedicatedHost
+	for {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeDedicatedHosts(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_ecs_dedicated_hosts", request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ecs.DescribeDedicatedHostsResponse)
+		for _, item := range response.DedicatedHosts.DedicatedHost {
+			if nameRegex != nil && !nameRegex.MatchString(item.DedicatedHostName) {
+				continue
+			}
+			hosts = append(hosts, item)
+		}
+
+		if len(response.DedicatedHosts.DedicatedHost) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+
+	return ecsDedicatedHostsDescriptionAttributes(d, hosts)
+}
+
+func ecsDedicatedHostsDescriptionAttributes(d *schema.ResourceData, hosts []ecs.DedicatedHost) error {
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, host := range hosts {
+		var instanceIds []string
+		for _, instance := range host.Instances.Instance {
+			instanceIds = append(instanceIds, instance.InstanceId)
+		}
+		mapping := map[string]interface{}{
+			"id":                    host.DedicatedHostId,
+			"name":                  host.DedicatedHostName,
+			"description":           host.Description,
+			"dedicated_host_type":   host.DedicatedHostType,
+			"zone_id":               host.ZoneId,
+			"status":                host.Status,
+			"action_on_maintenance": host.ActionOnMaintenance,
+			"auto_placement":        host.AutoPlacement,
+			"charge_type":           host.ChargeType,
+			"expired_time":          host.ExpiredTime,
+			"auto_release_time":     host.AutoReleaseTime,
+			"resource_group_id":     host.ResourceGroupId,
+			"creation_time":         host.CreationTime,
+			"cores":                 host.Cores,
+			"sockets":               host.Sockets,
+			"instance_ids":          instanceIds,
+			"tags":                  tagsToMap(host.Tags.Tag),
+		}
+		ids = append(ids, host.DedicatedHostId)
+		names = append(names, host.DedicatedHostName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("hosts", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+)
+
+func TestAccAlicloudEcsDedicatedHostsDataSourceBasic(t *testing.T) {
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccEcsDedicatedHostsDataSource%d", rand)
+	resourceId := "data.alicloud_ecs_dedicated_hosts.default"
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceEcsDedicatedHostsConfigDependence)
+
+	idsConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alicloud_ecs_dedicated_host.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alicloud_ecs_dedicated_host.default.id}_fake"},
+		}),
+	}
+
+	nameRegexConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alicloud_ecs_dedicated_host.default.dedicated_host_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alicloud_ecs_dedicated_host.default.dedicated_host_name}_fake",
+		}),
+	}
+
+	tagsConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alicloud_ecs_dedicated_host.default.id}"},
+			"tags": map[string]string{
+				"version": "1.0",
+			},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alicloud_ecs_dedicated_host.default.id}"},
+			"tags": map[string]string{
+				"version": "1.0_fake",
+			},
+		}),
+	}
+
+	allConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids":                 []string{"${alicloud_ecs_dedicated_host.default.id}"},
+			"name_regex":          "${alicloud_ecs_dedicated_host.default.dedicated_host_name}",
+			"dedicated_host_type": "ddh.g5",
+			"status":              "Available",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids":                 []string{"${alicloud_ecs_dedicated_host.default.id}"},
+			"name_regex":          "${alicloud_ecs_dedicated_host.default.dedicated_host_name}",
+			"dedicated_host_type": "ddh.g5",
+			"status":              "PermanentFailure",
+		}),
+	}
+
+	var existEcsDedicatedHostsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                       "1",
+			"names.#":                     "1",
+			"names.0":                     name,
+			"hosts.#":                     "1",
+			"hosts.0.id":                  CHECKSET,
+			"hosts.0.name":                name,
+			"hosts.0.description":         name,
+			"hosts.0.dedicated_host_type": "ddh.g5",
+			"hosts.0.zone_id":             CHECKSET,
+			"hosts.0.status":              "Available",
+			"hosts.0.charge_type":         "PostPaid",
+			"hosts.0.creation_time":       CHECKSET,
+			"hosts.0.cores":               CHECKSET,
+			"hosts.0.instance_ids.#":      "0",
+			"hosts.0.tags.%":              "1",
+			"hosts.0.tags.version":        "1.0",
+		}
+	}
+
+	var fakeEcsDedicatedHostsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":   "0",
+			"names.#": "0",
+			"hosts.#": "0",
+		}
+	}
+
+	var ecsDedicatedHostsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existEcsDedicatedHostsMapFunc,
+		fakeMapFunc:  fakeEcsDedicatedHostsMapFunc,
+	}
+
+	ecsDedicatedHostsCheckInfo.dataSourceTestCheck(t, rand, idsConfig, nameRegexConfig, tagsConfig, allConfig)
+}
+
+func dataSourceEcsDedicatedHostsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alicloud_ecs_dedicated_host" "default" {
+  dedicated_host_type = "ddh.g5"
+  dedicated_host_name = "${var.name}"
+  description         = "${var.name}"
+  tags = {
+    version = "1.0"
+  }
+}
+`, name)
+}
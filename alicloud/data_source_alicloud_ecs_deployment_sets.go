@@ -0,0 +1,180 @@
+package alicloud
+
+import (
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func dataSourceAlicloudEcsDeploymentSets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudEcsDeploymentSetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Availability"}),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"strategy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"granularity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_amount": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"instance_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudEcsDeploymentSetsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := ecs.CreateDescribeDeploymentSetsRequest()
+	request.RegionId = client.RegionId
+	request.Strategy = d.Get("strategy").(string)
+	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
+		request.DeploymentSetIds = convertListToJsonString(v.([]interface{}))
+	}
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		if r, err := regexp.Compile(v.(string)); err == nil {
+			nameRegex = r
+		}
+	}
+
+	var sets []ecs.DeploymentSet
+	for {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeDeploymentSets(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_ecs_deployment_sets", request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ecs.DescribeDeploymentSetsResponse)
+		for _, item := range response.DeploymentSets.DeploymentSet {
+			if nameRegex != nil && !nameRegex.MatchString(item.DeploymentSetName) {
+				continue
+			}
+			sets = append(sets, item)
+		}
+
+		if len(response.DeploymentSets.DeploymentSet) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+
+	return ecsDeploymentSetsDescriptionAttributes(d, sets)
+}
+
+func ecsDeploymentSetsDescriptionAttributes(d *schema.ResourceData, sets []ecs.DeploymentSet) error {
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, set := range sets {
+		mapping := map[string]interface{}{
+			"id":              set.DeploymentSetId,
+			"name":            set.DeploymentSetName,
+			"description":     set.DeploymentSetDescription,
+			"strategy":        set.Strategy,
+			"domain":          set.Domain,
+			"granularity":     set.Granularity,
+			"instance_amount": set.InstanceAmount,
+			"instance_ids":    set.InstanceIds.InstanceId,
+			"creation_time":   set.CreationTime,
+		}
+		ids = append(ids, set.DeploymentSetId)
+		names = append(names, set.DeploymentSetName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("sets", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+)
+
+func TestAccAlicloudEcsDeploymentSetsDataSourceBasic(t *testing.T) {
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccEcsDeploymentSetsDataSource%d", rand)
+	resourceId := "data.alicloud_ecs_deployment_sets.default"
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceEcsDeploymentSetsConfigDependence)
+
+	idsConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alicloud_ecs_deployment_set.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alicloud_ecs_deployment_set.default.id}_fake"},
+		}),
+	}
+
+	nameRegexConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alicloud_ecs_deployment_set.default.deployment_set_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alicloud_ecs_deployment_set.default.deployment_set_name}_fake",
+		}),
+	}
+
+	allConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids":        []string{"${alicloud_ecs_deployment_set.default.id}"},
+			"name_regex": "${alicloud_ecs_deployment_set.default.deployment_set_name}",
+			"strategy":   "Availability",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids":        []string{"${alicloud_ecs_deployment_set.default.id}"},
+			"name_regex": "${alicloud_ecs_deployment_set.default.deployment_set_name}_fake",
+			"strategy":   "Availability",
+		}),
+	}
+
+	var existEcsDeploymentSetsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                  "1",
+			"names.#":                "1",
+			"names.0":                name,
+			"sets.#":                 "1",
+			"sets.0.id":              CHECKSET,
+			"sets.0.name":            name,
+			"sets.0.description":     name,
+			"sets.0.strategy":        "Availability",
+			"sets.0.domain":          "Default",
+			"sets.0.granularity":     "Host",
+			"sets.0.instance_amount": "0",
+			"sets.0.instance_ids.#":  "0",
+			"sets.0.creation_time":   CHECKSET,
+		}
+	}
+
+	var fakeEcsDeploymentSetsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":   "0",
+			"names.#": "0",
+			"sets.#":  "0",
+		}
+	}
+
+	var ecsDeploymentSetsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existEcsDeploymentSetsMapFunc,
+		fakeMapFunc:  fakeEcsDeploymentSetsMapFunc,
+	}
+
+	ecsDeploymentSetsCheckInfo.dataSourceTestCheck(t, rand, idsConfig, nameRegexConfig, allConfig)
+}
+
+func dataSourceEcsDeploymentSetsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alicloud_ecs_deployment_set" "default" {
+  deployment_set_name = "${var.name}"
+  description         = "${var.name}"
+}
+`, name)
+}
@@ -0,0 +1,109 @@
+package alicloud
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func dataSourceAlicloudEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudEndpointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_codes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	serviceCodes := connectivity.ServiceCodes
+	if v, ok := d.GetOk("service_codes"); ok && len(v.([]interface{})) > 0 {
+		serviceCodes = []connectivity.ServiceCode{}
+		for _, code := range v.([]interface{}) {
+			serviceCode := connectivity.ServiceCode(strings.ToUpper(strings.TrimSpace(code.(string))))
+			if !isSupportedServiceCode(serviceCode) {
+				return WrapError(Error("The service code %s is not supported. Expected one of %v.", code, connectivity.ServiceCodes))
+			}
+			serviceCodes = append(serviceCodes, serviceCode)
+		}
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, serviceCode := range serviceCodes {
+		endpoint, source := client.ResolveEndpoint(serviceCode)
+		mapping := map[string]interface{}{
+			"service_code": string(serviceCode),
+			"region_id":    client.RegionId,
+			"endpoint":     endpoint,
+			"source":       source,
+		}
+		ids = append(ids, string(serviceCode))
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("endpoints", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
+
+func isSupportedServiceCode(serviceCode connectivity.ServiceCode) bool {
+	for _, code := range connectivity.ServiceCodes {
+		if code == serviceCode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudEndpointsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudEndpointsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_endpoints.default"),
+					resource.TestCheckResourceAttr("data.alicloud_endpoints.default", "endpoints.#", "2"),
+					resource.TestCheckResourceAttr("data.alicloud_endpoints.default", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.alicloud_endpoints.default", "endpoints.0.service_code", "ECS"),
+					resource.TestCheckResourceAttrSet("data.alicloud_endpoints.default", "endpoints.0.region_id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_endpoints.default", "endpoints.0.source"),
+					resource.TestCheckResourceAttr("data.alicloud_endpoints.default", "endpoints.1.service_code", "VPC"),
+				),
+			},
+			{
+				Config: testAccCheckAlicloudEndpointsDataSourceAll,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_endpoints.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_endpoints.default", "endpoints.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudEndpointsDataSourceBasic = `
+data "alicloud_endpoints" "default" {
+  service_codes = ["ecs", "vpc"]
+}
+`
+
+const testAccCheckAlicloudEndpointsDataSourceAll = `
+data "alicloud_endpoints" "default" {
+}
+`
@@ -0,0 +1,167 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func dataSourceAlicloudExpiringSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudExpiringSubscriptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"product_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"product_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"renewal_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(RenewAutoRenewal),
+					string(RenewManualRenewal),
+					string(RenewNotRenewal)}),
+			},
+			"expire_within_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      30,
+				ValidateFunc: validateIntegerInRange(1, 365),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"renewal_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"renewal_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"renewal_period_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudExpiringSubscriptionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	bssOpenApiService := BssOpenApiService{client}
+
+	now := time.Now()
+	instances, err := bssOpenApiService.QueryAvailableInstances(SubscriptionFilter{
+		ProductCode:   d.Get("product_code").(string),
+		ProductType:   d.Get("product_type").(string),
+		Region:        d.Get("region").(string),
+		RenewalStatus: d.Get("renewal_status").(string),
+		EndTimeStart:  now,
+		EndTimeEnd:    now.AddDate(0, 0, d.Get("expire_within_days").(int)),
+	})
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, object := range instances {
+		instanceId := object.InstanceID
+		if instanceId == "" {
+			instanceId = object.InstanceId
+		}
+		id := fmt.Sprintf("%s%s%s", object.ProductCode, COLON_SEPARATED, instanceId)
+		mapping := map[string]interface{}{
+			"id":                  id,
+			"instance_id":         instanceId,
+			"product_code":        object.ProductCode,
+			"product_type":        object.ProductType,
+			"region":              object.Region,
+			"status":              object.Status,
+			"renewal_status":      object.RenewStatus,
+			"renewal_period":      object.RenewalDuration,
+			"renewal_period_unit": object.RenewalDurationUnit,
+			"create_time":         object.CreateTime,
+			"end_time":            object.EndTime,
+		}
+		ids = append(ids, id)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("subscriptions", s); err != nil {
+		return WrapError(err)
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
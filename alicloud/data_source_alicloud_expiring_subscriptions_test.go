@@ -0,0 +1,33 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudExpiringSubscriptionsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudExpiringSubscriptionsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_expiring_subscriptions.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_expiring_subscriptions.default", "ids.#"),
+					resource.TestCheckResourceAttrSet("data.alicloud_expiring_subscriptions.default", "subscriptions.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudExpiringSubscriptionsDataSourceBasic = `
+data "alicloud_expiring_subscriptions" "default" {
+  product_code       = "ecs"
+  expire_within_days = 60
+}
+`
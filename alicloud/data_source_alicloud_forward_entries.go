@@ -116,7 +116,7 @@ func dataSourceAlicloudForwardEntriesRead(d *schema.ResourceData, meta interface
 		}
 	}
 	var allForwardEntries []vpc.ForwardTableEntry
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.VPCCode)
 	var raw interface{}
 	for {
 		if err := invoker.Run(func() error {
@@ -0,0 +1,202 @@
+package alicloud
+
+import (
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func dataSourceAlicloudLaunchTemplateVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudLaunchTemplateVersionsRead,
+		Schema: map[string]*schema.Schema{
+			"launch_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"min_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+			"max_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"default_version": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"version_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"launch_template_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_pair_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system_disk_category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system_disk_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudLaunchTemplateVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	request := ecs.CreateDescribeLaunchTemplateVersionsRequest()
+	request.RegionId = client.RegionId
+	request.LaunchTemplateId = d.Get("launch_template_id").(string)
+	request.DetailFlag = requests.NewBoolean(true)
+
+	if v, ok := d.GetOk("default_version"); ok {
+		request.DefaultVersion = requests.NewBoolean(v.(bool))
+	}
+	if v, ok := d.GetOk("min_version"); ok {
+		request.MinVersion = requests.NewInteger(v.(int))
+	}
+	if v, ok := d.GetOk("max_version"); ok {
+		request.MaxVersion = requests.NewInteger(v.(int))
+	}
+
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	var allVersions []ecs.LaunchTemplateVersionSet
+	for {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeLaunchTemplateVersions(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_launch_template_versions", request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response := raw.(*ecs.DescribeLaunchTemplateVersionsResponse)
+		allVersions = append(allVersions, response.LaunchTemplateVersionSets.LaunchTemplateVersionSet...)
+
+		if len(response.LaunchTemplateVersionSets.LaunchTemplateVersionSet) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+
+	return launchTemplateVersionsDescriptionAttributes(d, allVersions)
+}
+
+func launchTemplateVersionsDescriptionAttributes(d *schema.ResourceData, versions []ecs.LaunchTemplateVersionSet) error {
+	var s []map[string]interface{}
+	var ids []string
+	for _, version := range versions {
+		mapping := map[string]interface{}{
+			"version_number":       version.VersionNumber,
+			"default_version":      version.DefaultVersion,
+			"version_description":  version.VersionDescription,
+			"launch_template_name": version.LaunchTemplateName,
+			"created_by":           version.CreatedBy,
+			"creation_time":        version.CreateTime,
+			"modified_time":        version.ModifiedTime,
+			"image_id":             version.LaunchTemplateData.ImageId,
+			"instance_type":        version.LaunchTemplateData.InstanceType,
+			"instance_name":        version.LaunchTemplateData.InstanceName,
+			"security_group_id":    version.LaunchTemplateData.SecurityGroupId,
+			"vswitch_id":           version.LaunchTemplateData.VSwitchId,
+			"key_pair_name":        version.LaunchTemplateData.KeyPairName,
+			"system_disk_category": version.LaunchTemplateData.SystemDiskCategory,
+			"system_disk_size":     version.LaunchTemplateData.SystemDiskSize,
+		}
+		s = append(s, mapping)
+		ids = append(ids, strconv.FormatInt(version.VersionNumber, 10))
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("versions", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
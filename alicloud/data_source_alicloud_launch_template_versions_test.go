@@ -0,0 +1,103 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+)
+
+func TestAccAlicloudLaunchTemplateVersionsDataSourceBasic(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testaccLaunchTemplateVersionsDataSource%d", rand)
+	resourceId := "data.alicloud_launch_template_versions.default"
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceLaunchTemplateVersionsConfigDependence)
+
+	minVersionConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"launch_template_id": "${alicloud_launch_template.default.id}",
+			"min_version":        "1",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"launch_template_id": "${alicloud_launch_template.default.id}",
+			"min_version":        "2",
+		}),
+	}
+
+	maxVersionConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"launch_template_id": "${alicloud_launch_template.default.id}",
+			"min_version":        "1",
+			"max_version":        "1",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"launch_template_id": "${alicloud_launch_template.default.id}",
+			"min_version":        "2",
+			"max_version":        "3",
+		}),
+	}
+
+	allConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"launch_template_id": "${alicloud_launch_template.default.id}",
+			"default_version":    "true",
+			"min_version":        "1",
+			"max_version":        "1",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"launch_template_id": "${alicloud_launch_template.default.id}",
+			"default_version":    "true",
+			"min_version":        "2",
+			"max_version":        "3",
+		}),
+	}
+
+	var existLaunchTemplateVersionsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                           "1",
+			"ids.0":                           "1",
+			"versions.#":                      "1",
+			"versions.0.version_number":       "1",
+			"versions.0.default_version":      "true",
+			"versions.0.version_description":  name,
+			"versions.0.launch_template_name": name,
+			"versions.0.created_by":           CHECKSET,
+			"versions.0.creation_time":        CHECKSET,
+			"versions.0.image_id":             CHECKSET,
+			"versions.0.instance_type":        CHECKSET,
+			"versions.0.instance_name":        name,
+			"versions.0.security_group_id":    CHECKSET,
+		}
+	}
+
+	var fakeLaunchTemplateVersionsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":      "0",
+			"versions.#": "0",
+		}
+	}
+
+	var launchTemplateVersionsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existLaunchTemplateVersionsMapFunc,
+		fakeMapFunc:  fakeLaunchTemplateVersionsMapFunc,
+	}
+
+	launchTemplateVersionsCheckInfo.dataSourceTestCheck(t, rand, minVersionConfig, maxVersionConfig, allConfig)
+}
+
+func dataSourceLaunchTemplateVersionsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alicloud_launch_template" "default" {
+  name                = "${var.name}"
+  version_description = "${var.name}"
+  image_id            = "${data.alicloud_images.default.images.0.id}"
+  instance_type       = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  instance_name       = "${var.name}"
+  security_group_id   = "${alicloud_security_group.default.id}"
+}
+`, resourceLaunchTemplateConfigDependence(name))
+}
@@ -91,7 +91,7 @@ func dataSourceAlicloudAccessGroupsRead(d *schema.ResourceData, meta interface{}
 			nameRegex = r
 		}
 	}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.NASCode)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
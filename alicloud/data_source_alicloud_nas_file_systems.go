@@ -93,7 +93,7 @@ func dataSourceAlicloudFileSystemsRead(d *schema.ResourceData, meta interface{})
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 	var allfss []nas.DescribeFileSystemsFileSystem1
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.NASCode)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
@@ -110,7 +110,7 @@ func dataSourceAlicloudNatGatewaysRead(d *schema.ResourceData, meta interface{})
 			return WrapError(err)
 		}
 	}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.VPCCode)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
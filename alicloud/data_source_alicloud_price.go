@@ -0,0 +1,344 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// priceUnitHours is the number of hours of each price unit, a month is counted as 30 days.
+var priceUnitHours = map[string]float64{
+	"Hour":  1,
+	"Day":   24,
+	"Month": 720,
+	"Year":  8640,
+}
+
+func dataSourceAlicloudPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"product_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"subscription_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      SubscriptionTypePayAsYouGo,
+				ValidateFunc: validateAllowedStringValue([]string{SubscriptionTypePayAsYouGo, SubscriptionTypeSubscription}),
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"modules": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"module_code": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"config": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"price_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Hour",
+							ValidateFunc: validateAllowedStringValue([]string{"Hour", "Day", "Month", "Year"}),
+						},
+					},
+				},
+			},
+			"quantity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 1000),
+			},
+			"service_period_quantity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateIntegerInRange(1, 120),
+			},
+			"service_period_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Month",
+				ValidateFunc: validateAllowedStringValue([]string{"Month", "Year"}),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"currency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"original_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"discount_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"trade_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"hourly_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"monthly_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"module_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"module_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"original_cost": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"invoice_discount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"cost_after_discount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"unit_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudPriceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	region := client.RegionId
+	if v, ok := d.GetOk("region"); ok && v.(string) != "" {
+		region = v.(string)
+	}
+
+	var data bssopenapi.Data
+	var hourlyPrice float64
+	if d.Get("subscription_type").(string) == SubscriptionTypeSubscription {
+		var err error
+		if data, err = getSubscriptionPrice(d, client, region); err != nil {
+			return WrapError(err)
+		}
+		// The subscription price already covers all of the quantity instances.
+		months := d.Get("service_period_quantity").(int)
+		if d.Get("service_period_unit").(string) == "Year" {
+			months = months * 12
+		}
+		hourlyPrice = data.TradePrice / (float64(months) * priceUnitHours["Month"])
+	} else {
+		var err error
+		if data, err = getPayAsYouGoPrice(d, client, region); err != nil {
+			return WrapError(err)
+		}
+		data = scalePayAsYouGoPrice(data, d.Get("quantity").(int))
+		if hourlyPrice, err = payAsYouGoHourlyPrice(d.Get("modules").([]interface{}), data); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	var details []map[string]interface{}
+	ids := []string{d.Get("product_code").(string), d.Get("subscription_type").(string), region}
+	for _, detail := range data.ModuleDetails.ModuleDetail {
+		details = append(details, map[string]interface{}{
+			"module_code":         detail.ModuleCode,
+			"original_cost":       detail.OriginalCost,
+			"invoice_discount":    detail.InvoiceDiscount,
+			"cost_after_discount": detail.CostAfterDiscount,
+			"unit_price":          detail.UnitPrice,
+		})
+	}
+	for _, m := range d.Get("modules").([]interface{}) {
+		module := m.(map[string]interface{})
+		ids = append(ids, fmt.Sprintf("%s:%s", module["module_code"], module["config"]))
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	d.Set("region", region)
+	d.Set("currency", data.Currency)
+	d.Set("original_price", data.OriginalPrice)
+	d.Set("discount_price", data.DiscountPrice)
+	d.Set("trade_price", data.TradePrice)
+	d.Set("hourly_price", hourlyPrice)
+	d.Set("monthly_price", hourlyPrice*priceUnitHours["Month"])
+	if err := d.Set("module_details", details); err != nil {
+		return WrapError(err)
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), map[string]interface{}{
+			"currency":       data.Currency,
+			"trade_price":    data.TradePrice,
+			"hourly_price":   hourlyPrice,
+			"monthly_price":  hourlyPrice * priceUnitHours["Month"],
+			"module_details": details,
+		})
+	}
+	return nil
+}
+
+// scalePayAsYouGoPrice multiplies the pay-as-you-go prices of one instance by the quantity, so that all of the prices
+// cover the quantity instances as the subscription ones do. The unit prices of the modules are kept.
+func scalePayAsYouGoPrice(data bssopenapi.Data, quantity int) bssopenapi.Data {
+	q := float64(quantity)
+	data.OriginalPrice = data.OriginalPrice * q
+	data.DiscountPrice = data.DiscountPrice * q
+	data.TradePrice = data.TradePrice * q
+
+	details := make([]bssopenapi.ModuleDetail, len(data.ModuleDetails.ModuleDetail))
+	for i, detail := range data.ModuleDetails.ModuleDetail {
+		detail.OriginalCost = detail.OriginalCost * q
+		detail.InvoiceDiscount = detail.InvoiceDiscount * q
+		detail.CostAfterDiscount = detail.CostAfterDiscount * q
+		details[i] = detail
+	}
+	data.ModuleDetails.ModuleDetail = details
+	return data
+}
+
+// payAsYouGoHourlyPrice converts the price of each module from the unit of its own price_type to the hourly price,
+// and returns the sum of them. The trade price is only used when all of the modules have the same price_type and
+// the price of each module is not returned.
+func payAsYouGoHourlyPrice(modules []interface{}, data bssopenapi.Data) (float64, error) {
+	hours := make(map[string]float64)
+	for _, m := range modules {
+		module := m.(map[string]interface{})
+		hours[module["module_code"].(string)] = priceUnitHours[module["price_type"].(string)]
+	}
+
+	if len(data.ModuleDetails.ModuleDetail) == 0 {
+		var unit float64
+		for _, h := range hours {
+			if unit != 0 && unit != h {
+				return 0, WrapError(fmt.Errorf("the price of each module is not returned, and the modules have different price_type"))
+			}
+			unit = h
+		}
+		return data.TradePrice / unit, nil
+	}
+
+	var price float64
+	for _, detail := range data.ModuleDetails.ModuleDetail {
+		h, ok := hours[detail.ModuleCode]
+		if !ok {
+			return 0, WrapError(fmt.Errorf("the price of the module %s is returned, but it is not queried", detail.ModuleCode))
+		}
+		price += detail.CostAfterDiscount / h
+	}
+	return price, nil
+}
+
+func getPayAsYouGoPrice(d *schema.ResourceData, client *connectivity.AliyunClient, region string) (data bssopenapi.Data, err error) {
+	request := bssopenapi.CreateGetPayAsYouGoPriceRequest()
+	request.ProductCode = d.Get("product_code").(string)
+	request.ProductType = d.Get("product_type").(string)
+	request.SubscriptionType = SubscriptionTypePayAsYouGo
+	request.Region = region
+	var modules []bssopenapi.GetPayAsYouGoPriceModuleList
+	for _, m := range d.Get("modules").([]interface{}) {
+		module := m.(map[string]interface{})
+		modules = append(modules, bssopenapi.GetPayAsYouGoPriceModuleList{
+			ModuleCode: module["module_code"].(string),
+			Config:     module["config"].(string),
+			PriceType:  module["price_type"].(string),
+		})
+	}
+	request.ModuleList = &modules
+
+	bssOpenApiService := BssOpenApiService{client}
+	raw, err := bssOpenApiService.retryBssOpenApi(func(bssopenapiClient *bssopenapi.Client) (interface{}, error) {
+		return bssopenapiClient.GetPayAsYouGoPrice(request)
+	})
+	if err != nil {
+		return data, WrapErrorf(err, DataDefaultErrorMsg, "alicloud_price", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*bssopenapi.GetPayAsYouGoPriceResponse)
+	// execute errors including in the bssopenapi response
+	if !response.Success {
+		return data, WrapErrorf(Error(response.Message), DataDefaultErrorMsg, "alicloud_price", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return response.Data, nil
+}
+
+func getSubscriptionPrice(d *schema.ResourceData, client *connectivity.AliyunClient, region string) (data bssopenapi.Data, err error) {
+	request := bssopenapi.CreateGetSubscriptionPriceRequest()
+	request.ProductCode = d.Get("product_code").(string)
+	request.ProductType = d.Get("product_type").(string)
+	request.SubscriptionType = SubscriptionTypeSubscription
+	request.OrderType = "NewOrder"
+	request.Region = region
+	request.Quantity = requests.NewInteger(d.Get("quantity").(int))
+	request.ServicePeriodQuantity = requests.NewInteger(d.Get("service_period_quantity").(int))
+	request.ServicePeriodUnit = d.Get("service_period_unit").(string)
+	var modules []bssopenapi.GetSubscriptionPriceModuleList
+	for _, m := range d.Get("modules").([]interface{}) {
+		module := m.(map[string]interface{})
+		modules = append(modules, bssopenapi.GetSubscriptionPriceModuleList{
+			ModuleCode: module["module_code"].(string),
+			Config:     module["config"].(string),
+		})
+	}
+	request.ModuleList = &modules
+
+	bssOpenApiService := BssOpenApiService{client}
+	raw, err := bssOpenApiService.retryBssOpenApi(func(bssopenapiClient *bssopenapi.Client) (interface{}, error) {
+		return bssopenapiClient.GetSubscriptionPrice(request)
+	})
+	if err != nil {
+		return data, WrapErrorf(err, DataDefaultErrorMsg, "alicloud_price", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*bssopenapi.GetSubscriptionPriceResponse)
+	// execute errors including in the bssopenapi response
+	if !response.Success {
+		return data, WrapErrorf(Error(response.Message), DataDefaultErrorMsg, "alicloud_price", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return response.Data, nil
+}
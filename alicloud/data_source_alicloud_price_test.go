@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudPriceDataSource_payAsYouGo(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudPriceDataSourcePayAsYouGo,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_price.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_price.default", "currency"),
+					resource.TestCheckResourceAttrSet("data.alicloud_price.default", "trade_price"),
+					resource.TestCheckResourceAttrSet("data.alicloud_price.default", "hourly_price"),
+					resource.TestCheckResourceAttrSet("data.alicloud_price.default", "monthly_price"),
+					resource.TestCheckResourceAttr("data.alicloud_price.default", "module_details.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudPriceDataSource_subscription(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlicloudPriceDataSourceSubscription,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_price.default"),
+					resource.TestCheckResourceAttrSet("data.alicloud_price.default", "currency"),
+					resource.TestCheckResourceAttrSet("data.alicloud_price.default", "trade_price"),
+					resource.TestCheckResourceAttrSet("data.alicloud_price.default", "monthly_price"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlicloudPriceDataSourcePayAsYouGo = `
+data "alicloud_price" "default" {
+  product_code = "ecs"
+  modules {
+    module_code = "InstanceType"
+    config      = "InstanceType:ecs.g5.large,IoOptimized:IoOptimized,ImageOs:linux"
+  }
+  modules {
+    module_code = "SystemDisk"
+    config      = "SystemDisk.Category:cloud_efficiency,SystemDisk.Size:40"
+  }
+}
+`
+
+const testAccCheckAlicloudPriceDataSourceSubscription = `
+data "alicloud_price" "default" {
+  product_code            = "ecs"
+  subscription_type       = "Subscription"
+  service_period_quantity = 3
+  modules {
+    module_code = "InstanceType"
+    config      = "InstanceType:ecs.g5.large,IoOptimized:IoOptimized,ImageOs:linux"
+  }
+  modules {
+    module_code = "SystemDisk"
+    config      = "SystemDisk.Category:cloud_efficiency,SystemDisk.Size:40"
+  }
+}
+`
+
+func TestPayAsYouGoHourlyPrice(t *testing.T) {
+	modules := []interface{}{
+		map[string]interface{}{"module_code": "InstanceType", "price_type": "Hour"},
+		map[string]interface{}{"module_code": "SystemDisk", "price_type": "Month"},
+	}
+	data := bssopenapi.Data{TradePrice: 721}
+	data.ModuleDetails.ModuleDetail = []bssopenapi.ModuleDetail{
+		{ModuleCode: "InstanceType", CostAfterDiscount: 1},
+		{ModuleCode: "SystemDisk", CostAfterDiscount: 720},
+	}
+	price, err := payAsYouGoHourlyPrice(modules, data)
+	if err != nil || price != 2 {
+		t.Fatalf("expected the hourly price 2, got %v, %v", price, err)
+	}
+
+	data.ModuleDetails.ModuleDetail = nil
+	if _, err := payAsYouGoHourlyPrice(modules, data); err == nil {
+		t.Fatalf("expected an error when the modules with different price_type have no module details")
+	}
+
+	price, err = payAsYouGoHourlyPrice(modules[:1], bssopenapi.Data{TradePrice: 3})
+	if err != nil || price != 3 {
+		t.Fatalf("expected the hourly price 3, got %v, %v", price, err)
+	}
+}
+
+func TestScalePayAsYouGoPrice(t *testing.T) {
+	data := bssopenapi.Data{OriginalPrice: 2, DiscountPrice: 0.5, TradePrice: 1.5}
+	data.ModuleDetails.ModuleDetail = []bssopenapi.ModuleDetail{
+		{ModuleCode: "InstanceType", OriginalCost: 2, InvoiceDiscount: 0.5, CostAfterDiscount: 1.5, UnitPrice: 2},
+	}
+	scaled := scalePayAsYouGoPrice(data, 3)
+	if scaled.OriginalPrice != 6 || scaled.DiscountPrice != 1.5 || scaled.TradePrice != 4.5 {
+		t.Fatalf("expected the prices of 3 instances, got %v, %v, %v", scaled.OriginalPrice, scaled.DiscountPrice, scaled.TradePrice)
+	}
+	detail := scaled.ModuleDetails.ModuleDetail[0]
+	if detail.OriginalCost != 6 || detail.InvoiceDiscount != 1.5 || detail.CostAfterDiscount != 4.5 || detail.UnitPrice != 2 {
+		t.Fatalf("expected the module costs of 3 instances and the same unit price, got %+v", detail)
+	}
+	if data.ModuleDetails.ModuleDetail[0].CostAfterDiscount != 1.5 {
+		t.Fatalf("expected the module details of the response to be kept")
+	}
+}
@@ -0,0 +1,152 @@
+package alicloud
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func dataSourceAlicloudResourceManagerResourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudResourceManagerResourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+				ForceNew: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameRegex,
+				ForceNew:     true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Creating", "OK", "PendingDelete", "Deleted", "DeleteFailed"}),
+				ForceNew:     true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlicloudResourceManagerResourceGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	groups, err := resourceManagerService.ListResourceGroups(d.Get("status").(string))
+	if err != nil {
+		return WrapError(err)
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			idsMap[Trim(vv.(string))] = Trim(vv.(string))
+		}
+	}
+	var r *regexp.Regexp
+	if nameRegex, ok := d.GetOk("name_regex"); ok && nameRegex.(string) != "" {
+		r = regexp.MustCompile(nameRegex.(string))
+	}
+
+	var filteredGroups []ResourceGroup
+	for _, group := range groups {
+		if r != nil && !r.MatchString(group.Name) {
+			continue
+		}
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[group.Id]; !ok {
+				continue
+			}
+		}
+		filteredGroups = append(filteredGroups, group)
+	}
+	return resourceManagerResourceGroupsDescriptionAttributes(d, filteredGroups)
+}
+
+func resourceManagerResourceGroupsDescriptionAttributes(d *schema.ResourceData, groups []ResourceGroup) error {
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+
+	for _, group := range groups {
+		mapping := map[string]interface{}{
+			"id":           group.Id,
+			"name":         group.Name,
+			"display_name": group.DisplayName,
+			"account_id":   group.AccountId,
+			"status":       group.Status,
+			"create_date":  group.CreateDate,
+		}
+
+		ids = append(ids, group.Id)
+		names = append(names, group.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("groups", s); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+)
+
+func TestAccAlicloudResourceManagerResourceGroupsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alicloud_resource_manager_resource_groups.default"
+	name := fmt.Sprintf("tf-%d", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceResourceManagerResourceGroupsConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alicloud_resource_manager_resource_group.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alicloud_resource_manager_resource_group.default.name}_fake",
+		}),
+	}
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alicloud_resource_manager_resource_group.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alicloud_resource_manager_resource_group.default.id}_fake"},
+		}),
+	}
+
+	statusConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids":    []string{"${alicloud_resource_manager_resource_group.default.id}"},
+			"status": "OK",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids":    []string{"${alicloud_resource_manager_resource_group.default.id}"},
+			"status": "Deleted",
+		}),
+	}
+
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids":        []string{"${alicloud_resource_manager_resource_group.default.id}"},
+			"name_regex": "${alicloud_resource_manager_resource_group.default.name}",
+			"status":     "OK",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids":        []string{"${alicloud_resource_manager_resource_group.default.id}_fake"},
+			"name_regex": "${alicloud_resource_manager_resource_group.default.name}",
+			"status":     "OK",
+		}),
+	}
+
+	var existResourceGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                 "1",
+			"names.#":               "1",
+			"groups.#":              "1",
+			"groups.0.id":           CHECKSET,
+			"groups.0.name":         fmt.Sprintf("tf-%d", rand),
+			"groups.0.display_name": fmt.Sprintf("tf-testAcc%d", rand),
+			"groups.0.account_id":   CHECKSET,
+			"groups.0.status":       "OK",
+			"groups.0.create_date":  CHECKSET,
+		}
+	}
+
+	var fakeResourceGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":    "0",
+			"names.#":  "0",
+			"groups.#": "0",
+		}
+	}
+
+	var resourceGroupsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existResourceGroupsMapFunc,
+		fakeMapFunc:  fakeResourceGroupsMapFunc,
+	}
+	resourceGroupsCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf, statusConf, allConf)
+}
+
+func dataSourceResourceManagerResourceGroupsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+resource "alicloud_resource_manager_resource_group" "default" {
+  name         = "%s"
+  display_name = "tf-testAcc%s"
+}
+`, name, name[len("tf-"):])
+}
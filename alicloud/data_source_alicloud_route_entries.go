@@ -79,7 +79,7 @@ func dataSourceAlicloudRouteEntriesRead(d *schema.ResourceData, meta interface{}
 	request.RouteTableId = d.Get("route_table_id").(string)
 
 	var allRouteEntries []vpc.RouteEntry
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.VPCCode)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
@@ -109,7 +109,7 @@ func dataSourceAlicloudRouteTablesRead(d *schema.ResourceData, meta interface{})
 			return WrapError(err)
 		}
 	}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.VPCCode)
 	for {
 		var raw interface{}
 		var err error
@@ -249,8 +249,9 @@ func slbsDescriptionAttributes(d *schema.ResourceData, loadBalancers []slb.LoadB
 	var ids []string
 	var names []string
 	var s []map[string]interface{}
+	tagService := TagService{slbService.client}
 	for _, loadBalancer := range loadBalancers {
-		tags, _ := slbService.describeTags(loadBalancer.LoadBalancerId)
+		tags, _ := tagService.ListResourceTags(loadBalancer.LoadBalancerId, connectivity.SLBCode, TagResourceInstance)
 		mapping := map[string]interface{}{
 			"id":                       loadBalancer.LoadBalancerId,
 			"region_id":                loadBalancer.RegionId,
@@ -264,7 +265,7 @@ func slbsDescriptionAttributes(d *schema.ResourceData, loadBalancers []slb.LoadB
 			"address":                  loadBalancer.Address,
 			"internet":                 loadBalancer.AddressType == strings.ToLower(string(Internet)),
 			"creation_time":            loadBalancer.CreateTime,
-			"tags":                     slbService.slbTagsToMap(tags),
+			"tags":                     tags,
 		}
 
 		ids = append(ids, loadBalancer.LoadBalancerId)
@@ -127,7 +127,7 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 	request.PageNumber = requests.NewInteger(1)
 	request.ResourceGroupId = d.Get("resource_group_id").(string)
 	var allVpcs []vpc.Vpc
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.VPCCode)
 	for {
 		var raw interface{}
 		var err error
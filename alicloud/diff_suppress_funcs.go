@@ -197,6 +197,10 @@ func ecsPostPaidDiffSuppressFunc(k, old, new string, d *schema.ResourceData) boo
 	return common.InstanceChargeType(d.Get("instance_charge_type").(string)) == common.PostPaid
 }
 
+func ecsDedicatedHostPostPaidDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("charge_type").(string) == string(PostPaid)
+}
+
 func ecsNotAutoRenewDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	if common.InstanceChargeType(d.Get("instance_charge_type").(string)) == common.PostPaid {
 		return true
@@ -207,6 +211,10 @@ func ecsNotAutoRenewDiffSuppressFunc(k, old, new string, d *schema.ResourceData)
 	return true
 }
 
+func renewalNotAutoRenewDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return RenewalStatus(d.Get("renewal_status").(string)) != RenewAutoRenewal
+}
+
 func csKubernetesMasterPostPaidDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return common.InstanceChargeType(d.Get("master_instance_charge_type").(string)) == common.PostPaid || !(d.Id() == "") && !d.Get("force_update").(bool)
 }
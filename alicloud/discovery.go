@@ -0,0 +1,468 @@
+package alicloud
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+const (
+	DiscoveryFormatImportBlocks = "import-blocks"
+	DiscoveryFormatScript       = "script"
+)
+
+// DiscoveryOptions selects the resources written by Discover.
+type DiscoveryOptions struct {
+	// Products limits the discovery to the products, e.g. "ecs" and "vpc". All of the products are discovered when it is empty.
+	Products []string
+	// Tags limits the discovery to the resources which have all of the tags.
+	Tags map[string]string
+	// ResourceGroupId limits the discovery to the resources in the resource group.
+	ResourceGroupId string
+	// Format is DiscoveryFormatImportBlocks to write the import blocks along with the resources, or
+	// DiscoveryFormatScript to write a shell script running terraform import to ScriptWriter.
+	Format string
+}
+
+// DiscoveredResource is a resource found by Discover and read by the Read function of its resource type.
+type DiscoveredResource struct {
+	Type string
+	Name string
+	Id   string
+	Data *schema.ResourceData
+
+	resource *schema.Resource
+}
+
+// discoverFunc returns the ids of all of the resources of a resource type in the region of the client.
+type discoverFunc func(client *connectivity.AliyunClient) ([]string, error)
+
+type discoverer struct {
+	product string
+	// dataSource lists the ids of the resources, with the arguments selecting the resources of the resource type.
+	dataSource string
+	arguments  map[string]interface{}
+	// list is used when the resources can not be selected by a data source.
+	list discoverFunc
+}
+
+// discoverers lists the resource types supported by Discover, and the ids returned by their data sources or list
+// functions are accepted by the importers of the resource types.
+var discoverers = map[string]discoverer{
+	"alicloud_vpc":                       {product: "vpc", dataSource: "alicloud_vpcs"},
+	"alicloud_vswitch":                   {product: "vpc", dataSource: "alicloud_vswitches"},
+	"alicloud_nat_gateway":               {product: "vpc", dataSource: "alicloud_nat_gateways"},
+	"alicloud_eip":                       {product: "vpc", dataSource: "alicloud_eips"},
+	"alicloud_security_group":            {product: "ecs", dataSource: "alicloud_security_groups"},
+	"alicloud_instance":                  {product: "ecs", dataSource: "alicloud_instances"},
+	"alicloud_disk":                      {product: "ecs", dataSource: "alicloud_disks", arguments: map[string]interface{}{"type": string(DiskTypeData)}},
+	"alicloud_key_pair":                  {product: "ecs", dataSource: "alicloud_key_pairs"},
+	"alicloud_image":                     {product: "ecs", dataSource: "alicloud_images", arguments: map[string]interface{}{"owners": string(ImageOwnerSelf)}},
+	"alicloud_snapshot":                  {product: "ecs", dataSource: "alicloud_snapshots"},
+	"alicloud_network_interface":         {product: "ecs", dataSource: "alicloud_network_interfaces", arguments: map[string]interface{}{"type": "Secondary"}},
+	"alicloud_slb":                       {product: "slb", dataSource: "alicloud_slbs"},
+	"alicloud_db_instance":               {product: "rds", dataSource: "alicloud_db_instances"},
+	"alicloud_kvstore_instance":          {product: "kvstore", dataSource: "alicloud_kvstore_instances"},
+	"alicloud_mongodb_instance":          {product: "mongodb", dataSource: "alicloud_mongodb_instances", arguments: map[string]interface{}{"instance_type": string(MongoDBReplicate)}},
+	"alicloud_mongodb_sharding_instance": {product: "mongodb", dataSource: "alicloud_mongodb_instances", arguments: map[string]interface{}{"instance_type": string(MongoDBSharding)}},
+	"alicloud_gpdb_instance":             {product: "gpdb", dataSource: "alicloud_gpdb_instances"},
+	"alicloud_drds_instance":             {product: "drds", dataSource: "alicloud_drds_instances"},
+	"alicloud_elasticsearch_instance":    {product: "elasticsearch", dataSource: "alicloud_elasticsearch_instances"},
+	"alicloud_alikafka_instance":         {product: "alikafka", dataSource: "alicloud_alikafka_instances"},
+	"alicloud_ons_instance":              {product: "ons", dataSource: "alicloud_ons_instances"},
+	"alicloud_nas_file_system":           {product: "nas", dataSource: "alicloud_nas_file_systems"},
+	"alicloud_ots_instance":              {product: "ots", dataSource: "alicloud_ots_instances"},
+	"alicloud_kms_key":                   {product: "kms", dataSource: "alicloud_kms_keys"},
+	"alicloud_pvtz_zone":                 {product: "pvtz", dataSource: "alicloud_pvtz_zones"},
+	"alicloud_cen_instance":              {product: "cen", dataSource: "alicloud_cen_instances"},
+	"alicloud_api_gateway_group":         {product: "apigateway", dataSource: "alicloud_api_gateway_groups"},
+	"alicloud_oss_bucket":                {product: "oss", list: discoverOssBuckets},
+}
+
+// DiscoveryError reports the resource types which failed to be discovered. The resources of the other types are
+// still written.
+type DiscoveryError struct {
+	Failures map[string][]error
+}
+
+func (e *DiscoveryError) Error() string {
+	var resourceTypes []string
+	for resourceType := range e.Failures {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	var messages []string
+	for _, resourceType := range resourceTypes {
+		for _, err := range e.Failures[resourceType] {
+			messages = append(messages, fmt.Sprintf("%s: %s", resourceType, err))
+		}
+	}
+	return fmt.Sprintf("%d resource types failed to be discovered:\n%s", len(resourceTypes), strings.Join(messages, "\n"))
+}
+
+// DiscoveryProducts returns the products supported by Discover.
+func DiscoveryProducts() []string {
+	products := make(map[string]bool)
+	for _, v := range discoverers {
+		products[v.product] = true
+	}
+	var result []string
+	for product := range products {
+		result = append(result, product)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ValidateDiscoveryProducts returns an error if any of the products is not supported by Discover.
+func ValidateDiscoveryProducts(products []string) error {
+	supported := make(map[string]bool)
+	for _, v := range discoverers {
+		supported[v.product] = true
+	}
+	for _, product := range products {
+		if !supported[strings.ToLower(strings.TrimSpace(product))] {
+			return fmt.Errorf("the product %q is not supported, expected one of %s", product, strings.Join(DiscoveryProducts(), ", "))
+		}
+	}
+	return nil
+}
+
+// ConfigureDiscoveryProvider configures the provider from the environment variables, like terraform does for
+// an empty provider block, and overrides its region when the region is not empty.
+func ConfigureDiscoveryProvider(region string) (*schema.Provider, error) {
+	provider := Provider().(*schema.Provider)
+	raw := make(map[string]interface{})
+	if region != "" {
+		raw["region"] = region
+	}
+	if err := provider.Configure(terraform.NewResourceConfigRaw(raw)); err != nil {
+		return nil, WrapError(err)
+	}
+	return provider, nil
+}
+
+// Discover enumerates the resources of the region of the configured provider, reads each of them by the importer
+// and the Read function of its resource type, and writes their configurations to w.
+func Discover(provider *schema.Provider, options DiscoveryOptions, w io.Writer, scriptWriter io.Writer) ([]*DiscoveredResource, error) {
+	client := provider.Meta().(*connectivity.AliyunClient)
+	if err := ValidateDiscoveryProducts(options.Products); err != nil {
+		return nil, WrapError(err)
+	}
+	products := make(map[string]bool)
+	for _, product := range options.Products {
+		products[strings.ToLower(strings.TrimSpace(product))] = true
+	}
+
+	var resourceTypes []string
+	for resourceType, v := range discoverers {
+		if len(products) > 0 && !products[v.product] {
+			continue
+		}
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	names := make(map[string]bool)
+	failures := make(map[string][]error)
+	var discovered []*DiscoveredResource
+	for _, resourceType := range resourceTypes {
+		r, ok := provider.ResourcesMap[resourceType]
+		if !ok || !discoveryFilterable(r, options) {
+			continue
+		}
+		ids, err := discoverIds(provider, discoverers[resourceType], options, client)
+		if err != nil {
+			log.Printf("[ERROR] Listing the resources of %s got an error: %#v", resourceType, err)
+			failures[resourceType] = append(failures[resourceType], err)
+			continue
+		}
+		for _, id := range ids {
+			d, err := discoverResource(r, id, client)
+			if err != nil {
+				log.Printf("[ERROR] Reading the discovered resource %s %s got an error: %#v", resourceType, id, err)
+				failures[resourceType] = append(failures[resourceType], fmt.Errorf("reading %s: %s", id, err))
+				continue
+			}
+			if d == nil || !discoveryFilter(r, d, options) {
+				continue
+			}
+			discovered = append(discovered, &DiscoveredResource{
+				Type: resourceType,
+				Name: discoveryResourceName(resourceType, d, names),
+				Id:   d.Id(),
+				Data: d,
+
+				resource: r,
+			})
+		}
+	}
+
+	if err := writeDiscoveredResources(discovered, options, w, scriptWriter); err != nil {
+		return discovered, WrapError(err)
+	}
+	if len(failures) > 0 {
+		return discovered, &DiscoveryError{Failures: failures}
+	}
+	return discovered, nil
+}
+
+func discoverIds(provider *schema.Provider, v discoverer, options DiscoveryOptions, client *connectivity.AliyunClient) ([]string, error) {
+	if v.list != nil {
+		return v.list(client)
+	}
+	return discoverByDataSource(provider, v.dataSource, v.arguments, options, client)
+}
+
+// discoverResource reads a resource like terraform import does. It returns nil when the resource is not found.
+func discoverResource(r *schema.Resource, id string, client *connectivity.AliyunClient) (*schema.ResourceData, error) {
+	d := r.Data(nil)
+	d.SetId(id)
+	if r.Importer != nil && r.Importer.State != nil {
+		data, err := r.Importer.State(d, client)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		if len(data) < 1 {
+			return nil, nil
+		}
+		d = data[0]
+	}
+	if err := r.Read(d, client); err != nil {
+		return nil, WrapError(err)
+	}
+	if d.Id() == "" {
+		log.Printf("[WARN] The discovered resource %s is not found and it is skipped.", id)
+		return nil, nil
+	}
+	return d, nil
+}
+
+// discoveryTagsKey returns the attribute holding the tags of the resource type, or "" when it has no tags.
+func discoveryTagsKey(r *schema.Resource) string {
+	for _, key := range []string{"tags_all", "tags"} {
+		if s, ok := r.Schema[key]; ok && s.Type == schema.TypeMap {
+			return key
+		}
+	}
+	return ""
+}
+
+// discoveryFilterable returns false when the resource type can not have the tags or the resource group of the
+// options, so its resources are not listed at all.
+func discoveryFilterable(r *schema.Resource, options DiscoveryOptions) bool {
+	if _, ok := r.Schema["resource_group_id"]; !ok && options.ResourceGroupId != "" {
+		return false
+	}
+	return len(options.Tags) == 0 || discoveryTagsKey(r) != ""
+}
+
+// discoveryFilter checks the tags and the resource group of a read resource. It is still needed after the data
+// sources filter the resources, because some of the data sources do not accept the tags or the resource group.
+func discoveryFilter(r *schema.Resource, d *schema.ResourceData, options DiscoveryOptions) bool {
+	if !discoveryFilterable(r, options) {
+		return false
+	}
+	if options.ResourceGroupId != "" && d.Get("resource_group_id").(string) != options.ResourceGroupId {
+		return false
+	}
+	if len(options.Tags) > 0 {
+		tags := d.Get(discoveryTagsKey(r)).(map[string]interface{})
+		for k, v := range options.Tags {
+			if value, ok := tags[k]; !ok || fmt.Sprint(value) != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+var discoveryNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// discoveryResourceName names the resource after its name, or its id when it has no name, and it is unique among the resources of the same type.
+func discoveryResourceName(resourceType string, d *schema.ResourceData, names map[string]bool) string {
+	name := ""
+	for _, key := range []string{"name", "instance_name", "bucket", "vpc_name"} {
+		if v, ok := d.GetOk(key); ok {
+			if s, ok := v.(string); ok && s != "" {
+				name = s
+				break
+			}
+		}
+	}
+	if name == "" {
+		name = d.Id()
+	}
+	name = strings.Trim(discoveryNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+	if name == "" || !(name[0] >= 'a' && name[0] <= 'z' || name[0] == '_') {
+		name = "r_" + name
+	}
+	unique := name
+	for i := 2; names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[resourceType+"."+unique] = true
+	return unique
+}
+
+func writeDiscoveredResources(resources []*DiscoveredResource, options DiscoveryOptions, w io.Writer, scriptWriter io.Writer) error {
+	for _, r := range resources {
+		if options.Format == DiscoveryFormatImportBlocks {
+			if _, err := fmt.Fprintf(w, "import {\n  to = %s.%s\n  id = %s\n}\n\n", r.Type, r.Name, discoveryQuote(r.Id)); err != nil {
+				return WrapError(err)
+			}
+		}
+		if _, err := io.WriteString(w, discoveryResourceHCL(r)); err != nil {
+			return WrapError(err)
+		}
+	}
+	if options.Format == DiscoveryFormatScript && scriptWriter != nil {
+		if _, err := io.WriteString(scriptWriter, "#!/bin/sh\nset -e\n\n"); err != nil {
+			return WrapError(err)
+		}
+		for _, r := range resources {
+			if _, err := fmt.Fprintf(scriptWriter, "terraform import %s.%s '%s'\n", r.Type, r.Name, strings.Replace(r.Id, "'", `'\''`, -1)); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+	return nil
+}
+
+// discoveryResourceHCL renders the arguments of the resource which are set by its Read function.
+func discoveryResourceHCL(r *DiscoveredResource) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "resource %q %q {\n", r.Type, r.Name)
+	writeDiscoveryBlock(&b, "  ", r.resource.Schema, func(k string) interface{} {
+		return r.Data.Get(k)
+	})
+	b.WriteString("}\n\n")
+	return b.String()
+}
+
+func writeDiscoveryBlock(b *strings.Builder, indent string, schemaMap map[string]*schema.Schema, get func(string) interface{}) {
+	var keys []string
+	for k := range schemaMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	written := make(map[string]bool)
+	for _, k := range keys {
+		s := schemaMap[k]
+		if !s.Required && !s.Optional || s.Deprecated != "" || s.Removed != "" {
+			continue
+		}
+		conflicted := false
+		for _, c := range s.ConflictsWith {
+			if written[c] {
+				conflicted = true
+			}
+		}
+		if conflicted {
+			continue
+		}
+		v := get(k)
+		if !s.Required && discoveryIsZero(s, v) {
+			continue
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			for _, item := range discoveryList(v) {
+				m, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(b, "%s%s {\n", indent, k)
+				writeDiscoveryBlock(b, indent+"  ", elem.Schema, func(key string) interface{} {
+					return m[key]
+				})
+				fmt.Fprintf(b, "%s}\n", indent)
+			}
+		} else {
+			fmt.Fprintf(b, "%s%s = %s\n", indent, k, discoveryValue(v))
+		}
+		written[k] = true
+	}
+}
+
+func discoveryIsZero(s *schema.Schema, v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	if s.Default != nil && fmt.Sprint(s.Default) == fmt.Sprint(v) {
+		return true
+	}
+	switch value := v.(type) {
+	case string:
+		return value == ""
+	case int:
+		return value == 0
+	case float64:
+		return value == 0
+	case bool:
+		return !value
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+	return len(discoveryList(v)) == 0
+}
+
+func discoveryList(v interface{}) []interface{} {
+	switch value := v.(type) {
+	case *schema.Set:
+		return value.List()
+	case []interface{}:
+		return value
+	}
+	return nil
+}
+
+func discoveryValue(v interface{}) string {
+	switch value := v.(type) {
+	case string:
+		return discoveryQuote(value)
+	case int:
+		return strconv.Itoa(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	case map[string]interface{}:
+		var keys []string
+		for k := range value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var items []string
+		for _, k := range keys {
+			items = append(items, fmt.Sprintf("%s = %s", discoveryQuote(k), discoveryValue(value[k])))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	}
+	if list := discoveryList(v); list != nil {
+		var items []string
+		for _, item := range list {
+			items = append(items, discoveryValue(item))
+		}
+		if _, ok := v.(*schema.Set); ok {
+			sort.Strings(items)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	return discoveryQuote(fmt.Sprint(v))
+}
+
+// discoveryQuote quotes a string for HCL, which interpolates the sequences ${ and %{ in the quoted strings.
+func discoveryQuote(s string) string {
+	quoted := strconv.Quote(s)
+	quoted = strings.Replace(quoted, "${", "$${", -1)
+	return strings.Replace(quoted, "%{", "%%{", -1)
+}
@@ -0,0 +1,83 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// discoverByDataSource returns the ids read by the data source, which lists the resources by the same Describe APIs
+// and pagination as the configurations using it. The arguments select the resources managed by the resource type.
+func discoverByDataSource(provider *schema.Provider, name string, arguments map[string]interface{}, options DiscoveryOptions, client *connectivity.AliyunClient) ([]string, error) {
+	r, ok := provider.DataSourcesMap[name]
+	if !ok {
+		return nil, WrapError(fmt.Errorf("the data source %s is not found", name))
+	}
+	d := r.Data(nil)
+	for key, value := range discoveryArguments(r, arguments, options) {
+		if err := d.Set(key, value); err != nil {
+			return nil, WrapError(err)
+		}
+	}
+	if err := r.Read(d, client); err != nil {
+		return nil, WrapError(err)
+	}
+	var ids []string
+	for _, id := range d.Get("ids").([]interface{}) {
+		ids = append(ids, id.(string))
+	}
+	return ids, nil
+}
+
+// discoveryArguments adds the tags and the resource group of the options into the arguments when the data source
+// accepts them, so that the Describe APIs only return the resources in the scope instead of reading all of them.
+func discoveryArguments(r *schema.Resource, arguments map[string]interface{}, options DiscoveryOptions) map[string]interface{} {
+	result := make(map[string]interface{})
+	for key, value := range arguments {
+		result[key] = value
+	}
+	if s, ok := r.Schema["tags"]; ok && s.Optional && s.Type == schema.TypeMap && len(options.Tags) > 0 {
+		tags := make(map[string]interface{})
+		for key, value := range options.Tags {
+			tags[key] = value
+		}
+		result["tags"] = tags
+	}
+	if s, ok := r.Schema["resource_group_id"]; ok && s.Optional && s.Type == schema.TypeString && options.ResourceGroupId != "" {
+		result["resource_group_id"] = options.ResourceGroupId
+	}
+	return result
+}
+
+// discoverOssBuckets returns the buckets located in the region of the client. The data source alicloud_oss_buckets
+// is not used because it returns the buckets of all of the regions.
+func discoverOssBuckets(client *connectivity.AliyunClient) (ids []string, err error) {
+	var requestInfo *oss.Client
+	nextMarker := ""
+	for {
+		var options []oss.Option
+		if nextMarker != "" {
+			options = append(options, oss.Marker(nextMarker))
+		}
+		raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+			requestInfo = ossClient
+			return ossClient.ListBuckets(options...)
+		})
+		if err != nil {
+			return ids, WrapErrorf(err, DefaultErrorMsg, "alicloud_oss_bucket", "ListBuckets", AliyunOssGoSdk)
+		}
+		addDebug("ListBuckets", raw, requestInfo, map[string]interface{}{"options": options})
+		response, _ := raw.(oss.ListBucketsResult)
+		for _, bucket := range response.Buckets {
+			if bucket.Location == "oss-"+client.RegionId {
+				ids = append(ids, bucket.Name)
+			}
+		}
+		nextMarker = response.NextMarker
+		if nextMarker == "" {
+			return ids, nil
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package alicloud
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func testDiscoveryResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  80,
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func TestDiscoveryWriteResources(t *testing.T) {
+	r := testDiscoveryResource()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":              "Web ${env}",
+		"port":              80,
+		"security_groups":   []interface{}{"sg-2", "sg-1"},
+		"rule":              []interface{}{map[string]interface{}{"cidr": "10.0.0.0/8"}},
+		"resource_group_id": "rg-1",
+	})
+	d.SetId("i-1")
+	d.Set("status", "Running")
+	d.Set("tags_all", map[string]interface{}{"env": "prod"})
+
+	names := make(map[string]bool)
+	resources := []*DiscoveredResource{
+		{Type: "alicloud_test", Name: discoveryResourceName("alicloud_test", d, names), Id: d.Id(), Data: d, resource: r},
+		{Type: "alicloud_test", Name: discoveryResourceName("alicloud_test", d, names), Id: d.Id(), Data: d, resource: r},
+	}
+	if resources[0].Name != "web_env" || resources[1].Name != "web_env_2" {
+		t.Fatalf("unexpected resource names %s and %s", resources[0].Name, resources[1].Name)
+	}
+
+	var w, script bytes.Buffer
+	if err := writeDiscoveredResources(resources[:1], DiscoveryOptions{Format: DiscoveryFormatImportBlocks}, &w, &script); err != nil {
+		t.Fatalf("writeDiscoveredResources got an error: %#v", err)
+	}
+	expected := `import {
+  to = alicloud_test.web_env
+  id = "i-1"
+}
+
+resource "alicloud_test" "web_env" {
+  name = "Web $${env}"
+  resource_group_id = "rg-1"
+  rule {
+    cidr = "10.0.0.0/8"
+  }
+  security_groups = ["sg-1", "sg-2"]
+}
+
+`
+	if w.String() != expected {
+		t.Fatalf("unexpected configuration:\n%s", w.String())
+	}
+	if script.Len() != 0 {
+		t.Fatalf("expected no import script for the import blocks, got %s", script.String())
+	}
+
+	w.Reset()
+	if err := writeDiscoveredResources(resources, DiscoveryOptions{Format: DiscoveryFormatScript}, &w, &script); err != nil {
+		t.Fatalf("writeDiscoveredResources got an error: %#v", err)
+	}
+	if strings.Contains(w.String(), "import {") {
+		t.Fatalf("expected no import blocks for the script format, got %s", w.String())
+	}
+	if !strings.Contains(script.String(), "terraform import alicloud_test.web_env_2 'i-1'\n") {
+		t.Fatalf("unexpected import script:\n%s", script.String())
+	}
+}
+
+func TestDiscoveryFilter(t *testing.T) {
+	r := testDiscoveryResource()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":              "web",
+		"resource_group_id": "rg-1",
+	})
+	d.Set("tags_all", map[string]interface{}{"env": "prod", "team": "web"})
+
+	cases := []struct {
+		options  DiscoveryOptions
+		expected bool
+	}{
+		{DiscoveryOptions{}, true},
+		{DiscoveryOptions{ResourceGroupId: "rg-1"}, true},
+		{DiscoveryOptions{ResourceGroupId: "rg-2"}, false},
+		{DiscoveryOptions{Tags: map[string]string{"env": "prod"}}, true},
+		{DiscoveryOptions{Tags: map[string]string{"env": "prod", "team": "db"}}, false},
+		{DiscoveryOptions{Tags: map[string]string{"owner": "ops"}}, false},
+	}
+	for i, c := range cases {
+		if got := discoveryFilter(r, d, c.options); got != c.expected {
+			t.Fatalf("case %d: expected %t, got %t", i, c.expected, got)
+		}
+	}
+}
+
+func TestDiscoveryFilterable(t *testing.T) {
+	r := testDiscoveryResource()
+	if !discoveryFilterable(r, DiscoveryOptions{ResourceGroupId: "rg-1", Tags: map[string]string{"env": "prod"}}) {
+		t.Fatalf("expected the resource type with the tags and the resource group to be listed")
+	}
+	delete(r.Schema, "resource_group_id")
+	if discoveryFilterable(r, DiscoveryOptions{ResourceGroupId: "rg-1"}) {
+		t.Fatalf("expected the resource type without the resource group not to be listed")
+	}
+	delete(r.Schema, "tags")
+	delete(r.Schema, "tags_all")
+	if discoveryFilterable(r, DiscoveryOptions{Tags: map[string]string{"env": "prod"}}) {
+		t.Fatalf("expected the resource type without the tags not to be listed")
+	}
+	if !discoveryFilterable(r, DiscoveryOptions{}) {
+		t.Fatalf("expected the resource type to be listed without the filters")
+	}
+}
+
+func TestDiscoveryArguments(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	options := DiscoveryOptions{Tags: map[string]string{"env": "prod"}, ResourceGroupId: "rg-1"}
+
+	arguments := discoveryArguments(provider.DataSourcesMap["alicloud_instances"], map[string]interface{}{"status": "Running"}, options)
+	if arguments["status"] != "Running" || arguments["resource_group_id"] != "rg-1" {
+		t.Fatalf("unexpected arguments %v", arguments)
+	}
+	if tags, ok := arguments["tags"].(map[string]interface{}); !ok || tags["env"] != "prod" {
+		t.Fatalf("expected the tags to be passed to the data source, got %v", arguments)
+	}
+
+	arguments = discoveryArguments(provider.DataSourcesMap["alicloud_images"], map[string]interface{}{"owners": "self"}, options)
+	if len(arguments) != 1 {
+		t.Fatalf("expected the filters not accepted by the data source to be skipped, got %v", arguments)
+	}
+}
+
+func TestDiscoverReportsFailuresPerType(t *testing.T) {
+	defer func(v map[string]discoverer) {
+		discoverers = v
+	}(discoverers)
+	discoverers = map[string]discoverer{
+		"alicloud_test": {product: "test", dataSource: "alicloud_tests", arguments: map[string]interface{}{"type": "data"}},
+		"alicloud_test_failed": {product: "test", list: func(*connectivity.AliyunClient) ([]string, error) {
+			return nil, fmt.Errorf("ServiceUnavailable")
+		}},
+	}
+
+	r := testDiscoveryResource()
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if d.Id() == "i-broken" {
+			return fmt.Errorf("InternalError")
+		}
+		return d.Set("name", "web-"+d.Id())
+	}
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{"alicloud_test": r, "alicloud_test_failed": r},
+		DataSourcesMap: map[string]*schema.Resource{
+			"alicloud_tests": {
+				Schema: map[string]*schema.Schema{
+					"type": {Type: schema.TypeString, Optional: true},
+					"ids":  {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+				Read: func(d *schema.ResourceData, meta interface{}) error {
+					if d.Get("type").(string) != "data" {
+						return fmt.Errorf("expected the arguments to be set")
+					}
+					d.SetId("ids")
+					return d.Set("ids", []string{"i-1", "i-broken", "i-2"})
+				},
+			},
+		},
+	}
+	provider.SetMeta(&connectivity.AliyunClient{})
+
+	if _, err := Discover(provider, DiscoveryOptions{Products: []string{"ecs"}}, &bytes.Buffer{}, nil); err == nil {
+		t.Fatalf("expected an error for the unsupported product")
+	}
+
+	var w bytes.Buffer
+	resources, err := Discover(provider, DiscoveryOptions{Products: []string{"Test"}}, &w, nil)
+	discoveryError, ok := err.(*DiscoveryError)
+	if !ok {
+		t.Fatalf("expected a DiscoveryError, got %#v", err)
+	}
+	if len(discoveryError.Failures["alicloud_test_failed"]) != 1 || len(discoveryError.Failures["alicloud_test"]) != 1 {
+		t.Fatalf("unexpected failures %v", discoveryError.Failures)
+	}
+	if len(resources) != 2 || resources[0].Id != "i-1" || resources[1].Id != "i-2" {
+		t.Fatalf("expected the readable resources to be discovered, got %d", len(resources))
+	}
+	if !strings.Contains(w.String(), `resource "alicloud_test" "web-i-1"`) || !strings.Contains(w.String(), `resource "alicloud_test" "web-i-2"`) {
+		t.Fatalf("expected the discovered resources to be written, got:\n%s", w.String())
+	}
+}
+
+func TestDiscoverersMatchProvider(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	for resourceType, v := range discoverers {
+		if _, ok := provider.ResourcesMap[resourceType]; !ok {
+			t.Fatalf("the discovered resource type %s is not found", resourceType)
+		}
+		if v.list != nil {
+			continue
+		}
+		ds, ok := provider.DataSourcesMap[v.dataSource]
+		if !ok {
+			t.Fatalf("the data source %s of %s is not found", v.dataSource, resourceType)
+		}
+		if _, ok := ds.Schema["ids"]; !ok {
+			t.Fatalf("the data source %s of %s does not export ids", v.dataSource, resourceType)
+		}
+		for key := range v.arguments {
+			if _, ok := ds.Schema[key]; !ok {
+				t.Fatalf("the data source %s of %s does not support the argument %s", v.dataSource, resourceType, key)
+			}
+		}
+	}
+}
@@ -320,6 +320,19 @@ const (
 	// snapshot
 	SnapshotNotFound = "InvalidSnapshotId.NotFound"
 
+	// image
+	ImageNotFound = "InvalidImageId.NotFound"
+	TaskNotFound  = "InvalidTaskId.NotFound"
+
+	// dedicated host and deployment set
+	DedicatedHostNotFound    = "InvalidDedicatedHostId.NotFound"
+	DedicatedHostHasInstance = "InvalidOperation.DedicatedHostHasInstance"
+	DeploymentSetNotFound    = "InvalidDeploymentSetId.NotFound"
+
+	// cloud assistant
+	CommandNotFound    = "InvalidCmdId.NotFound"
+	InvocationNotFound = "InvalidInvokeId.NotFound"
+
 	// kv-store
 	InvalidKVStoreInstanceIdNotFound = "InvalidInstanceId.NotFound"
 	// MNS
@@ -371,6 +384,10 @@ const (
 
 	//emr
 	ClusterNotFound = "ClusterId.NotFound"
+
+	// Resource Manager
+	ResourceGroupNotFound = "EntityNotExists.ResourceGroup"
+	FolderNotFound        = "EntityNotExists.Folder"
 )
 
 var SlbIsBusy = []string{"SystemBusy", "OperationBusy", "ServiceIsStopping", "BackendServer.configuring", "ServiceIsConfiguring"}
@@ -381,8 +398,10 @@ var OperationDeniedDBStatus = []string{"OperationDenied.DBStatus", "OperationDen
 var DBReadInstanceNotReadyStatus = []string{"OperationDenied.ReadDBInstanceStatus", "OperationDenied.MasterDBInstanceState", "ReadDBInstance.Mismatch"}
 var NasNotFound = []string{InvalidMountTargetNotFound, InvalidFileSystemIDNotFound, ForbiddenNasNotFound, InvalidLBidNotFound, VolumeUnavailable}
 var SnapshotInvalidOperations = []string{"OperationConflict", "ServiceUnavailable", "InternalError", "SnapshotCreatedDisk", "SnapshotCreatedImage"}
+var ImageInvalidOperations = []string{"IncorrectImageStatus", "OperationConflict", "ServiceUnavailable", "InternalError"}
 var SnapshotPolicyInvalidOperations = []string{"OperationConflict", "ServiceUnavailable", "InternalError", "SnapshotCreatedDisk", "SnapshotCreatedImage"}
 var DiskNotSupportOnlineChangeErrors = []string{"InvalidDiskCategory.NotSupported", "InvalidRegion.NotSupport", "IncorrectInstanceStatus", "IncorrectDiskStatus", "InvalidOperation.InstanceTypeNotSupport"}
+var ResourceManagerNoPermission = []string{"NoPermission", "Forbidden.RAM", "Forbidden.AccessDenied"}
 
 // details at: https://help.aliyun.com/document_detail/27300.html
 var OtsTableIsTemporarilyUnavailable = []string{SuffixNoSuchHost, OTSServerBusy, OTSPartitionUnavailable, OTSInternalServerError,
@@ -82,6 +82,8 @@ const (
 	RenewAutoRenewal = RenewalStatus("AutoRenewal")
 	RenewNormal      = RenewalStatus("Normal")
 	RenewNotRenewal  = RenewalStatus("NotRenewal")
+
+	RenewManualRenewal = RenewalStatus("ManualRenewal")
 )
 
 type DiskType string
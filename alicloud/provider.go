@@ -10,9 +10,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
-
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
-	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
@@ -77,7 +75,14 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_ACCOUNT_ID", os.Getenv("ALICLOUD_ACCOUNT_ID")),
 				Description: descriptions["account_id"],
 			},
-			"assume_role": assumeRoleSchema(),
+			"assume_role":           assumeRoleSchema(),
+			"assume_role_with_oidc": assumeRoleWithOIDCSchema(),
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_CREDENTIAL_PROCESS", ""),
+				Description: descriptions["credential_process"],
+			},
 			"fc": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -109,10 +114,16 @@ func Provider() terraform.ResourceProvider {
 				Description:  descriptions["configuration_source"],
 				ValidateFunc: validateStringLengthInRange(0, 64),
 			},
+			"retry":        retrySchema(),
+			"rate_limits":  rateLimitsSchema(),
+			"default_tags": defaultTagsSchema(),
+			"ignore_tags":  ignoreTagsSchema(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 
 			"alicloud_account":                dataSourceAlicloudAccount(),
+			"alicloud_caller_identity":        dataSourceAlicloudCallerIdentity(),
+			"alicloud_endpoints":              dataSourceAlicloudEndpoints(),
 			"alicloud_images":                 dataSourceAlicloudImages(),
 			"alicloud_regions":                dataSourceAlicloudRegions(),
 			"alicloud_zones":                  dataSourceAlicloudZones(),
@@ -122,6 +133,8 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_disks":                  dataSourceAlicloudDisks(),
 			"alicloud_network_interfaces":     dataSourceAlicloudNetworkInterfaces(),
 			"alicloud_snapshots":              dataSourceAlicloudSnapshots(),
+			"alicloud_ecs_dedicated_hosts":    dataSourceAlicloudEcsDedicatedHosts(),
+			"alicloud_ecs_deployment_sets":    dataSourceAlicloudEcsDeploymentSets(),
 			"alicloud_vpcs":                   dataSourceAlicloudVpcs(),
 			"alicloud_vswitches":              dataSourceAlicloudVSwitches(),
 			"alicloud_eips":                   dataSourceAlicloudEips(),
@@ -141,6 +154,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_ram_users":                         dataSourceAlicloudRamUsers(),
 			"alicloud_ram_roles":                         dataSourceAlicloudRamRoles(),
 			"alicloud_ram_policies":                      dataSourceAlicloudRamPolicies(),
+			"alicloud_launch_template_versions":          dataSourceAlicloudLaunchTemplateVersions(),
 			"alicloud_security_groups":                   dataSourceAlicloudSecurityGroups(),
 			"alicloud_security_group_rules":              dataSourceAlicloudSecurityGroupRules(),
 			"alicloud_slbs":                              dataSourceAlicloudSlbs(),
@@ -227,6 +241,9 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_emr_disk_types":                    dataSourceAlicloudEmrDiskTypes(),
 			"alicloud_emr_main_versions":                 dataSourceAlicloudEmrMainVersions(),
 			"alicloud_sag_acls":                          dataSourceAlicloudSagAcls(),
+			"alicloud_resource_manager_resource_groups":  dataSourceAlicloudResourceManagerResourceGroups(),
+			"alicloud_price":                             dataSourceAlicloudPrice(),
+			"alicloud_expiring_subscriptions":            dataSourceAlicloudExpiringSubscriptions(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"alicloud_instance":                           resourceAliyunInstance(),
@@ -238,6 +255,15 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_snapshot":                           resourceAliyunSnapshot(),
 			"alicloud_snapshot_policy":                    resourceAliyunSnapshotPolicy(),
 			"alicloud_launch_template":                    resourceAliyunLaunchTemplate(),
+			"alicloud_image":                              resourceAliyunImage(),
+			"alicloud_image_copy":                         resourceAliyunImageCopy(),
+			"alicloud_image_share_permission":             resourceAliyunImageSharePermission(),
+			"alicloud_image_import":                       resourceAliyunImageImport(),
+			"alicloud_image_export":                       resourceAliyunImageExport(),
+			"alicloud_ecs_dedicated_host":                 resourceAlicloudEcsDedicatedHost(),
+			"alicloud_ecs_deployment_set":                 resourceAlicloudEcsDeploymentSet(),
+			"alicloud_ecs_command":                        resourceAlicloudEcsCommand(),
+			"alicloud_ecs_invocation":                     resourceAlicloudEcsInvocation(),
 			"alicloud_security_group":                     resourceAliyunSecurityGroup(),
 			"alicloud_security_group_rule":                resourceAliyunSecurityGroupRule(),
 			"alicloud_db_database":                        resourceAlicloudDBDatabase(),
@@ -393,6 +419,9 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_sag_qos_policy":                      resourceAlicloudSagQosPolicy(),
 			"alicloud_sag_qos_car":                         resourceAlicloudSagQosCar(),
 			"alicloud_sag_snat_entry":                      resourceAlicloudSagSnatEntry(),
+			"alicloud_resource_manager_resource_group":     resourceAlicloudResourceManagerResourceGroup(),
+			"alicloud_resource_manager_folder":             resourceAlicloudResourceManagerFolder(),
+			"alicloud_renewal":                             resourceAlicloudRenewal(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -442,47 +471,78 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.RamRoleSessionExpiration = (int)(expiredSeconds.(float64))
 	}
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		if assumeRole["role_arn"].(string) != "" {
-			config.RamRoleArn = assumeRole["role_arn"].(string)
+	defaultSessionExpiration := config.RamRoleSessionExpiration
+	if v := os.Getenv("ALICLOUD_ASSUME_ROLE_SESSION_EXPIRATION"); v != "" {
+		if expiredSeconds, err := strconv.Atoi(v); err == nil {
+			defaultSessionExpiration = expiredSeconds
 		}
-		if assumeRole["session_name"].(string) != "" {
-			config.RamRoleSessionName = assumeRole["session_name"].(string)
-		}
-		if config.RamRoleSessionName == "" {
-			config.RamRoleSessionName = "terraform"
+	}
+	if defaultSessionExpiration == 0 {
+		defaultSessionExpiration = 3600
+	}
+	for i, raw := range d.Get("assume_role").([]interface{}) {
+		assumeRole := raw.(map[string]interface{})
+		hop := connectivity.AssumeRoleHop{
+			RoleArn:           assumeRole["role_arn"].(string),
+			SessionName:       assumeRole["session_name"].(string),
+			Policy:            assumeRole["policy"].(string),
+			ExternalId:        assumeRole["external_id"].(string),
+			SessionExpiration: assumeRole["session_expiration"].(int),
 		}
-		config.RamRolePolicy = assumeRole["policy"].(string)
-		if assumeRole["session_expiration"].(int) == 0 {
-			if v := os.Getenv("ALICLOUD_ASSUME_ROLE_SESSION_EXPIRATION"); v != "" {
-				if expiredSeconds, err := strconv.Atoi(v); err == nil {
-					config.RamRoleSessionExpiration = expiredSeconds
-				}
-			}
-			if config.RamRoleSessionExpiration == 0 {
-				config.RamRoleSessionExpiration = 3600
+		// An empty role_arn of the first hop does not perform role switching, like the single assume_role before.
+		if hop.RoleArn == "" {
+			if i == 0 {
+				break
 			}
-		} else {
-			config.RamRoleSessionExpiration = assumeRole["session_expiration"].(int)
+			return nil, WrapError(fmt.Errorf("The role_arn of the assume_role hop %d is empty.", i+1))
+		}
+		if hop.SessionName == "" {
+			hop.SessionName = "terraform"
 		}
+		if hop.SessionExpiration == 0 {
+			hop.SessionExpiration = defaultSessionExpiration
+		}
+		config.AssumeRoleHops = append(config.AssumeRoleHops, hop)
 
-		log.Printf("[INFO] assume_role configuration set: (RamRoleArn: %q, RamRoleSessionName: %q, RamRolePolicy: %q, RamRoleSessionExpiration: %d)",
-			config.RamRoleArn, config.RamRoleSessionName, config.RamRolePolicy, config.RamRoleSessionExpiration)
+		log.Printf("[INFO] assume_role hop %d configuration set: (RoleArn: %q, SessionName: %q, Policy: %q, ExternalId set: %t, SessionExpiration: %d)",
+			i+1, hop.RoleArn, hop.SessionName, hop.Policy, hop.ExternalId != "", hop.SessionExpiration)
 	}
-
-	if err := config.MakeConfigByEcsRoleName(); err != nil {
-		return nil, err
+	if len(config.AssumeRoleHops) > 0 {
+		// The first hop is also used by the clients which assume the role by themselves
+		config.RamRoleArn = config.AssumeRoleHops[0].RoleArn
+		config.RamRoleSessionName = config.AssumeRoleHops[0].SessionName
+		config.RamRolePolicy = config.AssumeRoleHops[0].Policy
+		config.RamRoleSessionExpiration = config.AssumeRoleHops[0].SessionExpiration
 	}
 
-	if config.RamRoleArn != "" {
-		config.AccessKey, config.SecretKey, config.SecurityToken, err = getAssumeRoleAK(config.AccessKey, config.SecretKey, config.SecurityToken, region, config.RamRoleArn, config.RamRoleSessionName, config.RamRolePolicy, config.RamRoleSessionExpiration)
-		if err != nil {
-			return nil, err
-		}
+	config.CredentialProcess = strings.TrimSpace(getProviderConfig(d.Get("credential_process").(string), "process_command"))
+
+	config.OIDCProviderArn = getProviderConfig("", "oidc_provider_arn")
+	config.OIDCTokenFile = getProviderConfig("", "oidc_token_file")
+	config.OIDCRoleArn = getProviderConfig("", "oidc_role_arn")
+	config.OIDCSessionName = getProviderConfig("", "oidc_session_name")
+	oidcList := d.Get("assume_role_with_oidc").(*schema.Set).List()
+	if len(oidcList) == 1 {
+		oidc := oidcList[0].(map[string]interface{})
+		config.OIDCProviderArn = strings.TrimSpace(oidc["oidc_provider_arn"].(string))
+		config.OIDCTokenFile = strings.TrimSpace(oidc["oidc_token_file"].(string))
+		config.OIDCToken = strings.TrimSpace(oidc["oidc_token"].(string))
+		config.OIDCRoleArn = strings.TrimSpace(oidc["role_arn"].(string))
+		config.OIDCSessionName = oidc["session_name"].(string)
+		config.OIDCPolicy = oidc["policy"].(string)
+		config.OIDCSessionExpiration = oidc["session_expiration"].(int)
+	} else if config.OIDCProviderArn == "" && os.Getenv("ALIBABA_CLOUD_OIDC_PROVIDER_ARN") != "" {
+		// The environment variables injected into the pods by the RAM Roles for Service Accounts of Kubernetes
+		config.OIDCProviderArn = os.Getenv("ALIBABA_CLOUD_OIDC_PROVIDER_ARN")
+		config.OIDCTokenFile = os.Getenv("ALIBABA_CLOUD_OIDC_TOKEN_FILE")
+		config.OIDCRoleArn = os.Getenv("ALIBABA_CLOUD_ROLE_ARN")
+	}
+	if config.OIDCProviderArn != "" {
+		log.Printf("[INFO] assume_role_with_oidc configuration set: (OIDCProviderArn: %q, OIDCTokenFile: %q, RoleArn: %q, SessionName: %q)",
+			config.OIDCProviderArn, config.OIDCTokenFile, config.OIDCRoleArn, config.OIDCSessionName)
 	}
 
+	// The endpoints are parsed before the credential providers, which call the STS API when they are built.
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -522,6 +582,23 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.BssOpenApiEndpoint = strings.TrimSpace(endpoints["bssopenapi"].(string))
 		config.DdoscooEndpoint = strings.TrimSpace(endpoints["ddoscoo"].(string))
 		config.DdosbgpEndpoint = strings.TrimSpace(endpoints["ddosbgp"].(string))
+		config.ResourceManagerEndpoint = strings.TrimSpace(endpoints["resourcemanager"].(string))
+	}
+
+	if err := config.MakeConfigByCredentialProcess(); err != nil {
+		return nil, err
+	}
+
+	if err := config.MakeConfigByOIDC(); err != nil {
+		return nil, err
+	}
+
+	if err := config.MakeConfigByEcsRoleName(); err != nil {
+		return nil, err
+	}
+
+	if err := config.MakeConfigByAssumeRole(); err != nil {
+		return nil, err
 	}
 
 	if ots_instance_name, ok := d.GetOk("ots_instance_name"); ok && ots_instance_name.(string) != "" {
@@ -543,6 +620,55 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.FcEndpoint = strings.TrimSpace(fcEndpoint.(string))
 	}
 
+	config.RetryPolicy = connectivity.DefaultRetryPolicy()
+	if retryList := d.Get("retry").([]interface{}); len(retryList) == 1 && retryList[0] != nil {
+		retry := retryList[0].(map[string]interface{})
+		config.RetryPolicy = expandRetryPolicy(retry)
+		config.RetryPolicy.Jitter = retry["jitter"].(bool)
+		config.ProductRetryPolicies = make(map[connectivity.ServiceCode]connectivity.RetryPolicy)
+		for _, v := range retry["product_override"].(*schema.Set).List() {
+			override := v.(map[string]interface{})
+			product := connectivity.ServiceCode(strings.ToUpper(strings.TrimSpace(override["product"].(string))))
+			policy := expandRetryPolicy(override)
+			if jitter := override["jitter"].(string); jitter != "" {
+				value := jitter == "true"
+				policy.JitterOverride = &value
+			}
+			config.ProductRetryPolicies[product] = policy
+		}
+		log.Printf("[INFO] retry configuration set: (MaxAttempts: %d, MaxBackoff: %s, Jitter: %t, ExtraRetryableCodes: %v)",
+			config.RetryPolicy.MaxAttempts, config.RetryPolicy.MaxBackoff, config.RetryPolicy.Jitter, config.RetryPolicy.ExtraRetryableCodes)
+	}
+
+	if rateLimitsList := d.Get("rate_limits").([]interface{}); len(rateLimitsList) == 1 && rateLimitsList[0] != nil {
+		rateLimits := rateLimitsList[0].(map[string]interface{})
+		config.RateLimits = make(map[connectivity.ServiceCode]int)
+		for _, product := range rateLimitProducts {
+			if limit, ok := rateLimits[product]; ok && limit.(int) > 0 {
+				config.RateLimits[connectivity.ServiceCode(strings.ToUpper(product))] = limit.(int)
+			}
+		}
+		config.ActionRateLimits = make(map[string]int)
+		for _, v := range rateLimits["action"].(*schema.Set).List() {
+			action := v.(map[string]interface{})
+			key := connectivity.RateLimitActionKey(connectivity.ServiceCode(action["product"].(string)), action["name"].(string))
+			config.ActionRateLimits[key] = action["limit"].(int)
+		}
+		log.Printf("[INFO] rate_limits configuration set: (RateLimits: %v, ActionRateLimits: %v)", config.RateLimits, config.ActionRateLimits)
+	}
+
+	if defaultTagsList := d.Get("default_tags").([]interface{}); len(defaultTagsList) == 1 && defaultTagsList[0] != nil {
+		config.DefaultTags = make(map[string]string)
+		for key, value := range defaultTagsList[0].(map[string]interface{})["tags"].(map[string]interface{}) {
+			config.DefaultTags[key] = value.(string)
+		}
+	}
+	if ignoreTagsList := d.Get("ignore_tags").([]interface{}); len(ignoreTagsList) == 1 && ignoreTagsList[0] != nil {
+		ignoreTags := ignoreTagsList[0].(map[string]interface{})
+		config.IgnoreTagKeys = expandStringList(ignoreTags["keys"].(*schema.Set).List())
+		config.IgnoreTagKeyPrefixes = expandStringList(ignoreTags["key_prefixes"].(*schema.Set).List())
+	}
+
 	client, err := config.Client()
 	if err != nil {
 		return nil, err
@@ -551,6 +677,14 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	return client, nil
 }
 
+func expandRetryPolicy(retry map[string]interface{}) connectivity.RetryPolicy {
+	return connectivity.RetryPolicy{
+		MaxAttempts:         retry["max_attempts"].(int),
+		MaxBackoff:          time.Duration(retry["max_backoff"].(int)) * time.Second,
+		ExtraRetryableCodes: expandStringList(retry["extra_retryable_codes"].([]interface{})),
+	}
+}
+
 // This is a global MutexKV for use within this plugin.
 var alicloudMutexKV = mutexkv.NewMutexKV()
 
@@ -580,12 +714,60 @@ func init() {
 
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use, this policy to grant further permissions that are in excess to those of the, role that is being assumed.",
 
+		"assume_role_external_id": "The external ID required by the trust policy of the role, which prevents the confused deputy problem.",
+
 		"assume_role_session_expiration": "The time after which the established session for assuming role expires. Valid value range: [900-3600] seconds. Default to 0 (in this case Alicloud use own default value).",
 
-		"skip_region_validation": "Skip static validation of region ID. Used by users of alternative AlibabaCloud-like APIs or users w/ access to regions that are not public (yet).",
+		"assume_role_with_oidc_oidc_provider_arn": "The ARN of the OIDC identity provider, like `acs:ram::ACCOUNT_ID:oidc-provider/PROVIDER_NAME`.",
+
+		"assume_role_with_oidc_oidc_token_file": "The path of the file which contains the OIDC token. It is read again every time the role is assumed, so a rotated token is used.",
+
+		"assume_role_with_oidc_oidc_token": "The OIDC token. It conflicts with `oidc_token_file`, which should be used if the token is rotated.",
+
+		"assume_role_with_oidc_role_arn": "The ARN of the RAM role to assume with the OIDC token.",
+
+		"assume_role_with_oidc_session_name": "The session name to use when assuming the role. If omitted, `terraform` is passed to the AssumeRoleWithOIDC call as session name.",
+
+		"assume_role_with_oidc_policy": "The permissions applied when assuming the role, which cannot grant permissions in excess of the role.",
+
+		"assume_role_with_oidc_session_expiration": "The time in seconds after which the assumed role session expires. Valid value range: [900-3600] seconds.",
+
+		"credential_process": "An external command which prints the credential in JSON, like `{\"mode\": \"StsToken\", \"access_key_id\": \"...\", \"access_key_secret\": \"...\", \"sts_token\": \"...\", \"expiration\": \"...\"}`. It is run again before the credential expires.",
+
+		"skip_region_validation": "Skip validation of region ID against the regions returned by the DescribeRegions API and the product availability check at plan time. Used by users of alternative AlibabaCloud-like APIs or users w/ access to regions that are not public (yet).",
 
 		"configuration_source": "Use this to mark a terraform configuration file source.",
 
+		"retry_max_attempts": "The max number of attempts, including the first one, for an API call which fails with a retryable error, like Throttling and ServiceUnavailable. Default to 10.",
+
+		"retry_max_backoff": "The max waiting time in seconds between two attempts. The waiting time doubles after each failed attempt until it reaches this value. Default to 30.",
+
+		"retry_jitter": "Whether to randomize the waiting time between two attempts, so the concurrent API calls do not retry at the same time. Default to true.",
+
+		"retry_extra_retryable_codes": "The API error codes which should be retried besides Throttling, ServiceUnavailable and client connection failures.",
+
+		"retry_product_override": "The retry settings of a specified product, like ecs, vpc and slb. The unset fields inherit the values of the retry block.",
+
+		"rate_limits_product": "The max number of %s API calls per second. The calls exceeding it wait on the client side instead of failing with Throttling errors.",
+
+		"rate_limits_action": "The max number of calls per second of a specified API action, which is applied besides the limit of its product.",
+
+		"rate_limits_action_product": "The product code of the action, which is the same as the nested endpoints, like ecs, vpc and slb.",
+
+		"rate_limits_action_name": "The API action name, like RunInstances and DescribeInstances.",
+
+		"default_tags": "The tags which are merged into the tags of every taggable resource. The tags of a resource take precedence over them.",
+
+		"ignore_tags_keys": "The tag keys which are neither read nor managed by any resource, like the tags added by other services.",
+
+		"ignore_tags_key_prefixes": "The prefixes of the tag keys which are neither read nor managed by any resource, like `acs:` and `ack.`.",
+
+		"rate_limits_action_limit": "The max number of calls of the action per second.",
+
+		"retry_product_override_product": "The product code of the override, which is the same as the nested endpoints, like ecs, vpc, slb and rds.",
+
+		"retry_product_override_jitter": "Whether to randomize the waiting time of the product, \"true\" or \"false\". It inherits the jitter of the retry block when it is not set.",
+
 		"ecs_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom ECS endpoints.",
 
 		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom RDS endpoints.",
@@ -657,14 +839,15 @@ func init() {
 		"ddoscoo_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom DDOSCOO endpoints.",
 
 		"ddosbgp_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom DDOSBGP endpoints.",
+
+		"resourcemanager_endpoint": "Use this to override the default endpoint URL. It's typically used to connect to custom Resource Manager endpoints.",
 	}
 }
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
+		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -684,6 +867,12 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+				"external_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: descriptions["assume_role_external_id"],
+				},
 				"session_expiration": {
 					Type:         schema.TypeInt,
 					Optional:     true,
@@ -695,6 +884,231 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithOIDCSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"oidc_provider_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_oidc_oidc_provider_arn"],
+					DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_OIDC_PROVIDER_ARN", ""),
+				},
+				"oidc_token_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["assume_role_with_oidc_oidc_token_file"],
+					DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_OIDC_TOKEN_FILE", ""),
+				},
+				"oidc_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: descriptions["assume_role_with_oidc_oidc_token"],
+				},
+				"role_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_oidc_role_arn"],
+					DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_OIDC_ROLE_ARN", ""),
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["assume_role_with_oidc_session_name"],
+				},
+				"policy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["assume_role_with_oidc_policy"],
+				},
+				"session_expiration": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_with_oidc_session_expiration"],
+					ValidateFunc: intBetween(900, 3600),
+				},
+			},
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      connectivity.DefaultRetryMaxAttempts,
+					Description:  descriptions["retry_max_attempts"],
+					ValidateFunc: intBetween(1, 100),
+				},
+				"max_backoff": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      int(connectivity.DefaultRetryMaxBackoff / time.Second),
+					Description:  descriptions["retry_max_backoff"],
+					ValidateFunc: intBetween(1, 600),
+				},
+				"jitter": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: descriptions["retry_jitter"],
+				},
+				"extra_retryable_codes": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["retry_extra_retryable_codes"],
+				},
+				"product_override": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: descriptions["retry_product_override"],
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"product": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  descriptions["retry_product_override_product"],
+								ValidateFunc: validateAllowedStringValue(rateLimitProducts),
+							},
+							"max_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  descriptions["retry_max_attempts"],
+								ValidateFunc: intBetween(1, 100),
+							},
+							"max_backoff": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  descriptions["retry_max_backoff"],
+								ValidateFunc: intBetween(1, 600),
+							},
+							// A bool can not tell an unset value from false in a set, so it is a string and empty means inheriting.
+							"jitter": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  descriptions["retry_product_override_jitter"],
+								ValidateFunc: validateAllowedStringValue([]string{"true", "false"}),
+							},
+							"extra_retryable_codes": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: descriptions["retry_extra_retryable_codes"],
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// rateLimitProducts are the products which support the client-side rate limit and the retry override, and they are the same as the nested endpoints.
+var rateLimitProducts = []string{"ecs", "rds", "slb", "vpc", "cen", "ess", "oss", "ons", "alikafka", "dns", "ram", "cs", "cr", "cdn",
+	"kms", "ots", "cms", "pvtz", "sts", "log", "drds", "dds", "gpdb", "kvstore", "fc", "apigateway", "datahub", "mns", "location",
+	"elasticsearch", "nas", "actiontrail", "cas", "bssopenapi", "ddoscoo", "ddosbgp", "resourcemanager"}
+
+func rateLimitsSchema() *schema.Schema {
+	limits := map[string]*schema.Schema{
+		"action": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: descriptions["rate_limits_action"],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"product": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: descriptions["rate_limits_action_product"],
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: descriptions["rate_limits_action_name"],
+					},
+					"limit": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						Description:  descriptions["rate_limits_action_limit"],
+						ValidateFunc: intBetween(1, 10000),
+					},
+				},
+			},
+		},
+	}
+	for _, product := range rateLimitProducts {
+		limits[product] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  fmt.Sprintf(descriptions["rate_limits_product"], strings.ToUpper(product)),
+			ValidateFunc: intBetween(1, 10000),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: limits,
+		},
+	}
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: descriptions["default_tags"],
+				},
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -923,6 +1337,12 @@ func endpointsSchema() *schema.Schema {
 					Default:     "",
 					Description: descriptions["ddosbgp_endpoint"],
 				},
+				"resourcemanager": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["resourcemanager_endpoint"],
+				},
 			},
 		},
 		Set: endpointsToHash,
@@ -967,6 +1387,7 @@ func endpointsToHash(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["bssopenapi"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["ddoscoo"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["ddosbgp"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["resourcemanager"].(string)))
 	return hashcode.String(buf.String())
 }
 
@@ -1016,7 +1437,7 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 	}
 	switch ProfileKey {
 	case "access_key_id", "access_key_secret":
-		if mode == "EcsRamRole" {
+		if mode == "EcsRamRole" || mode == "OIDC" || mode == "External" {
 			return "", nil
 		}
 	case "ram_role_name":
@@ -1035,35 +1456,21 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 		if mode != "RamRoleArn" {
 			return float64(0), nil
 		}
+	case "oidc_provider_arn", "oidc_token_file":
+		if mode != "OIDC" {
+			return "", nil
+		}
+	case "oidc_role_arn", "oidc_session_name":
+		// The OIDC mode shares ram_role_arn and ram_session_name with the RamRoleArn mode
+		if mode != "OIDC" {
+			return "", nil
+		}
+		return providerConfig[strings.Replace(ProfileKey, "oidc_", "ram_", 1)], nil
+	case "process_command":
+		if mode != "External" {
+			return "", nil
+		}
 	}
 
 	return providerConfig[ProfileKey], nil
 }
-
-func getAssumeRoleAK(accessKey, secretKey, stsToken, region, roleArn, sessionName, policy string, sessionExpiration int) (string, string, string, error) {
-	request := sts.CreateAssumeRoleRequest()
-	request.RoleArn = roleArn
-	request.RoleSessionName = sessionName
-	request.DurationSeconds = requests.NewInteger(sessionExpiration)
-	request.Policy = policy
-	request.Scheme = "https"
-
-	var client *sts.Client
-	var err error
-	if stsToken == "" {
-		client, err = sts.NewClientWithAccessKey(region, accessKey, secretKey)
-	} else {
-		client, err = sts.NewClientWithStsToken(region, accessKey, secretKey, stsToken)
-	}
-
-	if err != nil {
-		return "", "", "", err
-	}
-
-	response, err := client.AssumeRole(request)
-	if err != nil {
-		return "", "", "", err
-	}
-
-	return response.Credentials.AccessKeyId, response.Credentials.AccessKeySecret, response.Credentials.SecurityToken, nil
-}
@@ -3,7 +3,11 @@ package alicloud
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
@@ -56,6 +60,112 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+func TestProviderConfigureStsEndpoint(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		actions = append(actions, r.Form.Get("Action"))
+		w.Write([]byte(`{"RequestId": "1", "Credentials": {"AccessKeyId": "STS.oidc", "AccessKeySecret": "secret", "SecurityToken": "token", "Expiration": "2019-10-17T11:52:19Z"}}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"region":                 "cn-hangzhou",
+		"skip_region_validation": true,
+		"endpoints": []interface{}{
+			map[string]interface{}{"sts": server.URL},
+		},
+		"assume_role_with_oidc": []interface{}{
+			map[string]interface{}{
+				"oidc_provider_arn": "acs:ram::123:oidc-provider/ack",
+				"oidc_token":        "token",
+				"role_arn":          "acs:ram::123:role/ci",
+			},
+		},
+	})
+	if _, err := providerConfigure(d); err != nil {
+		t.Fatalf("configuring the provider got an error: %#v", err)
+	}
+	if len(actions) != 1 || actions[0] != "AssumeRoleWithOIDC" {
+		t.Fatalf("the first STS call should be sent to the endpoint of endpoints.sts, got %v.", actions)
+	}
+}
+
+// testAccRecorder returns the recorder of the cassette testdata/cassettes/<test name>.yaml. The API calls are recorded into
+// the cassette when TF_ACC is set, and replayed from it without any credential otherwise, so that the CRUD logic can be
+// tested offline. The test is skipped if its cassette has not been recorded.
+// It must be called before the random values of the test are generated by testAccRecordedRandInt.
+func testAccRecorder(t *testing.T) *connectivity.Recorder {
+	path := filepath.Join("testdata", "cassettes", t.Name()+".yaml")
+	mode := connectivity.RecorderModeRecording
+	if os.Getenv(resource.TestEnvVar) == "" {
+		if _, err := os.Stat(path); err != nil {
+			t.Skipf("Skipping the test %s without the cassette %s. Set %s to record it.", t.Name(), path, resource.TestEnvVar)
+		}
+		mode = connectivity.RecorderModeReplaying
+	}
+	recorder, err := connectivity.NewRecorder(path, mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	region := recorder.Value("region", func() string {
+		if v := os.Getenv("ALICLOUD_REGION"); v != "" {
+			return v
+		}
+		return "cn-beijing"
+	})
+	if mode == connectivity.RecorderModeReplaying {
+		os.Setenv("ALICLOUD_REGION", region)
+		for _, key := range []string{"ALICLOUD_ACCESS_KEY", "ALICLOUD_SECRET_KEY"} {
+			if os.Getenv(key) == "" {
+				os.Setenv(key, "replayed")
+			}
+		}
+	}
+	return recorder
+}
+
+// testAccRecordedRandInt returns a random integer which is kept in the cassette, so the replayed test uses the same names.
+func testAccRecordedRandInt(recorder *connectivity.Recorder) int {
+	value, _ := strconv.Atoi(recorder.Value("rand", func() string {
+		return strconv.Itoa(acctest.RandInt())
+	}))
+	return value
+}
+
+// testAccRecordedTest runs the test case by a provider whose API calls go through the recorder, and writes the cassette
+// at the end. The PreCheck is skipped when the cassette is replayed.
+func testAccRecordedTest(t *testing.T, recorder *connectivity.Recorder, c resource.TestCase) {
+	provider := Provider().(*schema.Provider)
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		client, err := providerConfigure(d)
+		if err != nil {
+			return nil, err
+		}
+		client.(*connectivity.AliyunClient).UseRecorder(recorder)
+		return client, nil
+	}
+	if recorder.Mode() == connectivity.RecorderModeReplaying {
+		c.PreCheck = nil
+	}
+	c.Providers = map[string]terraform.ResourceProvider{
+		"alicloud": provider,
+	}
+
+	// The checks get the client by testAccProvider.
+	defaultProvider := testAccProvider
+	testAccProvider = provider
+	defer func() {
+		testAccProvider = defaultProvider
+	}()
+
+	resource.UnitTest(t, c)
+	if err := recorder.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // currently not all account site type support create PostPaid resources, PayByBandwidth and other limits.
 // The setting of account site type can skip some unsupported cases automatically.
 
@@ -117,6 +227,26 @@ func testSweepPreCheckWithRegions(region string, supported bool, regions []conne
 	return (find && !supported) || (!find && supported)
 }
 
+// testAccPreCheckWithProduct skips the test when the product is not available in the test region according to its
+// DescribeRegions API.
+func testAccPreCheckWithProduct(t *testing.T, serviceCode connectivity.ServiceCode) {
+	testAccPreCheck(t)
+	region := os.Getenv("ALICLOUD_REGION")
+	if testSweepPreCheckWithProduct(region, serviceCode) {
+		t.Skipf("Skipping unsupported region %s. The product %s is not available in it.", region, serviceCode)
+	}
+}
+
+// testSweepPreCheckWithProduct reports whether the product is not available in the region according to its
+// DescribeRegions API. The region is not skipped if the client can not be built.
+func testSweepPreCheckWithProduct(region string, serviceCode connectivity.ServiceCode) bool {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return false
+	}
+	return !rawClient.(*connectivity.AliyunClient).IsProductAvailable(serviceCode)
+}
+
 func testAccCheckAlicloudDataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -138,6 +268,24 @@ func testAccPreCheckWithMultipleAccount(t *testing.T) {
 	}
 }
 
+func testAccPreCheckWithImageShareAccountSetting(t *testing.T) {
+	if v := strings.TrimSpace(os.Getenv("ALICLOUD_IMAGE_SHARE_ACCOUNT_ID")); v == "" {
+		t.Skipf("Skipping the test case with no image share account setting")
+		t.Skipped()
+	}
+}
+
+func testAccPreCheckWithImageImportSetting(t *testing.T) {
+	if v := strings.TrimSpace(os.Getenv("ALICLOUD_IMAGE_IMPORT_OSS_BUCKET")); v == "" {
+		t.Skipf("Skipping the test case with no image import oss bucket setting")
+		t.Skipped()
+	}
+	if v := strings.TrimSpace(os.Getenv("ALICLOUD_IMAGE_IMPORT_OSS_OBJECT")); v == "" {
+		t.Skipf("Skipping the test case with no image import oss object setting")
+		t.Skipped()
+	}
+}
+
 func testAccPreCheckWithCmsContactGroupSetting(t *testing.T) {
 	if v := strings.TrimSpace(os.Getenv("ALICLOUD_CMS_CONTACT_GROUP")); v == "" {
 		t.Skipf("Skipping the test case with no cms contact group setting")
@@ -18,6 +18,11 @@ func resourceAlicloudActiontrail() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -110,7 +115,7 @@ func resourceAlicloudActiontrailCreate(d *schema.ResourceData, meta interface{})
 	if err := trailService.startActionTrail(d.Id()); err != nil {
 		return WrapError(err)
 	}
-	if err := trailService.WaitForActionTrail(d.Id(), Enable, DefaultTimeout); err != nil {
+	if err := trailService.WaitForActionTrail(d.Id(), Enable, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -211,6 +216,6 @@ func resourceAlicloudActiontrailDelete(d *schema.ResourceData, meta interface{})
 
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(trailService.WaitForActionTrail(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(trailService.WaitForActionTrail(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 
 }
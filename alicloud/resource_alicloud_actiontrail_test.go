@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers(
+	addTestSweepers(
 		"alicloud_actiontrail",
 		&resource.Sweeper{
 			Name: "alicloud_actiontrail",
@@ -62,6 +62,7 @@ func testSweepActiontrail(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_actiontrail", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping api: %s", name)
 			continue
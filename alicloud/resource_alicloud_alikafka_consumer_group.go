@@ -18,6 +18,10 @@ func resourceAlicloudAlikafkaConsumerGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -128,5 +132,5 @@ func resourceAlicloudAlikafkaConsumerGroupDelete(d *schema.ResourceData, meta in
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(alikafkaService.WaitForAlikafkaConsumerGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(alikafkaService.WaitForAlikafkaConsumerGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
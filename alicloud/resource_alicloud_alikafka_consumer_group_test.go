@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_alikafka_consumer_group", &resource.Sweeper{
+	addTestSweepers("alicloud_alikafka_consumer_group", &resource.Sweeper{
 		Name: "alicloud_alikafka_consumer_group",
 		F:    testSweepAlikafkaConsumerGroup,
 	})
@@ -78,6 +78,7 @@ func testSweepAlikafkaConsumerGroup(region string) error {
 					break
 				}
 			}
+			skip = sweepSkip("alicloud_alikafka_consumer_group", skip, v)
 			if skip {
 				log.Printf("[INFO] Skipping alikafka consumer id: %s ", name)
 				continue
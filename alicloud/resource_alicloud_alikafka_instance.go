@@ -22,6 +22,14 @@ func resourceAlicloudAlikafkaInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffAll(tagsAllCustomizeDiff, tagApiTagsCustomizeDiff),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Update: schema.DefaultTimeout(2000 * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -67,6 +75,13 @@ func resourceAlicloudAlikafkaInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -169,12 +184,24 @@ func resourceAlicloudAlikafkaInstanceCreate(d *schema.ResourceData, meta interfa
 	}
 
 	// 3. wait until running
-	err = alikafkaService.WaitForAlikafkaInstance(d.Id(), Running, DefaultLongTimeout)
+	err = alikafkaService.WaitForAlikafkaInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 
 	if err != nil {
 		return WrapError(err)
 	}
 
+	// 4. tag the instance
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, connectivity.ALIKAFKACode, TagResourceInstance); err != nil {
+		return WrapError(err)
+	}
+
+	// 5. move the instance into the resource group
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupAlikafkaInstance); err != nil {
+		return WrapError(err)
+	}
+
 	return resourceAlicloudAlikafkaInstanceRead(d, meta)
 }
 
@@ -204,6 +231,22 @@ func resourceAlicloudAlikafkaInstanceRead(d *schema.ResourceData, meta interface
 	d.Set("vswitch_id", object.VSwitchId)
 	d.Set("zone_id", object.ZoneId)
 
+	tagService := TagService{client}
+	tags, err := tagService.ListResourceTags(d.Id(), connectivity.ALIKAFKACode, TagResourceInstance)
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tags); err != nil {
+		return WrapError(err)
+	}
+
+	resourceManagerService := ResourceManagerService{client}
+	resourceGroupId, err := resourceManagerService.DescribeResourceGroupId(d.Id(), ResourceGroupAlikafkaInstance)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("resource_group_id", resourceGroupId)
+
 	return nil
 }
 
@@ -212,6 +255,16 @@ func resourceAlicloudAlikafkaInstanceUpdate(d *schema.ResourceData, meta interfa
 	client := meta.(*connectivity.AliyunClient)
 	alikafkaService := AlikafkaService{client}
 
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, connectivity.ALIKAFKACode, TagResourceInstance); err != nil {
+		return WrapError(err)
+	}
+
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupAlikafkaInstance); err != nil {
+		return WrapError(err)
+	}
+
 	// Process change instance name.
 	if d.HasChange("name") {
 		var name string
@@ -288,13 +341,13 @@ func resourceAlicloudAlikafkaInstanceUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	err := alikafkaService.WaitForAlikafkaInstanceUpdated(d.Id(), d.Get("topic_quota").(int),
-		d.Get("disk_size").(int), d.Get("io_max").(int), eipMax, DefaultTimeoutMedium)
+		d.Get("disk_size").(int), d.Get("io_max").(int), eipMax, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 
 	if err != nil {
 		return WrapError(err)
 	}
 
-	err = alikafkaService.WaitForAlikafkaInstance(d.Id(), Running, 2000)
+	err = alikafkaService.WaitForAlikafkaInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 
 	if err != nil {
 		return WrapError(err)
@@ -332,5 +385,5 @@ func resourceAlicloudAlikafkaInstanceDelete(d *schema.ResourceData, meta interfa
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(alikafkaService.WaitForAllAlikafkaNodeRelease(d.Id(), DefaultTimeoutMedium))
+	return WrapError(alikafkaService.WaitForAllAlikafkaNodeRelease(d.Id(), int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_alikafka_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_alikafka_instance", &resource.Sweeper{
 		Name: "alicloud_alikafka_instance",
 		F:    testSweepAlikafkaInstance,
 	})
@@ -56,6 +56,7 @@ func testSweepAlikafkaInstance(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_alikafka_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping alikafka instance: %s ", name)
 			continue
@@ -189,6 +190,24 @@ func TestAccAlicloudAlikafkaInstance_basic(t *testing.T) {
 				),
 			},
 
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"tags": map[string]string{
+						"Created": "TF",
+						"For":     "acceptance test",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tags.%":           "2",
+						"tags.Created":     "TF",
+						"tags.For":         "acceptance test",
+						"tags_all.%":       "2",
+						"tags_all.Created": "TF",
+					}),
+				),
+			},
+
 			{
 				Config: testAccConfig(map[string]interface{}{
 					"name":        "${var.name}",
@@ -198,16 +217,20 @@ func TestAccAlicloudAlikafkaInstance_basic(t *testing.T) {
 					"deploy_type": "5",
 					"io_max":      "20",
 					"eip_max":     "0",
+					"tags":        REMOVEKEY,
 				}),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheck(map[string]string{
-						"name":        fmt.Sprintf("tf-testacc-alikafkainstancebasic%v", rand),
-						"topic_quota": "50",
-						"disk_type":   "1",
-						"disk_size":   "500",
-						"deploy_type": "5",
-						"io_max":      "20",
-						"eip_max":     "0",
+						"name":         fmt.Sprintf("tf-testacc-alikafkainstancebasic%v", rand),
+						"topic_quota":  "50",
+						"disk_type":    "1",
+						"disk_size":    "500",
+						"deploy_type":  "5",
+						"io_max":       "20",
+						"eip_max":      "0",
+						"tags.%":       REMOVEKEY,
+						"tags.Created": REMOVEKEY,
+						"tags.For":     REMOVEKEY,
 					}),
 				),
 			},
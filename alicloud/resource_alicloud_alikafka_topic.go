@@ -20,6 +20,10 @@ func resourceAlicloudAlikafkaTopic() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -167,5 +171,5 @@ func resourceAlicloudAlikafkaTopicDelete(d *schema.ResourceData, meta interface{
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(alikafkaService.WaitForAlikafkaTopic(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(alikafkaService.WaitForAlikafkaTopic(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_alikafka_topic", &resource.Sweeper{
+	addTestSweepers("alicloud_alikafka_topic", &resource.Sweeper{
 		Name: "alicloud_alikafka_topic",
 		F:    testSweepAlikafkaTopic,
 	})
@@ -93,6 +93,7 @@ func testSweepAlikafkaTopic(region string) error {
 					break
 				}
 			}
+			skip = sweepSkip("alicloud_alikafka_topic", skip, v)
 			if skip {
 				log.Printf("[INFO] Skipping alikafka topic: %s ", name)
 				continue
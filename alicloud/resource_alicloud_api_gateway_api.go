@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 
@@ -21,6 +22,10 @@ func resourceAliyunApigatewayApi() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:     schema.TypeString,
@@ -599,7 +604,7 @@ func resourceAliyunApigatewayApiDelete(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(cloudApiService.WaitForApiGatewayApi(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(cloudApiService.WaitForApiGatewayApi(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func buildAliyunApiArgs(d *schema.ResourceData, meta interface{}) (*cloudapi.CreateApiRequest, error) {
@@ -15,14 +15,14 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_api_gateway_api", &resource.Sweeper{
+	addTestSweepers("alicloud_api_gateway_api", &resource.Sweeper{
 		Name: "alicloud_api_gateway_api",
 		F:    testSweepApiGatewayApi,
 	})
 }
 
 func testSweepApiGatewayApi(region string) error {
-	if testSweepPreCheckWithRegions(region, false, connectivity.ApiGatewayNoSupportedRegions) {
+	if testSweepPreCheckWithProduct(region, connectivity.CLOUDAPICode) {
 		log.Printf("[INFO] Skipping API Gateway unsupported region: %s", region)
 		return nil
 	}
@@ -59,6 +59,7 @@ func testSweepApiGatewayApi(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_api_gateway_api", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping api: %s", name)
 			continue
@@ -23,6 +23,12 @@ func resourceAliyunApigatewayApp() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -33,7 +39,8 @@ func resourceAliyunApigatewayApp() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -76,7 +83,9 @@ func resourceAliyunApigatewayAppRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", cloudApiService.tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, cloudApiService.tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
 	if err := resource.Retry(3*time.Second, func() *resource.RetryError {
 		object, err := cloudApiService.DescribeApiGatewayApp(d.Id())
 		if err != nil {
@@ -144,5 +153,5 @@ func resourceAliyunApigatewayAppDelete(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(cloudApiService.WaitForApiGatewayApp(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(cloudApiService.WaitForApiGatewayApp(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
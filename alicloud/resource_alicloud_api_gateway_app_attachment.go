@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +14,14 @@ func resourceAliyunApigatewayAppAttachment() *schema.Resource {
 		Create: resourceAliyunApigatewayAppAttachmentCreate,
 		Read:   resourceAliyunApigatewayAppAttachmentRead,
 		Delete: resourceAliyunApigatewayAppAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -69,7 +78,7 @@ func resourceAliyunApigatewayAppAttachmentCreate(d *schema.ResourceData, meta in
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	id := fmt.Sprintf("%s%s%s%s%s%s%s", groupId, COLON_SEPARATED, apiId, COLON_SEPARATED, appId, COLON_SEPARATED, stageName)
 
-	err = cloudApiService.WaitForApiGatewayAppAttachment(id, Normal, DefaultTimeout)
+	err = cloudApiService.WaitForApiGatewayAppAttachment(id, Normal, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -84,6 +93,10 @@ func resourceAliyunApigatewayAppAttachmentRead(d *schema.ResourceData, meta inte
 
 	_, err := cloudApiService.DescribeApiGatewayAppAttachment(d.Id())
 	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
 		return WrapError(err)
 	}
 
@@ -124,5 +137,5 @@ func resourceAliyunApigatewayAppAttachmentDelete(d *schema.ResourceData, meta in
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(cloudApiService.WaitForApiGatewayAppAttachment(d.Id(), Deleted, DefaultLongTimeout))
+	return WrapError(cloudApiService.WaitForApiGatewayAppAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
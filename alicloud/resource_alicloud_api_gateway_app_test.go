@@ -17,14 +17,14 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_api_gateway_app", &resource.Sweeper{
+	addTestSweepers("alicloud_api_gateway_app", &resource.Sweeper{
 		Name: "alicloud_api_gateway_app",
 		F:    testSweepApiGatewayApp,
 	})
 }
 
 func testSweepApiGatewayApp(region string) error {
-	if testSweepPreCheckWithRegions(region, false, connectivity.ApiGatewayNoSupportedRegions) {
+	if testSweepPreCheckWithProduct(region, connectivity.CLOUDAPICode) {
 		log.Printf("[INFO] Skipping API Gateway unsupported region: %s", region)
 		return nil
 	}
@@ -59,6 +59,7 @@ func testSweepApiGatewayApp(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_api_gateway_app", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping app: %s", name)
 			continue
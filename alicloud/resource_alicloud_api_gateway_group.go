@@ -18,6 +18,11 @@ func resourceAliyunApigatewayGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: productAvailableCustomizeDiff(connectivity.CLOUDAPICode),
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -114,6 +119,6 @@ func resourceAliyunApigatewayGroupDelete(d *schema.ResourceData, meta interface{
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(cloudApiService.WaitForApiGatewayGroup(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(cloudApiService.WaitForApiGatewayGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 
 }
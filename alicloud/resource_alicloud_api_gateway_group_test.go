@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_api_gateway_group", &resource.Sweeper{
+	addTestSweepers("alicloud_api_gateway_group", &resource.Sweeper{
 		Name: "alicloud_api_gateway_group",
 		F:    testSweepApiGatewayGroup,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 }
 
 func testSweepApiGatewayGroup(region string) error {
-	if testSweepPreCheckWithRegions(region, false, connectivity.ApiGatewayNoSupportedRegions) {
+	if testSweepPreCheckWithProduct(region, connectivity.CLOUDAPICode) {
 		log.Printf("[INFO] Skipping API Gateway unsupported region: %s", region)
 		return nil
 	}
@@ -61,6 +61,7 @@ func testSweepApiGatewayGroup(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_api_gateway_group", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping api group: %s", name)
 			continue
@@ -16,14 +16,14 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_api_gateway_vpc_access", &resource.Sweeper{
+	addTestSweepers("alicloud_api_gateway_vpc_access", &resource.Sweeper{
 		Name: "alicloud_api_gateway_vpc_access",
 		F:    testSweepApiGatewayVpcAccess,
 	})
 }
 
 func testSweepApiGatewayVpcAccess(region string) error {
-	if testSweepPreCheckWithRegions(region, false, connectivity.ApiGatewayNoSupportedRegions) {
+	if testSweepPreCheckWithProduct(region, connectivity.CLOUDAPICode) {
 		log.Printf("[INFO] Skipping API Gateway unsupported region: %s", region)
 		return nil
 	}
@@ -59,6 +59,7 @@ func testSweepApiGatewayVpcAccess(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_api_gateway_vpc_access", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Api Gateway Vpc: %s", name)
 			continue
@@ -17,6 +17,9 @@ func resourceAlicloudCasCertificate() *schema.Resource {
 		Create: resourceAlicloudCasCreate,
 		Read:   resourceAlicloudCasRead,
 		Delete: resourceAlicloudCasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -85,6 +88,17 @@ func resourceAlicloudCasRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", cert.Name)
 
+	// The certificate and its key are only read when they are absent from the state, e.g. after the certificate
+	// is imported, because the returned PEM text can differ from the configured one in the whitespaces.
+	if _, ok := d.GetOk("cert"); !ok {
+		detail, err := casService.DescribeCasDetail(d.Id())
+		if err != nil {
+			return WrapError(err)
+		}
+		d.Set("cert", detail.Cert)
+		d.Set("key", detail.Key)
+	}
+
 	return nil
 }
 
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_cas_certificate", &resource.Sweeper{
+	addTestSweepers("alicloud_cas_certificate", &resource.Sweeper{
 		Name: "alicloud_cas_certificate",
 		F:    testSweepCasCertificate,
 	})
@@ -77,6 +77,7 @@ func testSweepCasCertificate(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_cas_certificate", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Certificate: %s (%d)", name, id)
 			continue
@@ -118,6 +119,12 @@ func TestAccAlicloudCasCertificate_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("alicloud_cas_certificate.cert", "name", fmt.Sprintf("tf_testAcc_%v", randInt)),
 				),
 			},
+			{
+				ResourceName:            "alicloud_cas_certificate.cert",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cert", "key"},
+			},
 		},
 	})
 }
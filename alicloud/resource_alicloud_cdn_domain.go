@@ -18,6 +18,14 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 		Read:   resourceAlicloudCdnDomainRead,
 		Update: resourceAlicloudCdnDomainUpdate,
 		Delete: resourceAlicloudCdnDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(360 * time.Second),
+			Update: schema.DefaultTimeout(360 * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -432,7 +440,7 @@ func resourceAlicloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if d.HasChange("certificate_config") {
 		if d.IsNewResource() {
-			err := WaitForDomainStatus(d.Id(), Online, 360, meta)
+			err := WaitForDomainStatus(d.Id(), Online, int(d.Timeout(schema.TimeoutCreate).Seconds()), meta)
 			if err != nil {
 				return fmt.Errorf("Timeout when Cdn Domain Online. Error: %#v", err)
 			}
@@ -472,8 +480,9 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 	resp, _ := raw.(cdn.DomainConfigResponse)
 	configs := resp.DomainConfigs
 
+	// The config blocks are also rebuilt when they are enabled on the domain but absent from the state, e.g. after it is imported.
 	queryStringConfig := configs.IgnoreQueryStringConfig
-	if _, ok := d.GetOk("parameter_filter_config"); ok {
+	if _, ok := d.GetOk("parameter_filter_config"); ok || queryStringConfig.Enable == "on" {
 		config := make([]map[string]interface{}, 1)
 		config[0] = map[string]interface{}{
 			"enable":        queryStringConfig.Enable,
@@ -482,7 +491,7 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("parameter_filter_config", config)
 	}
 
-	if _, ok := d.GetOk("certificate_config"); ok {
+	if _, ok := d.GetOk("certificate_config"); ok || domain.ServerCertificateStatus == "on" {
 		ov := d.Get("certificate_config")
 		oldConfig := ov.([]interface{})
 		config := make([]map[string]interface{}, 1)
@@ -503,7 +512,7 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	errorPageConfig := configs.ErrorPageConfig
-	if _, ok := d.GetOk("page_404_config"); ok {
+	if _, ok := d.GetOk("page_404_config"); ok || (errorPageConfig.PageType != "" && errorPageConfig.PageType != "default") {
 		config := make([]map[string]interface{}, 1)
 		config[0] = map[string]interface{}{
 			"page_type":       errorPageConfig.PageType,
@@ -517,7 +526,7 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	referConfig := configs.RefererConfig
-	if _, ok := d.GetOk("refer_config"); ok {
+	if _, ok := d.GetOk("refer_config"); ok || referConfig.ReferList != "" {
 		config := make([]map[string]interface{}, 1)
 		config[0] = map[string]interface{}{
 			"refer_type":  referConfig.ReferType,
@@ -528,7 +537,7 @@ func resourceAlicloudCdnDomainRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	authConfig := configs.ReqAuthConfig
-	if _, ok := d.GetOk("auth_config"); ok {
+	if _, ok := d.GetOk("auth_config"); ok || (authConfig.AuthType != "" && authConfig.AuthType != "no_auth") {
 		config := make([]map[string]interface{}, 1)
 		timeout, _ := strconv.Atoi(authConfig.TimeOut)
 		config[0] = map[string]interface{}{
@@ -840,7 +849,11 @@ func certificateConfigUpdate(client *connectivity.AliyunClient, d *schema.Resour
 	}
 	d.SetPartial("certificate_config")
 	if okServerCertificate && args.ServerCertificateStatus != "off" {
-		err := WaitForServerCertificate(client, d.Id(), args.ServerCertificate, 360)
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+		err := WaitForServerCertificate(client, d.Id(), args.ServerCertificate, int(timeout.Seconds()))
 		if err != nil {
 			return fmt.Errorf("Timeout waiting for Cdn server certificate. Error: %#v", err)
 		}
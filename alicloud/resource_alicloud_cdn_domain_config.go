@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -19,6 +20,11 @@ func resourceAlicloudCdnDomainConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:         schema.TypeString,
@@ -87,7 +93,7 @@ func resourceAlicloudCdnDomainConfigCreate(d *schema.ResourceData, meta interfac
 
 	d.SetId(fmt.Sprintf("%s:%s", request.DomainNames, d.Get("function_name").(string)))
 
-	err = cdnService.WaitForCdnDomain(d.Get("domain_name").(string), Online, DefaultTimeoutMedium)
+	err = cdnService.WaitForCdnDomain(d.Get("domain_name").(string), Online, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -150,7 +156,7 @@ func resourceAlicloudCdnDomainConfigDelete(d *schema.ResourceData, meta interfac
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(cdnService.WaitForCdnDomain(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(cdnService.WaitForCdnDomain(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func expirationCdnDomainConfigHash(v interface{}) int {
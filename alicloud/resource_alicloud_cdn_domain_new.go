@@ -21,6 +21,14 @@ func resourceAlicloudCdnDomainNew() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:         schema.TypeString,
@@ -127,7 +135,8 @@ func resourceAlicloudCdnDomainNew() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateCdnScope,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -169,7 +178,7 @@ func resourceAlicloudCdnDomainCreateNew(d *schema.ResourceData, meta interface{}
 
 	d.SetId(request.DomainName)
 
-	err = cdnService.WaitForCdnDomain(d.Id(), Online, DefaultLongTimeout)
+	err = cdnService.WaitForCdnDomain(d.Id(), Online, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -212,7 +221,7 @@ func resourceAlicloudCdnDomainUpdateNew(d *schema.ResourceData, meta interface{}
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-			err = cdnService.WaitForCdnDomain(d.Id(), Online, DefaultTimeoutMedium)
+			err = cdnService.WaitForCdnDomain(d.Id(), Online, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 			if err != nil {
 				return WrapError(err)
 			}
@@ -301,7 +310,9 @@ func resourceAlicloudCdnDomainReadNew(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", cdnTagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, cdnTagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
@@ -333,7 +344,7 @@ func resourceAlicloudCdnDomainDeleteNew(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(cdnService.WaitForCdnDomain(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(cdnService.WaitForCdnDomain(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func certificateConfigUpdateNew(client *connectivity.AliyunClient, d *schema.ResourceData) error {
@@ -402,7 +413,7 @@ func certificateConfigUpdateNew(client *connectivity.AliyunClient, d *schema.Res
 	}
 	d.SetPartial("certificate_config")
 	if okServerCertificate && request.ServerCertificateStatus != "off" {
-		err := cdnService.WaitForServerCertificateNew(d.Id(), request.ServerCertificate, DefaultTimeout)
+		err := cdnService.WaitForServerCertificateNew(d.Id(), request.ServerCertificate, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 		if err != nil {
 			return WrapError(err)
 		}
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_cdn_domain_new", &resource.Sweeper{
+	addTestSweepers("alicloud_cdn_domain_new", &resource.Sweeper{
 		Name: "alicloud_cdn_domain_new",
 		F:    testSweepCdnDomains_new,
 	})
@@ -68,6 +68,7 @@ func testSweepCdnDomains_new(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_cdn_domain_new", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping CDN domain: %s", name)
 			continue
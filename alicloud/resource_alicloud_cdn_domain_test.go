@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_cdn_domain", &resource.Sweeper{
+	addTestSweepers("alicloud_cdn_domain", &resource.Sweeper{
 		Name: "alicloud_cdn_domain",
 		F:    testSweepCdnDomains,
 	})
@@ -65,6 +65,7 @@ func testSweepCdnDomains(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_cdn_domain", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping CDN domain: %s", name)
 			continue
@@ -108,6 +109,12 @@ func TestAccAlicloudCdnDomain_basic(t *testing.T) {
 						fmt.Sprintf("tf-testacc%d.xiaozhu.com", rand)),
 				),
 			},
+			{
+				ResourceName:            "alicloud_cdn_domain.domain",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_port"},
+			},
 		},
 	})
 }
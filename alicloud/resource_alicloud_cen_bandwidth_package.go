@@ -24,6 +24,12 @@ func resourceAlicloudCenBandwidthPackage() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bandwidth": {
 				Type:     schema.TypeInt,
@@ -153,7 +159,7 @@ func resourceAlicloudCenBandwidthPackageCreate(d *schema.ResourceData, meta inte
 	}
 
 	d.SetId(response.CenBandwidthPackageId)
-	err = cenService.WaitForCenBandwidthPackage(d.Id(), Idle, bandwidth, DefaultCenTimeout)
+	err = cenService.WaitForCenBandwidthPackage(d.Id(), Idle, bandwidth, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -238,7 +244,7 @@ func resourceAlicloudCenBandwidthPackageUpdate(d *schema.ResourceData, meta inte
 		}
 		addDebug(modifyCenBandwidthPackageSpecRequest.GetActionName(), raw)
 		// modify function may delay for a while
-		if err := cenService.WaitForCenBandwidthPackage(d.Id(), Idle, bandwidth, DefaultCenTimeout); err != nil {
+		if err := cenService.WaitForCenBandwidthPackage(d.Id(), Idle, bandwidth, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return WrapError(err)
 		}
 		d.SetPartial("bandwidth")
@@ -277,7 +283,7 @@ func resourceAlicloudCenBandwidthPackageDelete(d *schema.ResourceData, meta inte
 	}
 
 	// set bandwidth "-1" here to use WaitForCenBandwidthPackage, actually determined by status.
-	return WrapError(cenService.WaitForCenBandwidthPackage(d.Id(), Deleted, -1, DefaultCenTimeout))
+	return WrapError(cenService.WaitForCenBandwidthPackage(d.Id(), Deleted, -1, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func convertGeographicRegionId(regionId string) (retStr string) {
@@ -18,6 +18,11 @@ func resourceAlicloudCenBandwidthPackageAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -62,7 +67,7 @@ func resourceAlicloudCenBandwidthPackageAttachmentCreate(d *schema.ResourceData,
 	}
 
 	d.SetId(cenBwpId)
-	if err := cenService.WaitForCenBandwidthPackageAttachment(d.Id(), InUse, DefaultCenTimeout); err != nil {
+	if err := cenService.WaitForCenBandwidthPackageAttachment(d.Id(), InUse, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -117,5 +122,5 @@ func resourceAlicloudCenBandwidthPackageAttachmentDelete(d *schema.ResourceData,
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(cenService.WaitForCenBandwidthPackageAttachment(cenBwpId, Deleted, DefaultCenTimeout))
+	return WrapError(cenService.WaitForCenBandwidthPackageAttachment(cenBwpId, Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_cen_bandwidth_package", &resource.Sweeper{
+	addTestSweepers("alicloud_cen_bandwidth_package", &resource.Sweeper{
 		Name: "alicloud_cen_bandwidth_package",
 		F:    testSweepCenBandwidthPackage,
 	})
@@ -87,6 +87,7 @@ func testSweepCenBandwidthPackage(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_cen_bandwidth_package", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping CEN bandwidth package: %s (%s)", name, id)
 			continue
@@ -21,6 +21,11 @@ func resourceAlicloudCenInstanceAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCenTimeoutLong * time.Second),
+			Delete: schema.DefaultTimeout(DefaultCenTimeoutLong * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -87,7 +92,7 @@ func resourceAlicloudCenInstanceAttachmentCreate(d *schema.ResourceData, meta in
 
 	d.SetId(cenId + COLON_SEPARATED + instanceId)
 
-	if err := cenService.WaitForCenInstanceAttachment(d.Id(), Status("Attached"), DefaultCenTimeoutLong); err != nil {
+	if err := cenService.WaitForCenInstanceAttachment(d.Id(), Status("Attached"), int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAlicloudCenInstanceAttachmentRead(d, meta)
@@ -157,5 +162,5 @@ func resourceAlicloudCenInstanceAttachmentDelete(d *schema.ResourceData, meta in
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(cenService.WaitForCenInstanceAttachment(d.Id(), Deleted, DefaultCenTimeoutLong))
+	return WrapError(cenService.WaitForCenInstanceAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
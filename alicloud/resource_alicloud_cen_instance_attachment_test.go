@@ -2,17 +2,123 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/schema"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
+func init() {
+	addTestSweepers("alicloud_cen_instance_attachment", &resource.Sweeper{
+		Name: "alicloud_cen_instance_attachment",
+		F:    testSweepCenInstanceAttachments,
+	})
+}
+
+// testSweepCenInstanceAttachments detaches the child instances in the region from the testing CEN instances,
+// which may be created by the tests running in other regions.
+func testSweepCenInstanceAttachments(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	var insts []cbn.Cen
+	describeCensRequest := cbn.CreateDescribeCensRequest()
+	describeCensRequest.RegionId = client.RegionId
+	describeCensRequest.PageSize = requests.NewInteger(PageSizeLarge)
+	describeCensRequest.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithCenClient(func(cenClient *cbn.Client) (interface{}, error) {
+			return cenClient.DescribeCens(describeCensRequest)
+		})
+		if err != nil {
+			return fmt.Errorf("Error retrieving CEN Instances: %s", err)
+		}
+		describeCensResponse, _ := raw.(*cbn.DescribeCensResponse)
+		if len(describeCensResponse.Cens.Cen) < 1 {
+			break
+		}
+		insts = append(insts, describeCensResponse.Cens.Cen...)
+
+		if len(describeCensResponse.Cens.Cen) < PageSizeLarge {
+			break
+		}
+
+		page, err := getNextpageNumber(describeCensRequest.PageNumber)
+		if err != nil {
+			return err
+		}
+		describeCensRequest.PageNumber = page
+	}
+
+	cenService := CenService{client}
+	for _, v := range insts {
+		name := v.Name
+		id := v.CenId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		if skip && !sweepTagsMatched("alicloud_cen_instance_attachment", v) {
+			log.Printf("[INFO] Skipping CEN Instance: %s (%s)", name, id)
+			continue
+		}
+		describeCenAttachedChildInstancesRequest := cbn.CreateDescribeCenAttachedChildInstancesRequest()
+		describeCenAttachedChildInstancesRequest.CenId = id
+		describeCenAttachedChildInstancesRequest.ChildInstanceRegionId = region
+		raw, err := client.WithCenClient(func(cenClient *cbn.Client) (interface{}, error) {
+			return cenClient.DescribeCenAttachedChildInstances(describeCenAttachedChildInstancesRequest)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to Describe CEN Attached Instance (%s (%s)): %s", name, id, err)
+			continue
+		}
+		describeCenAttachedChildInstancesResponse, _ := raw.(*cbn.DescribeCenAttachedChildInstancesResponse)
+		for _, childInstance := range describeCenAttachedChildInstancesResponse.ChildInstances.ChildInstance {
+			instanceId := childInstance.ChildInstanceId
+			if childInstance.ChildInstanceRegionId != region || sweepSkip("alicloud_cen_instance_attachment", false, childInstance) {
+				continue
+			}
+			log.Printf("[INFO] Detaching CEN Child Instance: %s (%s %s)", name, id, instanceId)
+			detachCenChildInstanceRequest := cbn.CreateDetachCenChildInstanceRequest()
+			detachCenChildInstanceRequest.CenId = id
+			detachCenChildInstanceRequest.ChildInstanceId = instanceId
+			detachCenChildInstanceRequest.ChildInstanceType = childInstance.ChildInstanceType
+			detachCenChildInstanceRequest.ChildInstanceRegionId = childInstance.ChildInstanceRegionId
+			_, err := client.WithCenClient(func(cenClient *cbn.Client) (interface{}, error) {
+				return cenClient.DetachCenChildInstance(detachCenChildInstanceRequest)
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to Detach CEN Attached Instance (%s (%s %s)): %s", name, id, instanceId, err)
+				continue
+			}
+			err = cenService.WaitForCenInstanceAttachment(id+COLON_SEPARATED+instanceId, Deleted, DefaultCenTimeoutLong)
+			if err != nil {
+				log.Printf("[ERROR] Failed to WaitFor CEN Attached Instance Detached (%s (%s %s)): %s", name, id, instanceId, err)
+			}
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudCenInstanceAttachment_basic(t *testing.T) {
 	var v *cbn.ChildInstance
 	resourceId := "alicloud_cen_instance_attachment.default"
@@ -20,6 +20,10 @@ func resourceAlicloudCenInstanceGrant() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cen_id": {
 				Type:     schema.TypeString,
@@ -153,5 +157,5 @@ func resourceAlicloudCenInstanceGrantDelete(d *schema.ResourceData, meta interfa
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(vpcService.WaitForCenInstanceGrant(d.Id(), Deleted, DefaultCenTimeout))
+	return WrapError(vpcService.WaitForCenInstanceGrant(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -17,11 +17,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_cen_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_cen_instance", &resource.Sweeper{
 		Name: "alicloud_cen_instance",
 		F:    testSweepCenInstances,
 		Dependencies: []string{
 			"alicloud_cen_bandwidth_package",
+			"alicloud_cen_instance_attachment",
 		},
 	})
 }
@@ -78,6 +79,7 @@ func testSweepCenInstances(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_cen_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping CEN Instance: %s (%s)", name, id)
 			continue
@@ -18,6 +18,11 @@ func resourceAlicloudCenRouteEntry() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultCenTimeoutLong * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -77,7 +82,7 @@ func resourceAlicloudCenRouteEntryCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(cenId + COLON_SEPARATED + vtbId + COLON_SEPARATED + cidr)
 
-	err = cenService.WaitForCenRouterEntry(d.Id(), PUBLISHED, DefaultCenTimeout)
+	err = cenService.WaitForCenRouterEntry(d.Id(), PUBLISHED, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -161,5 +166,5 @@ func resourceAlicloudCenRouteEntryDelete(d *schema.ResourceData, meta interface{
 		return WrapErrorf(err, DataDefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 
 	}
-	return WrapError(cenService.WaitForCenRouterEntry(d.Id(), Deleted, DefaultCenTimeoutLong))
+	return WrapError(cenService.WaitForCenRouterEntry(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -2,14 +2,98 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/smartag"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
+func init() {
+	addTestSweepers("alicloud_cloud_connect_network", &resource.Sweeper{
+		Name: "alicloud_cloud_connect_network",
+		F:    testSweepCloudConnectNetworks,
+	})
+}
+
+func testSweepCloudConnectNetworks(region string) error {
+	if testSweepPreCheckWithRegions(region, true, connectivity.SmartagSupportedRegions) {
+		log.Printf("[INFO] Skipping Smartag unsupported region: %s", region)
+		return nil
+	}
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	var ccns []smartag.CloudConnectNetwork
+	request := smartag.CreateDescribeCloudConnectNetworksRequest()
+	request.RegionId = client.RegionId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
+			return sagClient.DescribeCloudConnectNetworks(request)
+		})
+		if err != nil {
+			return fmt.Errorf("Error retrieving Cloud Connect Networks: %s", err)
+		}
+		response, _ := raw.(*smartag.DescribeCloudConnectNetworksResponse)
+		if len(response.CloudConnectNetworks.CloudConnectNetwork) < 1 {
+			break
+		}
+		ccns = append(ccns, response.CloudConnectNetworks.CloudConnectNetwork...)
+
+		if len(response.CloudConnectNetworks.CloudConnectNetwork) < PageSizeLarge {
+			break
+		}
+
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return err
+		}
+		request.PageNumber = page
+	}
+
+	for _, v := range ccns {
+		name := v.Name
+		id := v.CcnId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		skip = sweepSkip("alicloud_cloud_connect_network", skip, v)
+		if skip {
+			log.Printf("[INFO] Skipping Cloud Connect Network: %s (%s)", name, id)
+			continue
+		}
+		log.Printf("[INFO] Deleting Cloud Connect Network: %s (%s)", name, id)
+		deleteRequest := smartag.CreateDeleteCloudConnectNetworkRequest()
+		deleteRequest.RegionId = client.RegionId
+		deleteRequest.CcnId = id
+		_, err := client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
+			return sagClient.DeleteCloudConnectNetwork(deleteRequest)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete Cloud Connect Network (%s (%s)): %s", name, id, err)
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudCloudConnectNetwork_basic(t *testing.T) {
 	var ccn smartag.CloudConnectNetwork
 	resourceId := "alicloud_cloud_connect_network.default"
@@ -21,7 +21,7 @@ import (
 var cmsContactGroup = os.Getenv("ALICLOUD_CMS_CONTACT_GROUP")
 
 func init() {
-	resource.AddTestSweepers("alicloud_cms_alarm", &resource.Sweeper{
+	addTestSweepers("alicloud_cms_alarm", &resource.Sweeper{
 		Name: "alicloud_cms_alarm",
 		F:    testSweepCMSAlarms,
 	})
@@ -80,6 +80,7 @@ func testSweepCMSAlarms(region string) error {
 		if skip && v.AlertState == "INSUFFICIENT_DATA" {
 			skip = false
 		}
+		skip = sweepSkip("alicloud_cms_alarm", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping CMS Alarm: %s (%s)", name, id)
 			continue
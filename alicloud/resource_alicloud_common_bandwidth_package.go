@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 
@@ -22,6 +23,11 @@ func resourceAliyunCommonBandwidthPackage() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -95,7 +101,7 @@ func resourceAliyunCommonBandwidthPackageCreate(d *schema.ResourceData, meta int
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*vpc.CreateCommonBandwidthPackageResponse)
 	d.SetId(response.BandwidthPackageId)
-	if err = vpcService.WaitForCommonBandwidthPackage(response.BandwidthPackageId, Available, DefaultTimeout); err != nil {
+	if err = vpcService.WaitForCommonBandwidthPackage(response.BandwidthPackageId, Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -191,5 +197,5 @@ func resourceAliyunCommonBandwidthPackageDelete(d *schema.ResourceData, meta int
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(vpcService.WaitForCommonBandwidthPackage(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForCommonBandwidthPackage(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
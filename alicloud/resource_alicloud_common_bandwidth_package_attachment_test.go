@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_common_bandwidth_package_attachment", &resource.Sweeper{
+	addTestSweepers("alicloud_common_bandwidth_package_attachment", &resource.Sweeper{
 		Name: "alicloud_common_bandwidth_package_attachment",
 		F:    testSweepCommonBandwidthPackageAttachment,
 	})
@@ -75,6 +75,7 @@ func testSweepCommonBandwidthPackageAttachment(region string) error {
 					break
 				}
 			}
+			skip = sweepSkip("alicloud_common_bandwidth_package_attachment", skip, eip)
 			if skip {
 				log.Printf("[INFO] Skipping Common Bandwidth Package: %s (%s)", name, id)
 				continue
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_common_bandwidth_package", &resource.Sweeper{
+	addTestSweepers("alicloud_common_bandwidth_package", &resource.Sweeper{
 		Name: "alicloud_common_bandwidth_package",
 		F:    testSweepCommonBandwidthPackage,
 		// When implemented, these should be removed firstly
@@ -79,6 +79,7 @@ func testSweepCommonBandwidthPackage(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_common_bandwidth_package", skip, cbwp)
 		if skip {
 			log.Printf("[INFO] Skipping Common Bandwidth Package: %s (%s)", name, id)
 			continue
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +19,10 @@ func resourceAlicloudCRNamespace() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -137,5 +142,5 @@ func resourceAlicloudCRNamespaceDelete(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
-	return WrapError(crService.WaitForCRNamespace(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(crService.WaitForCRNamespace(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
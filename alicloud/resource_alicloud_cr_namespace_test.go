@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_cr_namespace", &resource.Sweeper{
+	addTestSweepers("alicloud_cr_namespace", &resource.Sweeper{
 		Name: "alicloud_cr_namespace",
 		F:    testSweepCRNamespace,
 	})
@@ -62,11 +62,15 @@ func testSweepCRNamespace(region string) error {
 
 	var ns []string
 	for _, n := range resp.Data.Namespace {
+		skip := true
 		for _, p := range prefixes {
 			if strings.HasPrefix(n.Namespace, strings.ToLower(p)) {
-				ns = append(ns, n.Namespace)
+				skip = false
 			}
 		}
+		if !sweepSkip("alicloud_cr_namespace", skip, n) {
+			ns = append(ns, n.Namespace)
+		}
 	}
 
 	for _, n := range ns {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,10 @@ func resourceAlicloudCRRepo() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:         schema.TypeString,
@@ -194,5 +199,5 @@ func resourceAlicloudCRRepoDelete(d *schema.ResourceData, meta interface{}) erro
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
-	return WrapError(crService.WaitForCrRepo(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(crService.WaitForCrRepo(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -23,6 +23,11 @@ func resourceAlicloudCSApplication() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
 				Type:     schema.TypeString,
@@ -146,7 +151,7 @@ func resourceAlicloudCSApplicationCreate(d *schema.ResourceData, meta interface{
 		}
 		args.Environment = env
 	}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	if err := invoker.Run(func() error {
 		cluster, certs, err := csService.GetContainerClusterAndCertsByName(clusterName)
 		if err == nil {
@@ -161,7 +166,7 @@ func resourceAlicloudCSApplicationCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(fmt.Sprintf("%s%s%s", clusterName, COLON_SEPARATED, args.Name))
 
-	if err := csService.WaitForContainerApplication(clusterName, args.Name, Running, DefaultTimeoutMedium); err != nil {
+	if err := csService.WaitForContainerApplication(clusterName, args.Name, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return fmt.Errorf("Waitting for container application %#v got an error: %#v", cs.Running, err)
 	}
 
@@ -235,7 +240,7 @@ func resourceAlicloudCSApplicationUpdate(d *schema.ResourceData, meta interface{
 	csService := CsService{client}
 	parts := strings.Split(d.Id(), COLON_SEPARATED)
 	clusterName := parts[0]
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	args := &cs.ProjectUpdationArgs{
 		Name:        parts[1],
 		Description: d.Get("description").(string),
@@ -322,7 +327,7 @@ func resourceAlicloudCSApplicationUpdate(d *schema.ResourceData, meta interface{
 					if err != nil {
 						return fmt.Errorf("Rollbacking container application blue-green got an error: %#v", err)
 					}
-					err = csService.WaitForContainerApplication(parts[0], parts[1], Running, DefaultTimeoutMedium)
+					err = csService.WaitForContainerApplication(parts[0], parts[1], Running, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 					if err != nil {
 						return fmt.Errorf("After rolling back blue-green project, waitting for container application %#v got an error: %#v", Running, err)
 					}
@@ -349,7 +354,7 @@ func resourceAlicloudCSApplicationUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if err := csService.WaitForContainerApplication(parts[0], parts[1], Running, DefaultTimeoutMedium); err != nil {
+	if err := csService.WaitForContainerApplication(parts[0], parts[1], Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 		return fmt.Errorf("After updating, waitting for container application %#v got an error: %#v", Running, err)
 	}
 
@@ -365,7 +370,7 @@ func resourceAlicloudCSApplicationDelete(d *schema.ResourceData, meta interface{
 	clusterName := parts[0]
 
 	appName := parts[1]
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 
 	return resource.Retry(3*time.Minute, func() *resource.RetryError {
 		err := invoker.Run(func() error {
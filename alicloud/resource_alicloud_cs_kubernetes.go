@@ -61,6 +61,11 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 				ValidateFunc:  validateContainerNamePrefix,
 				ConflictsWith: []string{"name"},
 			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"force_update": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -500,7 +505,7 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 
 	if isMultiAZ, err := isMultiAZClusterAndCheck(d); err != nil {
 		return WrapError(err)
@@ -568,7 +573,7 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
 	d.Partial(true)
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	if d.HasChange("worker_numbers") && !d.IsNewResource() {
 
 		workerNumbers := expandIntList(d.Get("worker_numbers").([]interface{}))
@@ -658,6 +663,24 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 		d.SetPartial("name")
 		d.SetPartial("name_prefix")
 	}
+
+	// The masters are the ECS instances of the cluster, their renewal is set one by one
+	if !d.IsNewResource() && d.Get("master_instance_charge_type").(string) == string(PrePaid) &&
+		(d.HasChange("master_auto_renew") || d.HasChange("master_auto_renew_period")) {
+		bssOpenApiService := BssOpenApiService{client}
+		for _, node := range d.Get("master_nodes").([]interface{}) {
+			instanceId := node.(map[string]interface{})["id"].(string)
+			if err := bssOpenApiService.SetAutoRenew(BssProductCodeEcs, instanceId, d.Get("master_auto_renew").(bool), d.Get("master_auto_renew_period").(int)); err != nil {
+				return WrapError(err)
+			}
+		}
+		d.SetPartial("master_auto_renew")
+		d.SetPartial("master_auto_renew_period")
+	}
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupCsCluster); err != nil {
+		return WrapError(err)
+	}
 	d.Partial(false)
 
 	return resourceAlicloudCSKubernetesRead(d, meta)
@@ -666,7 +689,7 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	object, err := csService.DescribeCsKubernetes(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -857,6 +880,13 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 	}
 
 	var config cs.ClusterConfig
+	resourceManagerService := ResourceManagerService{client}
+	resourceGroupId, err := resourceManagerService.DescribeResourceGroupId(d.Id(), ResourceGroupCsCluster)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("resource_group_id", resourceGroupId)
+
 	if file, ok := d.GetOk("kube_config"); ok && file.(string) != "" {
 		if err := invoker.Run(func() error {
 			raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -886,7 +916,7 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 func resourceAlicloudCSKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 
 	var requestInfo *cs.Client
 	var response interface{}
@@ -49,6 +49,11 @@ func resourceAlicloudCSManagedKubernetes() *schema.Resource {
 				ValidateFunc:  validateContainerNamePrefix,
 				ConflictsWith: []string{"name"},
 			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -303,7 +308,7 @@ func resourceAlicloudCSManagedKubernetes() *schema.Resource {
 
 func resourceAlicloudCSManagedKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	csService := CsService{client}
 	args, err := buildManagedKubernetesArgs(d, meta)
 	if err != nil {
@@ -336,6 +341,10 @@ func resourceAlicloudCSManagedKubernetesCreate(d *schema.ResourceData, meta inte
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupCsCluster); err != nil {
+		return WrapError(err)
+	}
 	return resourceAlicloudCSManagedKubernetesRead(d, meta)
 }
 
@@ -343,7 +352,7 @@ func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta inte
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
 	d.Partial(true)
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	if d.HasChange("worker_number") || d.HasChange("worker_numbers") {
 		var scaleSize int
 		if d.HasChange("worker_number") {
@@ -455,6 +464,10 @@ func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta inte
 		d.SetPartial("name")
 		d.SetPartial("name_prefix")
 	}
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupCsCluster); err != nil {
+		return WrapError(err)
+	}
 	d.Partial(false)
 
 	return resourceAlicloudCSManagedKubernetesRead(d, meta)
@@ -463,7 +476,7 @@ func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta inte
 func resourceAlicloudCSManagedKubernetesRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	object, err := csService.DescribeCsManagedKubernetes(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -598,6 +611,13 @@ func resourceAlicloudCSManagedKubernetesRead(d *schema.ResourceData, meta interf
 	}
 
 	var config cs.ClusterConfig
+	resourceManagerService := ResourceManagerService{client}
+	resourceGroupId, err := resourceManagerService.DescribeResourceGroupId(d.Id(), ResourceGroupCsCluster)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("resource_group_id", resourceGroupId)
+
 	if file, ok := d.GetOk("kube_config"); ok && file.(string) != "" {
 		var requestInfo *cs.Client
 
@@ -629,7 +649,7 @@ func resourceAlicloudCSManagedKubernetesDelete(d *schema.ResourceData, meta inte
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	var response interface{}
 
 	err := resource.Retry(30*time.Minute, func() *resource.RetryError {
@@ -18,6 +18,7 @@ func resourceAlicloudCSServerlessKubernetes() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffAll(tagsAllCustomizeDiff, csServerlessKubernetesTagsAllForceNew),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -99,6 +100,7 @@ func resourceAlicloudCSServerlessKubernetes() *schema.Resource {
 				ForceNew: true,
 				//ValidateFunc: validateCSClusterTags,
 			},
+			"tags_all": tagsAllSchema(),
 			"force_update": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -111,7 +113,7 @@ func resourceAlicloudCSServerlessKubernetes() *schema.Resource {
 
 func resourceAlicloudCSServerlessKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 
 	csService := CsService{client}
 
@@ -123,7 +125,7 @@ func resourceAlicloudCSServerlessKubernetesCreate(d *schema.ResourceData, meta i
 	}
 
 	tags := make([]cs.Tag, 0)
-	tagsMap, ok := d.Get("tags").(map[string]interface{})
+	tagsMap, ok := d.Get("tags_all").(map[string]interface{})
 	if ok {
 		for key, value := range tagsMap {
 			if value != nil {
@@ -187,7 +189,7 @@ func resourceAlicloudCSServerlessKubernetesCreate(d *schema.ResourceData, meta i
 func resourceAlicloudCSServerlessKubernetesRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	object, err := csService.DescribeCsServerlessKubernetes(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -203,7 +205,13 @@ func resourceAlicloudCSServerlessKubernetesRead(d *schema.ResourceData, meta int
 	_ = d.Set("security_group_id", object.SecurityGroupId)
 	_ = d.Set("private_zone", object.PrivateZone)
 	_ = d.Set("deletion_protection", object.DeletionProtection)
-	_ = d.Set("tags", object.Tags)
+	tags := make(map[string]string)
+	for _, tag := range object.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	if err := setTagsAndTagsAll(client, d, tags); err != nil {
+		return WrapError(err)
+	}
 
 	var requestInfo *cs.Client
 	var response interface{}
@@ -272,7 +280,7 @@ func resourceAlicloudCSServerlessKubernetesDelete(d *schema.ResourceData, meta i
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.CONTAINCode)
 	var response interface{}
 
 	if err := invoker.Run(func() error {
@@ -297,3 +305,12 @@ func resourceAlicloudCSServerlessKubernetesDelete(d *schema.ResourceData, meta i
 	}
 	return nil
 }
+
+// csServerlessKubernetesTagsAllForceNew recreates the cluster when the provider default_tags change its tags, because
+// the tags of a serverless cluster can not be modified.
+func csServerlessKubernetesTagsAllForceNew(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("tags_all") {
+		return d.ForceNew("tags_all")
+	}
+	return nil
+}
@@ -23,6 +23,11 @@ func resourceAlicloudCSSwarm() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -232,7 +237,7 @@ func resourceAlicloudCSSwarmCreate(d *schema.ResourceData, meta interface{}) err
 		if args.Size == 0 {
 			state = cs.InActive
 		}
-		return nil, csClient.WaitForClusterAsyn(cluster.ClusterID, state, 500)
+		return nil, csClient.WaitForClusterAsyn(cluster.ClusterID, state, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	})
 
 	if err != nil {
@@ -273,7 +278,7 @@ func resourceAlicloudCSSwarmUpdate(d *schema.ResourceData, meta interface{}) err
 			if ni == 0 {
 				state = cs.InActive
 			}
-			return nil, csClient.WaitForClusterAsyn(d.Id(), state, 500)
+			return nil, csClient.WaitForClusterAsyn(d.Id(), state, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 		})
 
 		if err != nil {
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_cs_cluster", &resource.Sweeper{
+	addTestSweepers("alicloud_cs_cluster", &resource.Sweeper{
 		Name: "alicloud_cs_cluster",
 		F:    testSweepCSSwarms,
 	})
@@ -59,6 +59,7 @@ func testSweepCSSwarms(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_cs_cluster", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping CS Clusters: %s (%s)", name, id)
 			continue
@@ -21,6 +21,10 @@ func resourceAlicloudDatahubProject() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -159,5 +163,5 @@ func resourceAliyunDatahubProjectDelete(d *schema.ResourceData, meta interface{}
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteProject", AliyunDatahubSdkGo)
 	}
-	return WrapError(datahubService.WaitForDatahubProject(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(datahubService.WaitForDatahubProject(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -16,7 +16,7 @@ var datahubProjectSuffixMin = 100000
 var datahubProjectSuffixMax = 999999
 
 func init() {
-	resource.AddTestSweepers("alicloud_datahub_project", &resource.Sweeper{
+	addTestSweepers("alicloud_datahub_project", &resource.Sweeper{
 		Name: "alicloud_datahub_project",
 		F:    testSweepDatahubProject,
 	})
@@ -45,7 +45,7 @@ func testSweepDatahubProject(region string) error {
 
 	for _, projectName := range projects.Names {
 		// a testing project?
-		if !isTerraformTestingDatahubObject(projectName) {
+		if sweepSkip("alicloud_datahub_project", !isTerraformTestingDatahubObject(projectName), projectName) {
 			log.Printf("[INFO] Skipping Datahub project: %s", projectName)
 			continue
 		}
@@ -22,6 +22,10 @@ func resourceAlicloudDatahubSubscription() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project_name": {
 				Type:         schema.TypeString,
@@ -193,5 +197,5 @@ func resourceAliyunDatahubSubscriptionDelete(d *schema.ResourceData, meta interf
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteSubscription", AliyunDatahubSdkGo)
 	}
-	return WrapError(datahubService.WaitForDatahubSubscription(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(datahubService.WaitForDatahubSubscription(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
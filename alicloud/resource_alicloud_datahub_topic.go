@@ -24,6 +24,10 @@ func resourceAlicloudDatahubTopic() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project_name": {
 				Type:         schema.TypeString,
@@ -235,5 +239,5 @@ func resourceAliyunDatahubTopicDelete(d *schema.ResourceData, meta interface{})
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteTopic", AliyunDatahubSdkGo)
 	}
-	return WrapError(datahubService.WaitForDatahubTopic(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(datahubService.WaitForDatahubTopic(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
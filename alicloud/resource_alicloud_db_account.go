@@ -21,6 +21,12 @@ func resourceAlicloudDBAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -100,7 +106,7 @@ func resourceAlicloudDBAccountCreate(d *schema.ResourceData, meta interface{}) e
 		request.AccountDescription = v.(string)
 	}
 	// wait instance running before modifying
-	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
+	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -123,7 +129,7 @@ func resourceAlicloudDBAccountCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.DBInstanceId, COLON_SEPARATED, request.AccountName))
 
-	if err := rdsService.WaitForAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+	if err := rdsService.WaitForAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -159,7 +165,7 @@ func resourceAlicloudDBAccountUpdate(d *schema.ResourceData, meta interface{}) e
 	accountName := parts[1]
 
 	if d.HasChange("description") {
-		if err := rdsService.WaitForAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+		if err := rdsService.WaitForAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return WrapError(err)
 		}
 		request := rds.CreateModifyAccountDescriptionRequest()
@@ -179,7 +185,7 @@ func resourceAlicloudDBAccountUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if d.HasChange("password") || d.HasChange("kms_encrypted_password") {
-		if err := rdsService.WaitForAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+		if err := rdsService.WaitForAccount(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return WrapError(err)
 		}
 		request := rds.CreateResetAccountPasswordRequest()
@@ -242,5 +248,5 @@ func resourceAlicloudDBAccountDelete(d *schema.ResourceData, meta interface{}) e
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return rdsService.WaitForAccount(d.Id(), Deleted, DefaultTimeoutMedium)
+	return rdsService.WaitForAccount(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))
 }
@@ -20,6 +20,12 @@ func resourceAlicloudDBAccountPrivilege() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -59,7 +65,7 @@ func resourceAlicloudDBAccountPrivilegeCreate(d *schema.ResourceData, meta inter
 	privilege := d.Get("privilege").(string)
 	dbList := d.Get("db_names").(*schema.Set).List()
 	// wait instance running before granting
-	if err := rsdService.WaitForDBInstance(instanceId, Running, DefaultLongTimeout); err != nil {
+	if err := rsdService.WaitForDBInstance(instanceId, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", instanceId, COLON_SEPARATED, account, COLON_SEPARATED, privilege))
@@ -129,7 +135,7 @@ func resourceAlicloudDBAccountPrivilegeUpdate(d *schema.ResourceData, meta inter
 
 		if len(remove) > 0 {
 			// wait instance running before revoking
-			if err := rdsService.WaitForDBInstance(parts[0], Running, DefaultTimeoutMedium); err != nil {
+			if err := rdsService.WaitForDBInstance(parts[0], Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 				return WrapError(err)
 			}
 			for _, db := range remove {
@@ -141,7 +147,7 @@ func resourceAlicloudDBAccountPrivilegeUpdate(d *schema.ResourceData, meta inter
 
 		if len(add) > 0 {
 			// wait instance running before granting
-			if err := rdsService.WaitForDBInstance(parts[0], Running, DefaultTimeoutMedium); err != nil {
+			if err := rdsService.WaitForDBInstance(parts[0], Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 				return WrapError(err)
 			}
 			for _, db := range add {
@@ -184,5 +190,5 @@ func resourceAlicloudDBAccountPrivilegeDelete(d *schema.ResourceData, meta inter
 		}
 	}
 
-	return rdsService.WaitForAccountPrivilege(d.Id(), dbName, Deleted, DefaultTimeoutMedium)
+	return rdsService.WaitForAccountPrivilege(d.Id(), dbName, Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))
 }
@@ -23,6 +23,11 @@ func resourceAlicloudDBBackupPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -148,7 +153,7 @@ func resourceAlicloudDBBackupPolicyUpdate(d *schema.ResourceData, meta interface
 
 	if update {
 		// wait instance running before modifying
-		if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return WrapError(err)
 		}
 		if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -187,5 +192,5 @@ func resourceAlicloudDBBackupPolicyDelete(d *schema.ResourceData, meta interface
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium)
+	return rdsService.WaitForDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutDelete).Seconds()))
 }
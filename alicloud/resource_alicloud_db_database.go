@@ -20,6 +20,10 @@ func resourceAlicloudDBDatabase() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -141,7 +145,7 @@ func resourceAlicloudDBDatabaseDelete(d *schema.ResourceData, meta interface{})
 	request.DBInstanceId = parts[0]
 	request.DBName = parts[1]
 	// wait instance status is running before deleting database
-	if err := rdsService.WaitForDBInstance(parts[0], Running, 1800); err != nil {
+	if err := rdsService.WaitForDBInstance(parts[0], Running, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
@@ -158,5 +162,5 @@ func resourceAlicloudDBDatabaseDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(rdsService.WaitForDBDatabase(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(rdsService.WaitForDBDatabase(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
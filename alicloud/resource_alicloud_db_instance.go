@@ -33,6 +33,8 @@ func resourceAlicloudDBInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffAll(productAvailableCustomizeDiff(connectivity.RDSCode), caseInsensitiveTagsAllCustomizeDiff, tagApiTagsCustomizeDiff),
+
 		Schema: map[string]*schema.Schema{
 			"engine": {
 				Type:     schema.TypeString,
@@ -290,6 +292,14 @@ func resourceAlicloudDBInstance() *schema.Resource {
 				},
 			},
 
+			"tags_all": tagsAllSchema(),
+
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
 			"maintain_time": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -345,33 +355,27 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if err := rdsService.setInstanceTags(d); err != nil {
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, connectivity.RDSCode, TagResourceInstance); err != nil {
 		return WrapError(err)
 	}
 
-	if !d.IsNewResource() && (d.HasChange("instance_charge_type")) {
-		prePaidRequest := rds.CreateModifyDBInstancePayTypeRequest()
-		prePaidRequest.RegionId = client.RegionId
-		prePaidRequest.DBInstanceId = d.Id()
-		payType := PayType(d.Get("instance_charge_type").(string))
-		prePaidRequest.PayType = string(payType)
-		if payType == Prepaid {
-			period := d.Get("period").(int)
-			prePaidRequest.UsedTime = requests.Integer(strconv.Itoa(period))
-			prePaidRequest.Period = string(Month)
-			if period > 9 {
-				prePaidRequest.UsedTime = requests.Integer(strconv.Itoa(period / 12))
-				prePaidRequest.Period = string(Year)
-			}
+	if !d.IsNewResource() {
+		resourceManagerService := ResourceManagerService{client}
+		if err := resourceManagerService.SetResourceGroup(d, ResourceGroupRdsInstance); err != nil {
+			return WrapError(err)
 		}
+	}
 
-		raw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
-			return client.ModifyDBInstancePayType(prePaidRequest)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), prePaidRequest.GetActionName(), AlibabaCloudSdkGoERROR)
+	if !d.IsNewResource() && (d.HasChange("instance_charge_type")) {
+		bssOpenApiService := BssOpenApiService{client}
+		period := 0
+		if PayType(d.Get("instance_charge_type").(string)) == Prepaid {
+			period = d.Get("period").(int)
+		}
+		if err := bssOpenApiService.ConvertChargeType(BssProductCodeRds, "", d.Id(), period); err != nil {
+			return WrapError(err)
 		}
-		addDebug(prePaidRequest.GetActionName(), raw, prePaidRequest.RpcRequest, prePaidRequest)
 		// wait instance status is Normal after modifying
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
@@ -382,24 +386,10 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("auto_renew") || d.HasChange("auto_renew_period") {
-		request := rds.CreateModifyInstanceAutoRenewalAttributeRequest()
-		request.DBInstanceId = d.Id()
-		request.RegionId = client.RegionId
-		auto_renew := d.Get("auto_renew").(bool)
-		if auto_renew {
-			request.AutoRenew = "True"
-		} else {
-			request.AutoRenew = "False"
-		}
-		request.Duration = strconv.Itoa(d.Get("auto_renew_period").(int))
-
-		raw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
-			return client.ModifyInstanceAutoRenewalAttribute(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		bssOpenApiService := BssOpenApiService{client}
+		if err := bssOpenApiService.SetAutoRenew(BssProductCodeRds, d.Id(), d.Get("auto_renew").(bool), d.Get("auto_renew_period").(int)); err != nil {
+			return WrapError(err)
 		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 		d.SetPartial("auto_renew")
 		d.SetPartial("auto_renew_period")
@@ -551,12 +541,13 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 		return WrapError(err)
 	}
 
-	tags, err := rdsService.describeTags(d)
+	tagService := TagService{client}
+	tags, err := tagService.ListResourceTags(d.Id(), connectivity.RDSCode, TagResourceInstance)
 	if err != nil {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", rdsService.tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, tags); err != nil {
+		return WrapError(err)
 	}
 
 	monitoringPeriod, err := rdsService.DescribeDbInstanceMonitor(d.Id())
@@ -580,6 +571,7 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("connection_string", instance.ConnectionString)
 	d.Set("instance_name", instance.DBInstanceDescription)
 	d.Set("maintain_time", instance.MaintainTime)
+	d.Set("resource_group_id", instance.ResourceGroupId)
 
 	if err = rdsService.RefreshParameters(d, "parameters"); err != nil {
 		return WrapError(err)
@@ -673,6 +665,7 @@ func buildDBCreateRequest(d *schema.ResourceData, meta interface{}) (*rds.Create
 	request.DBInstanceClass = Trim(d.Get("instance_type").(string))
 	request.DBInstanceNetType = string(Intranet)
 	request.DBInstanceDescription = d.Get("instance_name").(string)
+	request.ResourceGroupId = d.Get("resource_group_id").(string)
 
 	if zone, ok := d.GetOk("zone_id"); ok && Trim(zone.(string)) != "" {
 		request.ZoneId = Trim(zone.(string))
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_db_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_db_instance", &resource.Sweeper{
 		Name: "alicloud_db_instance",
 		F:    testSweepDBInstances,
 	})
@@ -75,6 +75,7 @@ func testSweepDBInstances(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_db_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping RDS Instance: %s (%s)", name, id)
 			continue
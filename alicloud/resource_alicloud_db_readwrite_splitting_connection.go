@@ -26,6 +26,12 @@ func resourceAlicloudDBReadWriteSplittingConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Read:   schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -126,7 +132,7 @@ func resourceAlicloudDBReadWriteSplittingConnectionRead(d *schema.ResourceData,
 	client := meta.(*connectivity.AliyunClient)
 	rdsService := RdsService{client}
 
-	err := rdsService.WaitForDBReadWriteSplitting(d.Id(), "", DefaultLongTimeout)
+	err := rdsService.WaitForDBReadWriteSplitting(d.Id(), "", int(d.Timeout(schema.TimeoutRead).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -226,7 +232,7 @@ func resourceAlicloudDBReadWriteSplittingConnectionUpdate(d *schema.ResourceData
 		}
 
 		// wait instance running after modifying
-		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
+		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -261,5 +267,5 @@ func resourceAlicloudDBReadWriteSplittingConnectionDelete(d *schema.ResourceData
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(rdsService.WaitForDBReadWriteSplitting(d.Id(), Deleted, DefaultLongTimeout))
+	return WrapError(rdsService.WaitForDBReadWriteSplitting(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
@@ -20,6 +21,10 @@ func resourceAlicloudDdosbgpInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:         schema.TypeString,
@@ -171,7 +176,7 @@ func resourceAlicloudDdosbgpInstanceDelete(d *schema.ResourceData, meta interfac
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(ddosbgpService.WaitForDdosbgpInstance(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ddosbgpService.WaitForDdosbgpInstance(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func buildDdosbgpCreateRequest(region string, d *schema.ResourceData, meta interface{}) *bssopenapi.CreateInstanceRequest {
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ddosbgp_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_ddosbgp_instance", &resource.Sweeper{
 		Name: "alicloud_ddosbgp_instance",
 		F:    testSweepDdosbgpInstances,
 	})
@@ -76,6 +76,7 @@ func testSweepDdosbgpInstances(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ddosbgp_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Ddosbgp Instance: %s", name)
 			continue
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ddoscoo_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_ddoscoo_instance", &resource.Sweeper{
 		Name: "alicloud_ddoscoo_instance",
 		F:    testSweepDdoscooInstances,
 	})
@@ -74,6 +74,7 @@ func testSweepDdoscooInstances(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ddoscoo_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Ddoscoo Instance: %s", name)
 			continue
@@ -20,6 +20,13 @@ func resourceAliyunDisk() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -92,7 +99,8 @@ func resourceAliyunDisk() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -149,7 +157,7 @@ func resourceAliyunDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*ecs.CreateDiskResponse)
 	d.SetId(response.DiskId)
-	if err := ecsService.WaitForDisk(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForDisk(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -186,8 +194,8 @@ func resourceAliyunDiskRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil && !NotFoundError(err) {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	return nil
@@ -203,6 +211,7 @@ func resourceAliyunDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		return WrapError(err)
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	update := false
 	request := ecs.CreateModifyDiskAttributeRequest()
@@ -308,5 +317,5 @@ func resourceAliyunDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForDisk(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ecsService.WaitForDisk(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
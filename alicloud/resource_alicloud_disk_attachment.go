@@ -17,6 +17,14 @@ func resourceAliyunDiskAttachment() *schema.Resource {
 		Create: resourceAliyunDiskAttachmentCreate,
 		Read:   resourceAliyunDiskAttachmentRead,
 		Delete: resourceAliyunDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -75,7 +83,7 @@ func resourceAliyunDiskAttachmentCreate(d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(request.DiskId + ":" + request.InstanceId)
 
-	if err := ecsService.WaitForDiskAttachment(d.Id(), DiskInUse, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForDiskAttachment(d.Id(), DiskInUse, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	newDisk, err := ecsService.DescribeDisk(diskID)
@@ -151,5 +159,5 @@ func resourceAliyunDiskAttachmentDelete(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForDiskAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ecsService.WaitForDiskAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
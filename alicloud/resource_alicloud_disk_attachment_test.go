@@ -43,6 +43,11 @@ func TestAccAlicloudDiskAttachment(t *testing.T) {
 						"alicloud_disk_attachment.default", "device_name"),
 				),
 			},
+			{
+				ResourceName:      "alicloud_disk_attachment.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccDiskAttachmentConfigResize(),
 				Check: resource.ComposeTestCheckFunc(
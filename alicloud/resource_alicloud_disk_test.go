@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_disk", &resource.Sweeper{
+	addTestSweepers("alicloud_disk", &resource.Sweeper{
 		Name: "alicloud_disk",
 		F:    testSweepDisks,
 	})
@@ -75,6 +75,7 @@ func testSweepDisks(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_disk", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Disk: %s (%s)", name, id)
 			continue
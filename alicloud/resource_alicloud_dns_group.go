@@ -15,6 +15,9 @@ func resourceAlicloudDnsGroup() *schema.Resource {
 		Read:   resourceAlicloudDnsGroupRead,
 		Update: resourceAlicloudDnsGroupUpdate,
 		Delete: resourceAlicloudDnsGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
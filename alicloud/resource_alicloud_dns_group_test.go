@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers(
+	addTestSweepers(
 		"alicloud_dns_group",
 		&resource.Sweeper{
 			Name: "alicloud_dns_group",
@@ -46,7 +46,7 @@ func testSweepDnsGroup(region string) error {
 		response, _ := raw.(*alidns.DescribeDomainGroupsResponse)
 		groups := response.DomainGroups.DomainGroup
 		for _, domainGroup := range groups {
-			if strings.HasPrefix(domainGroup.GroupName, "tf-testacc") {
+			if !sweepSkip("alicloud_dns_group", !strings.HasPrefix(domainGroup.GroupName, "tf-testacc"), domainGroup) {
 				allGroups = append(allGroups, domainGroup)
 			} else {
 				log.Printf("Skip %#v.", domainGroup)
@@ -112,6 +112,11 @@ func TestAccAlicloudDnsGroup_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccConfig(map[string]interface{}{
 					"name": fmt.Sprintf("tf-testaccdns%d", rand-1),
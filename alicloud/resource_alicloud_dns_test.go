@@ -16,10 +16,10 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers(
-		"alicloud.dns",
+	addTestSweepers(
+		"alicloud_dns",
 		&resource.Sweeper{
-			Name: "alicloud.dns",
+			Name: "alicloud_dns",
 			F:    testSweepDns,
 		})
 }
@@ -45,7 +45,7 @@ func testSweepDns(region string) error {
 		response, _ := raw.(*alidns.DescribeDomainsResponse)
 		domains := response.Domains.Domain
 		for _, domain := range domains {
-			if strings.HasPrefix(domain.DomainName, "tf-testacc"+defaultRegionToTest) {
+			if !sweepSkip("alicloud_dns", !strings.HasPrefix(domain.DomainName, "tf-testacc"+defaultRegionToTest), domain) {
 				allDomains = append(allDomains, domain)
 			} else {
 				log.Printf("Skip %#v", domain)
@@ -17,6 +17,7 @@ func resourceAlicloudDRDSInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: productAvailableCustomizeDiff(connectivity.DRDSCode),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
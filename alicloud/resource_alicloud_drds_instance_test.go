@@ -17,14 +17,14 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_drds_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_drds_instance", &resource.Sweeper{
 		Name: "alicloud_drds_instance",
 		F:    testSweepDRDSInstances,
 	})
 }
 
 func testSweepDRDSInstances(region string) error {
-	if testSweepPreCheckWithRegions(region, true, connectivity.DrdsSupportedRegions) {
+	if testSweepPreCheckWithProduct(region, connectivity.DRDSCode) {
 		log.Printf("[INFO] Skipping DRDS Instance unsupported region: %s", region)
 		return nil
 	}
@@ -59,6 +59,7 @@ func testSweepDRDSInstances(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_drds_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping DRDS Instance: %s (%s)", name, id)
 			continue
@@ -103,7 +104,7 @@ func TestAccAlicloudDRDSInstance_Basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
-			testAccPreCheckWithRegions(t, true, connectivity.DrdsSupportedRegions)
+			testAccPreCheckWithProduct(t, connectivity.DRDSCode)
 			testAccPreCheckWithRegions(t, false, connectivity.DrdsClassicNoSupportedRegions)
 			testAccPreCheckWithAccountSiteType(t, DomesticSite)
 		},
@@ -176,7 +177,7 @@ func TestAccAlicloudDRDSInstance_Vpc(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
-			testAccPreCheckWithRegions(t, true, connectivity.DrdsSupportedRegions)
+			testAccPreCheckWithProduct(t, connectivity.DRDSCode)
 			testAccPreCheckWithAccountSiteType(t, DomesticSite)
 		},
 		// module name
@@ -249,7 +250,7 @@ func TestAccAlicloudDRDSInstance_Multi(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
-			testAccPreCheckWithRegions(t, true, connectivity.DrdsSupportedRegions)
+			testAccPreCheckWithProduct(t, connectivity.DRDSCode)
 			testAccPreCheckWithRegions(t, false, connectivity.DrdsClassicNoSupportedRegions)
 			testAccPreCheckWithAccountSiteType(t, DomesticSite)
 		},
@@ -0,0 +1,163 @@
+package alicloud
+
+import (
+	"encoding/base64"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudEcsCommand() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEcsCommandCreate,
+		Read:   resourceAlicloudEcsCommandRead,
+		Update: resourceAlicloudEcsCommandUpdate,
+		Delete: resourceAlicloudEcsCommandDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(1, 512),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"RunShellScript", "RunPowerShellScript", "RunBatScript"}),
+			},
+			// The content is sent Base64 encoded, it is kept as plain text in the state.
+			"command_content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"working_dir": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validateIntegerInRange(10, 86400),
+			},
+			"enable_parameter": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"parameter_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAlicloudEcsCommandCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := ecs.CreateCreateCommandRequest()
+	request.RegionId = client.RegionId
+	request.Name = d.Get("name").(string)
+	request.Description = d.Get("description").(string)
+	request.Type = d.Get("type").(string)
+	request.CommandContent = base64.StdEncoding.EncodeToString([]byte(d.Get("command_content").(string)))
+	request.WorkingDir = d.Get("working_dir").(string)
+	request.Timeout = requests.NewInteger(d.Get("timeout").(int))
+	request.EnableParameter = requests.NewBoolean(d.Get("enable_parameter").(bool))
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.CreateCommand(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_ecs_command", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.CreateCommandResponse)
+	d.SetId(response.CommandId)
+
+	return resourceAlicloudEcsCommandRead(d, meta)
+}
+
+func resourceAlicloudEcsCommandRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	command, err := ecsService.DescribeEcsCommand(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("name", command.Name)
+	d.Set("description", command.Description)
+	d.Set("type", command.Type)
+	d.Set("command_content", command.CommandContent)
+	d.Set("working_dir", command.WorkingDir)
+	d.Set("timeout", command.Timeout)
+	d.Set("enable_parameter", command.EnableParameter)
+	if err := d.Set("parameter_names", command.ParameterNames.ParameterName); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlicloudEcsCommandUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("command_content") || d.HasChange("working_dir") || d.HasChange("timeout") {
+		request := ecs.CreateModifyCommandRequest()
+		request.RegionId = client.RegionId
+		request.CommandId = d.Id()
+		request.Name = d.Get("name").(string)
+		request.Description = d.Get("description").(string)
+		request.CommandContent = base64.StdEncoding.EncodeToString([]byte(d.Get("command_content").(string)))
+		request.WorkingDir = d.Get("working_dir").(string)
+		request.Timeout = requests.NewInteger(d.Get("timeout").(int))
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyCommand(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	return resourceAlicloudEcsCommandRead(d, meta)
+}
+
+func resourceAlicloudEcsCommandDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := ecs.CreateDeleteCommandRequest()
+	request.RegionId = client.RegionId
+	request.CommandId = d.Id()
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DeleteCommand(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, CommandNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
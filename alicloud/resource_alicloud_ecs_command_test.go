@@ -0,0 +1,233 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func init() {
+	addTestSweepers("alicloud_ecs_command", &resource.Sweeper{
+		Name: "alicloud_ecs_command",
+		F:    testSweepEcsCommands,
+	})
+}
+
+func testSweepEcsCommands(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return WrapError(err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	var commands []ecs.Command
+	request := ecs.CreateDescribeCommandsRequest()
+	request.RegionId = client.RegionId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeCommands(request)
+		})
+		if err != nil {
+			return WrapError(err)
+		}
+		response, _ := raw.(*ecs.DescribeCommandsResponse)
+		if len(response.Commands.Command) < 1 {
+			break
+		}
+		commands = append(commands, response.Commands.Command...)
+
+		if len(response.Commands.Command) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return err
+		} else {
+			request.PageNumber = page
+		}
+	}
+
+	for _, v := range commands {
+		name := v.Name
+		id := v.CommandId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		skip = sweepSkip("alicloud_ecs_command", skip, v)
+		if skip {
+			log.Printf("[INFO] Skipping command: %s (%s)", name, id)
+			continue
+		}
+		log.Printf("[INFO] Deleting command: %s (%s)", name, id)
+		req := ecs.CreateDeleteCommandRequest()
+		req.RegionId = client.RegionId
+		req.CommandId = id
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DeleteCommand(req)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete command (%s (%s)): %s", name, id, err)
+		}
+	}
+	return nil
+}
+
+func TestAccAlicloudEcsCommandBasic(t *testing.T) {
+	var v ecs.Command
+	resourceId := "alicloud_ecs_command.default"
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccEcsCommand%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"name":              name,
+		"description":       name,
+		"type":              "RunShellScript",
+		"command_content":   "echo hello",
+		"working_dir":       "/root",
+		"timeout":           "60",
+		"enable_parameter":  "false",
+		"parameter_names.#": "0",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeEcsCommand")
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceEcsCommandConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":            "${var.name}",
+					"description":     "${var.name}",
+					"type":            "RunShellScript",
+					"command_content": "echo hello",
+					"working_dir":     "/root",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":        "${var.name}-update",
+					"description": "${var.name}-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name":        name + "-update",
+						"description": name + "-update",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"command_content": "echo update",
+					"working_dir":     "/tmp",
+					"timeout":         "120",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"command_content": "echo update",
+						"working_dir":     "/tmp",
+						"timeout":         "120",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudEcsCommandParameter(t *testing.T) {
+	var v ecs.Command
+	resourceId := "alicloud_ecs_command.default"
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccEcsCommandParameter%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"name":              name,
+		"type":              "RunShellScript",
+		"command_content":   "echo {{greeting}}",
+		"enable_parameter":  "true",
+		"parameter_names.#": "1",
+		"parameter_names.0": "greeting",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeEcsCommand")
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceEcsCommandConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":             "${var.name}",
+					"type":             "RunShellScript",
+					"command_content":  "echo {{greeting}}",
+					"enable_parameter": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+		},
+	})
+}
+
+func resourceEcsCommandConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+`, name)
+}
@@ -0,0 +1,285 @@
+package alicloud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudEcsDedicatedHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEcsDedicatedHostCreate,
+		Read:   resourceAlicloudEcsDedicatedHostRead,
+		Update: resourceAlicloudEcsDedicatedHostUpdate,
+		Delete: resourceAlicloudEcsDedicatedHostDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"dedicated_host_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"dedicated_host_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStringLengthInRange(2, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 256),
+			},
+			"action_on_maintenance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"Migrate", "Stop"}),
+			},
+			"auto_placement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"on", "off"}),
+			},
+			"auto_release_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      PostPaid,
+				ValidateFunc: validateAllowedStringValue([]string{string(PostPaid), string(PrePaid)}),
+			},
+			"period": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateFunc:     validateIntegerInRange(1, 60),
+				DiffSuppressFunc: ecsDedicatedHostPostPaidDiffSuppressFunc,
+			},
+			"period_unit": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          Month,
+				ValidateFunc:     validateAllowedStringValue([]string{"Week", string(Month), "Year"}),
+				DiffSuppressFunc: ecsDedicatedHostPostPaidDiffSuppressFunc,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expired_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudEcsDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateAllocateDedicatedHostsRequest()
+	request.RegionId = client.RegionId
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.DedicatedHostType = d.Get("dedicated_host_type").(string)
+	request.ZoneId = d.Get("zone_id").(string)
+	request.DedicatedHostName = d.Get("dedicated_host_name").(string)
+	request.Description = d.Get("description").(string)
+	request.ActionOnMaintenance = d.Get("action_on_maintenance").(string)
+	request.AutoPlacement = d.Get("auto_placement").(string)
+	request.AutoReleaseTime = d.Get("auto_release_time").(string)
+	request.ResourceGroupId = d.Get("resource_group_id").(string)
+	request.ChargeType = d.Get("charge_type").(string)
+	if request.ChargeType == string(PrePaid) {
+		request.Period = requests.NewInteger(d.Get("period").(int))
+		request.PeriodUnit = d.Get("period_unit").(string)
+	}
+	request.Quantity = requests.NewInteger(1)
+	var tags []ecs.AllocateDedicatedHostsTag
+	for key, value := range d.Get("tags_all").(map[string]interface{}) {
+		tags = append(tags, ecs.AllocateDedicatedHostsTag{Key: key, Value: value.(string)})
+	}
+	if len(tags) > 0 {
+		request.Tag = &tags
+	}
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.AllocateDedicatedHosts(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_ecs_dedicated_host", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.AllocateDedicatedHostsResponse)
+	if len(response.DedicatedHostIdSets.DedicatedHostId) < 1 {
+		return WrapErrorf(Error(GetNotFoundMessage("EcsDedicatedHost", "")), IdMsg, "alicloud_ecs_dedicated_host")
+	}
+	d.SetId(response.DedicatedHostIdSets.DedicatedHostId[0])
+
+	stateConf := BuildStateConf([]string{}, []string{string(DedicatedHostAvailable)}, d.Timeout(schema.TimeoutCreate), 5*time.Second,
+		ecsService.EcsDedicatedHostStateRefreshFunc(d.Id(), []string{string(DedicatedHostPermanentFailure)}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlicloudEcsDedicatedHostRead(d, meta)
+}
+
+func resourceAlicloudEcsDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	host, err := ecsService.DescribeEcsDedicatedHost(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("dedicated_host_type", host.DedicatedHostType)
+	d.Set("zone_id", host.ZoneId)
+	d.Set("dedicated_host_name", host.DedicatedHostName)
+	d.Set("description", host.Description)
+	d.Set("action_on_maintenance", host.ActionOnMaintenance)
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("auto_release_time", host.AutoReleaseTime)
+	d.Set("resource_group_id", host.ResourceGroupId)
+	d.Set("charge_type", host.ChargeType)
+	d.Set("status", host.Status)
+	d.Set("expired_time", host.ExpiredTime)
+
+	tags, err := ecsService.DescribeTags(d.Id(), TagResourceDedicatedHost)
+	if err != nil && !NotFoundError(err) {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlicloudEcsDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	d.Partial(true)
+
+	if err := setTags(client, TagResourceDedicatedHost, d); err != nil {
+		return WrapError(err)
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
+	if d.HasChange("dedicated_host_name") || d.HasChange("description") || d.HasChange("action_on_maintenance") || d.HasChange("auto_placement") {
+		request := ecs.CreateModifyDedicatedHostAttributeRequest()
+		request.RegionId = client.RegionId
+		request.DedicatedHostId = d.Id()
+		request.DedicatedHostName = d.Get("dedicated_host_name").(string)
+		request.Description = d.Get("description").(string)
+		request.ActionOnMaintenance = d.Get("action_on_maintenance").(string)
+		request.AutoPlacement = d.Get("auto_placement").(string)
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyDedicatedHostAttribute(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		d.SetPartial("dedicated_host_name")
+		d.SetPartial("description")
+		d.SetPartial("action_on_maintenance")
+		d.SetPartial("auto_placement")
+	}
+
+	if d.HasChange("auto_release_time") {
+		request := ecs.CreateModifyDedicatedHostAutoReleaseTimeRequest()
+		request.RegionId = client.RegionId
+		request.DedicatedHostId = d.Id()
+		request.AutoReleaseTime = d.Get("auto_release_time").(string)
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyDedicatedHostAutoReleaseTime(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		d.SetPartial("auto_release_time")
+	}
+
+	d.Partial(false)
+	return resourceAlicloudEcsDedicatedHostRead(d, meta)
+}
+
+func resourceAlicloudEcsDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	if strings.ToLower(d.Get("charge_type").(string)) == strings.ToLower(string(PrePaid)) {
+		return WrapError(Error("At present, 'PrePaid' dedicated host cannot be deleted and must wait it to be expired and release it automatically"))
+	}
+
+	request := ecs.CreateReleaseDedicatedHostRequest()
+	request.RegionId = client.RegionId
+	request.DedicatedHostId = d.Id()
+
+	var raw interface{}
+	invoker := NewProductInvoker(client, connectivity.ECSCode)
+	// The instances on the host are being released
+	invoker.AddCatcher(Catcher{DedicatedHostHasInstance, 0, DefaultRetryWaitSeconds})
+	err := invoker.Run(func() error {
+		resp, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ReleaseDedicatedHost(request)
+		})
+		raw = resp
+		return err
+	})
+	if err != nil {
+		if IsExceptedError(err, DedicatedHostNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+
+	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second,
+		ecsService.EcsDedicatedHostStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func init() {
+	addTestSweepers("alicloud_ecs_dedicated_host", &resource.Sweeper{
+		Name: "alicloud_ecs_dedicated_host",
+		F:    testSweepEcsDedicatedHosts,
+		// The instances on the hosts should be released firstly
+		Dependencies: []string{"alicloud_instance"},
+	})
+}
+
+func testSweepEcsDedicatedHosts(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return WrapError(err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	var hosts []ecs.DedicatedHost
+	request := ecs.CreateDescribeDedicatedHostsRequest()
+	request.RegionId = client.RegionId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeDedicatedHosts(request)
+		})
+		if err != nil {
+			return WrapError(err)
+		}
+		response, _ := raw.(*ecs.DescribeDedicatedHostsResponse)
+		if len(response.DedicatedHosts.DedicatedHost) < 1 {
+			break
+		}
+		hosts = append(hosts, response.DedicatedHosts.DedicatedHost...)
+
+		if len(response.DedicatedHosts.DedicatedHost) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return err
+		} else {
+			request.PageNumber = page
+		}
+	}
+
+	for _, v := range hosts {
+		name := v.DedicatedHostName
+		id := v.DedicatedHostId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		// The PrePaid hosts can not be released
+		if v.ChargeType == string(PrePaid) {
+			skip = true
+		}
+		skip = sweepSkip("alicloud_ecs_dedicated_host", skip, v)
+		if skip {
+			log.Printf("[INFO] Skipping dedicated host: %s (%s)", name, id)
+			continue
+		}
+		log.Printf("[INFO] Releasing dedicated host: %s (%s)", name, id)
+		req := ecs.CreateReleaseDedicatedHostRequest()
+		req.RegionId = client.RegionId
+		req.DedicatedHostId = id
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ReleaseDedicatedHost(req)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to release dedicated host (%s (%s)): %s", name, id, err)
+		}
+	}
+	return nil
+}
+
+func TestAccAlicloudEcsDedicatedHostBasic(t *testing.T) {
+	var v ecs.DedicatedHost
+	resourceId := "alicloud_ecs_dedicated_host.default"
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccEcsDedicatedHost%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"dedicated_host_type":   "ddh.g5",
+		"dedicated_host_name":   name,
+		"description":           name,
+		"zone_id":               CHECKSET,
+		"action_on_maintenance": CHECKSET,
+		"auto_placement":        CHECKSET,
+		"charge_type":           "PostPaid",
+		"status":                "Available",
+		"tags.%":                "1",
+		"tags.version":          "1.0",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeEcsDedicatedHost")
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceEcsDedicatedHostConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"dedicated_host_type": "ddh.g5",
+					"dedicated_host_name": "${var.name}",
+					"description":         "${var.name}",
+					"tags": map[string]string{
+						"version": "1.0",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"period", "period_unit"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"dedicated_host_name": "${var.name}-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"dedicated_host_name": name + "-update",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"description": "${var.name}-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"description": name + "-update",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"action_on_maintenance": "Stop",
+					"auto_placement":        "off",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"action_on_maintenance": "Stop",
+						"auto_placement":        "off",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"auto_release_time": time.Now().Add(48 * time.Hour).UTC().Format("2006-01-02T15:04:05Z"),
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"auto_release_time": CHECKSET,
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"tags": map[string]string{
+						"version": "1.0",
+						"Tag2":    "Tag2",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tags.%":    "2",
+						"tags.Tag2": "Tag2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceEcsDedicatedHostConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+`, name)
+}
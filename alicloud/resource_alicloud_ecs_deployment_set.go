@@ -0,0 +1,154 @@
+package alicloud
+
+import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudEcsDeploymentSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEcsDeploymentSetCreate,
+		Read:   resourceAlicloudEcsDeploymentSetRead,
+		Update: resourceAlicloudEcsDeploymentSetUpdate,
+		Delete: resourceAlicloudEcsDeploymentSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_set_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 256),
+			},
+			"strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Availability",
+				ValidateFunc: validateAllowedStringValue([]string{"Availability"}),
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Default",
+				ValidateFunc: validateAllowedStringValue([]string{"Default"}),
+			},
+			"granularity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Host",
+				ValidateFunc: validateAllowedStringValue([]string{"Host"}),
+			},
+			"on_unable_to_redeploy_failed_instance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"CancelMembershipAndStart", "KeepStopped"}),
+			},
+			"instance_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAlicloudEcsDeploymentSetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := ecs.CreateCreateDeploymentSetRequest()
+	request.RegionId = client.RegionId
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.DeploymentSetName = d.Get("deployment_set_name").(string)
+	request.Description = d.Get("description").(string)
+	request.Strategy = d.Get("strategy").(string)
+	request.Domain = d.Get("domain").(string)
+	request.Granularity = d.Get("granularity").(string)
+	request.OnUnableToRedeployFailedInstance = d.Get("on_unable_to_redeploy_failed_instance").(string)
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.CreateDeploymentSet(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_ecs_deployment_set", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.CreateDeploymentSetResponse)
+	d.SetId(response.DeploymentSetId)
+
+	return resourceAlicloudEcsDeploymentSetRead(d, meta)
+}
+
+func resourceAlicloudEcsDeploymentSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	set, err := ecsService.DescribeEcsDeploymentSet(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("deployment_set_name", set.DeploymentSetName)
+	d.Set("description", set.DeploymentSetDescription)
+	d.Set("strategy", set.Strategy)
+	d.Set("domain", set.Domain)
+	d.Set("granularity", set.Granularity)
+	if err := d.Set("instance_ids", set.InstanceIds.InstanceId); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlicloudEcsDeploymentSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	if d.HasChange("deployment_set_name") || d.HasChange("description") {
+		request := ecs.CreateModifyDeploymentSetAttributeRequest()
+		request.RegionId = client.RegionId
+		request.DeploymentSetId = d.Id()
+		request.DeploymentSetName = d.Get("deployment_set_name").(string)
+		request.Description = d.Get("description").(string)
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyDeploymentSetAttribute(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	return resourceAlicloudEcsDeploymentSetRead(d, meta)
+}
+
+func resourceAlicloudEcsDeploymentSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := ecs.CreateDeleteDeploymentSetRequest()
+	request.RegionId = client.RegionId
+	request.DeploymentSetId = d.Id()
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DeleteDeploymentSet(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, DeploymentSetNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
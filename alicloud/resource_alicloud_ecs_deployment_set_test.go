@@ -0,0 +1,175 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func init() {
+	addTestSweepers("alicloud_ecs_deployment_set", &resource.Sweeper{
+		Name: "alicloud_ecs_deployment_set",
+		F:    testSweepEcsDeploymentSets,
+		// The instances in the deployment sets should be released firstly
+		Dependencies: []string{"alicloud_instance"},
+	})
+}
+
+func testSweepEcsDeploymentSets(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return WrapError(err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	var sets []ecs.DeploymentSet
+	request := ecs.CreateDescribeDeploymentSetsRequest()
+	request.RegionId = client.RegionId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeDeploymentSets(request)
+		})
+		if err != nil {
+			return WrapError(err)
+		}
+		response, _ := raw.(*ecs.DescribeDeploymentSetsResponse)
+		if len(response.DeploymentSets.DeploymentSet) < 1 {
+			break
+		}
+		sets = append(sets, response.DeploymentSets.DeploymentSet...)
+
+		if len(response.DeploymentSets.DeploymentSet) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return err
+		} else {
+			request.PageNumber = page
+		}
+	}
+
+	for _, v := range sets {
+		name := v.DeploymentSetName
+		id := v.DeploymentSetId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		skip = sweepSkip("alicloud_ecs_deployment_set", skip, v)
+		if skip {
+			log.Printf("[INFO] Skipping deployment set: %s (%s)", name, id)
+			continue
+		}
+		log.Printf("[INFO] Deleting deployment set: %s (%s)", name, id)
+		req := ecs.CreateDeleteDeploymentSetRequest()
+		req.RegionId = client.RegionId
+		req.DeploymentSetId = id
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DeleteDeploymentSet(req)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete deployment set (%s (%s)): %s", name, id, err)
+		}
+	}
+	return nil
+}
+
+func TestAccAlicloudEcsDeploymentSetBasic(t *testing.T) {
+	var v ecs.DeploymentSet
+	resourceId := "alicloud_ecs_deployment_set.default"
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccEcsDeploymentSet%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"deployment_set_name": name,
+		"description":         name,
+		"strategy":            "Availability",
+		"domain":              "Default",
+		"granularity":         "Host",
+		"instance_ids.#":      "0",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeEcsDeploymentSet")
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceEcsDeploymentSetConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"deployment_set_name": "${var.name}",
+					"description":         "${var.name}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"on_unable_to_redeploy_failed_instance"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"deployment_set_name": "${var.name}-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"deployment_set_name": name + "-update",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"description": "${var.name}-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"description": name + "-update",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceEcsDeploymentSetConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+`, name)
+}
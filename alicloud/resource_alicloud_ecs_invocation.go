@@ -0,0 +1,230 @@
+package alicloud
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudEcsInvocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudEcsInvocationCreate,
+		Read:   resourceAlicloudEcsInvocationRead,
+		Delete: resourceAlicloudEcsInvocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"command_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 50,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exit_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finished_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlicloudEcsInvocationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateInvokeCommandRequest()
+	request.RegionId = client.RegionId
+	request.CommandId = d.Get("command_id").(string)
+	instanceIds := expandStringList(d.Get("instance_ids").(*schema.Set).List())
+	request.InstanceId = &instanceIds
+	if v := d.Get("frequency").(string); v != "" {
+		request.Timed = requests.NewBoolean(true)
+		request.Frequency = v
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		request.Parameters = v.(map[string]interface{})
+	}
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.InvokeCommand(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_ecs_invocation", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.InvokeCommandResponse)
+	d.SetId(response.InvokeId)
+
+	// The timed invocations keep running until they are stopped
+	if d.Get("wait_for_completion").(bool) && d.Get("frequency").(string) == "" {
+		stateConf := BuildStateConf([]string{string(InvocationPending), string(InvocationRunning)}, []string{string(InvocationFinished)}, d.Timeout(schema.TimeoutCreate), 5*time.Second,
+			ecsService.EcsInvocationStateRefreshFunc(d.Id(), []string{string(InvocationFailed), string(InvocationPartialFailed), string(InvocationStopped)}))
+		if _, err := stateConf.WaitForState(); err != nil {
+			// Keep the results of the instances in the state to find out why the invocation failed
+			if err := resourceAlicloudEcsInvocationRead(d, meta); err != nil {
+				return WrapError(err)
+			}
+			return WrapErrorf(err, IdMsg, d.Id())
+		}
+	}
+
+	return resourceAlicloudEcsInvocationRead(d, meta)
+}
+
+func resourceAlicloudEcsInvocationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	invocation, err := ecsService.DescribeEcsInvocation(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("command_id", invocation.CommandId)
+	d.Set("frequency", invocation.Frequency)
+	d.Set("status", invocation.InvokeStatus)
+	if invocation.Parameters != "" {
+		parameters := make(map[string]string)
+		if err := json.Unmarshal([]byte(invocation.Parameters), &parameters); err != nil {
+			return WrapError(err)
+		}
+		if err := d.Set("parameters", parameters); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	var instanceIds []string
+	for _, instance := range invocation.InvokeInstances.InvokeInstance {
+		instanceIds = append(instanceIds, instance.InstanceId)
+	}
+	if err := d.Set("instance_ids", instanceIds); err != nil {
+		return WrapError(err)
+	}
+
+	results, err := ecsService.DescribeEcsInvocationResults(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	var s []map[string]interface{}
+	for _, result := range results {
+		output, err := base64.StdEncoding.DecodeString(result.Output)
+		if err != nil {
+			return WrapError(err)
+		}
+		s = append(s, map[string]interface{}{
+			"instance_id":   result.InstanceId,
+			"status":        result.InvokeRecordStatus,
+			"exit_code":     result.ExitCode,
+			"output":        string(output),
+			"start_time":    result.StartTime,
+			"finished_time": result.FinishedTime,
+		})
+	}
+	if err := d.Set("results", s); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlicloudEcsInvocationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	// The invocation can not be deleted, it is stopped if it is still running or it is timed.
+	invocation, err := ecsService.DescribeEcsInvocation(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	if !invocation.Timed && invocation.InvokeStatus != string(InvocationPending) && invocation.InvokeStatus != string(InvocationRunning) {
+		return nil
+	}
+
+	request := ecs.CreateStopInvocationRequest()
+	request.RegionId = client.RegionId
+	request.InvokeId = d.Id()
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.StopInvocation(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, InvocationNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
@@ -0,0 +1,98 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudEcsInvocationBasic(t *testing.T) {
+	var v ecs.Invocation
+	resourceId := "alicloud_ecs_invocation.default"
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccEcsInvocation%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"command_id":          CHECKSET,
+		"instance_ids.#":      "1",
+		"status":              "Finished",
+		"results.#":           "1",
+		"results.0.status":    "Finished",
+		"results.0.exit_code": "0",
+		"results.0.output":    "hello\n",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeEcsInvocation")
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceEcsInvocationConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers: testAccProviders,
+		// The invocation can not be deleted, it is kept after destroying
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"command_id":   "${alicloud_ecs_command.default.id}",
+					"instance_ids": []string{"${alicloud_instance.default.id}"},
+					"parameters": map[string]string{
+						"greeting": "hello",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"parameters.%":        "1",
+						"parameters.greeting": "hello",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
+
+func resourceEcsInvocationConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+variable "name" {
+  default = "%s"
+}
+
+resource "alicloud_instance" "default" {
+  vswitch_id           = "${alicloud_vswitch.default.id}"
+  image_id             = "${data.alicloud_images.default.images.0.id}"
+  instance_type        = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  system_disk_category = "cloud_efficiency"
+  security_groups      = ["${alicloud_security_group.default.id}"]
+  instance_name        = "${var.name}"
+}
+
+resource "alicloud_ecs_command" "default" {
+  name             = "${var.name}"
+  type             = "RunShellScript"
+  command_content  = "echo {{greeting}}"
+  enable_parameter = true
+}
+`, EcsInstanceCommonTestCase, name)
+}
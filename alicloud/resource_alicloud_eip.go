@@ -21,6 +21,13 @@ func resourceAliyunEip() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -59,7 +66,8 @@ func resourceAliyunEip() *schema.Resource {
 				ValidateFunc:     validateEipChargeTypePeriod,
 				DiffSuppressFunc: ecsPostPaidDiffSuppressFunc,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"ip_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -119,7 +127,7 @@ func resourceAliyunEipCreate(d *schema.ResourceData, meta interface{}) error {
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*vpc.AllocateEipAddressResponse)
 	d.SetId(response.AllocationId)
-	err = vpcService.WaitForEip(d.Id(), Available, DefaultTimeoutMedium)
+	err = vpcService.WaitForEip(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -153,7 +161,9 @@ func resourceAliyunEipRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", vpcService.tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, vpcService.tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
@@ -219,5 +229,5 @@ func resourceAliyunEipDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForEip(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForEip(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
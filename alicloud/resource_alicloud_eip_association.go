@@ -15,6 +15,14 @@ func resourceAliyunEipAssociation() *schema.Resource {
 		Create: resourceAliyunEipAssociationCreate,
 		Read:   resourceAliyunEipAssociationRead,
 		Delete: resourceAliyunEipAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
@@ -84,7 +92,7 @@ func resourceAliyunEipAssociationCreate(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_eip_association", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	if err := vpcService.WaitForEip(request.AllocationId, InUse, 60); err != nil {
+	if err := vpcService.WaitForEip(request.AllocationId, InUse, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	// There is at least 30 seconds delay for ecs instance
@@ -112,6 +120,7 @@ func resourceAliyunEipAssociationRead(d *schema.ResourceData, meta interface{})
 	d.Set("instance_id", object.InstanceId)
 	d.Set("allocation_id", object.AllocationId)
 	d.Set("instance_type", object.InstanceType)
+	d.Set("private_ip_address", object.PrivateIpAddress)
 	return nil
 }
 
@@ -159,5 +168,5 @@ func resourceAliyunEipAssociationDelete(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForEipAssociation(d.Id(), Available, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForEipAssociation(d.Id(), Available, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_eip", &resource.Sweeper{
+	addTestSweepers("alicloud_eip", &resource.Sweeper{
 		Name: "alicloud_eip",
 		F:    testSweepEips,
 		// When implemented, these should be removed firstly
@@ -81,6 +81,7 @@ func testSweepEips(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_eip", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping EIP: %s (%s)", name, id)
 			continue
@@ -28,6 +28,7 @@ func resourceAlicloudElasticsearch() *schema.Resource {
 			Update: schema.DefaultTimeout(120 * time.Minute),
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
+		CustomizeDiff: customizeDiffAll(tagsAllCustomizeDiff, tagApiTagsCustomizeDiff),
 		Schema: map[string]*schema.Schema{
 			// Basic instance information
 			"description": {
@@ -172,6 +173,9 @@ func resourceAlicloudElasticsearch() *schema.Resource {
 				ValidateFunc: validateIntegerInRange(1, 3),
 				Default:      1,
 			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -245,6 +249,15 @@ func resourceAlicloudElasticsearchRead(d *schema.ResourceData, meta interface{})
 	// Cross zone configuration
 	d.Set("zone_count", object.Result.ZoneCount)
 
+	tagService := TagService{client}
+	tags, err := tagService.ListResourceTags(d.Id(), connectivity.ELASTICSEARCHCode, TagResourceInstance)
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tags); err != nil {
+		return WrapError(err)
+	}
+
 	return nil
 }
 
@@ -287,6 +300,11 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 		d.SetPartial("kibana_whitelist")
 	}
 
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, connectivity.ELASTICSEARCHCode, TagResourceInstance); err != nil {
+		return WrapError(err)
+	}
+
 	if d.IsNewResource() {
 		d.Partial(false)
 		return resourceAlicloudElasticsearchRead(d, meta)
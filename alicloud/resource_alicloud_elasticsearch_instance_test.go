@@ -31,7 +31,7 @@ const MasterNodeSpec = "elasticsearch.sn2ne.large"
 const MasterNodeSpecForUpdate = "elasticsearch.sn2ne.xlarge"
 
 func init() {
-	resource.AddTestSweepers("alicloud_elasticsearch_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_elasticsearch_instance", &resource.Sweeper{
 		Name: "alicloud_elasticsearch_instance",
 		F:    testSweepElasticsearch,
 	})
@@ -102,6 +102,7 @@ func testSweepElasticsearch(region string) error {
 				skip = !need
 			}
 		}
+		skip = sweepSkip("alicloud_elasticsearch_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Elasticsearch Instance: %s (%s)", description, id)
 			continue
@@ -17,6 +17,9 @@ func resourceAlicloudEmrCluster() *schema.Resource {
 		Read:   resourceAlicloudEmrClusterRead,
 		Update: resourceAlicloudEmrClusterUpdate,
 		Delete: resourceAlicloudEmrClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -404,6 +407,9 @@ func resourceAlicloudEmrClusterRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("name", object.ClusterInfo.Name)
 	d.Set("charge_type", object.ClusterInfo.ChargeType)
+	d.Set("cluster_type", object.ClusterInfo.SoftwareInfo.ClusterType)
+	d.Set("emr_ver", object.ClusterInfo.SoftwareInfo.EmrVer)
+	d.Set("zone_id", object.ClusterInfo.ZoneId)
 
 	d.Set("high_availability_enable", object.ClusterInfo.HighAvailabilityEnable)
 	d.Set("net_type", object.ClusterInfo.NetType)
@@ -417,6 +423,31 @@ func resourceAlicloudEmrClusterRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("user_defined_emr_ecs_role", object.ClusterInfo.UserDefinedEmrEcsRole)
 	d.Set("related_cluster_id", object.ClusterInfo.RelateClusterInfo.ClusterId)
 
+	// The host groups are named by the service when host_group_name is not specified, so they are only
+	// rebuilt from the cluster when there are none in the state, e.g. after the cluster is imported.
+	if d.Get("host_group").(*schema.Set).Len() == 0 {
+		var hostGroups []map[string]interface{}
+		for _, hostGroup := range object.ClusterInfo.HostGroupList.HostGroup {
+			period, _ := strconv.Atoi(hostGroup.Period)
+			hostGroups = append(hostGroups, map[string]interface{}{
+				"host_group_name":   hostGroup.HostGroupName,
+				"host_group_type":   hostGroup.HostGroupType,
+				"period":            period,
+				"charge_type":       hostGroup.ChargeType,
+				"node_count":        strconv.Itoa(hostGroup.NodeCount),
+				"instance_type":     hostGroup.InstanceType,
+				"disk_type":         strings.ToLower(hostGroup.DiskType),
+				"disk_capacity":     strconv.Itoa(hostGroup.DiskCapacity),
+				"disk_count":        strconv.Itoa(hostGroup.DiskCount),
+				"sys_disk_type":     strings.ToLower(hostGroup.SysDiskType),
+				"sys_disk_capacity": strconv.Itoa(hostGroup.SysDiskCapacity),
+			})
+		}
+		if err := d.Set("host_group", hostGroups); err != nil {
+			return WrapError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -547,5 +578,5 @@ func resourceAlicloudEmrClusterDelete(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return WrapError(emrService.WaitForEmrCluster(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(emrService.WaitForEmrCluster(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_emr_cluster", &resource.Sweeper{
+	addTestSweepers("alicloud_emr_cluster", &resource.Sweeper{
 		Name: "alicloud_emr_cluster",
 		F:    testSweepEmrCluster,
 	})
@@ -61,7 +61,7 @@ func testSweepEmrCluster(region string) error {
 					flag = true
 				}
 			}
-			if flag {
+			if !sweepSkip("alicloud_emr_cluster", !flag, v) {
 				request := emr.CreateReleaseClusterRequest()
 				request.Id = v.ClusterId
 				request.ForceRelease = requests.NewBoolean(true)
@@ -160,6 +160,12 @@ func TestAccAlicloudEmrCluster_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"host_group", "option_software_list", "is_open_public_ip", "ssh_enable", "master_pwd", "key_pair_name"},
+			},
 		},
 	})
 }
@@ -22,6 +22,11 @@ func resourceAlicloudEssAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -65,7 +70,7 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 		if object.LifecycleState == string(Inactive) {
 			return WrapError(Error("Scaling group current status is %s, please active it before attaching or removing ECS instances.", object.LifecycleState))
 		} else {
-			if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, DefaultTimeout); err != nil {
+			if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -214,7 +219,7 @@ func resourceAliyunEssAttachmentDelete(d *schema.ResourceData, meta interface{})
 		return WrapError(err)
 	}
 
-	if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, DefaultTimeout); err != nil {
+	if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
 		if NotFoundError(err) {
 			return nil
 		}
@@ -276,7 +281,7 @@ func resourceAliyunEssAttachmentDelete(d *schema.ResourceData, meta interface{})
 		return WrapError(err)
 	}
 
-	return WrapError(essService.WaitForEssAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func convertArrayInterfaceToArrayString(elm []interface{}) (arr []string) {
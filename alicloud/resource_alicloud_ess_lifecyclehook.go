@@ -20,6 +20,10 @@ func resourceAlicloudEssLifecycleHook() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -168,7 +172,7 @@ func resourceAliyunEssLifeCycleHookDelete(d *schema.ResourceData, meta interface
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(essService.WaitForEssLifecycleHook(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssLifecycleHook(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 
 }
 
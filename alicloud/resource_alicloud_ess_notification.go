@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +19,10 @@ func resourceAlicloudEssNotification() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"notification_arn": {
 				Type:     schema.TypeString,
@@ -137,5 +142,5 @@ func resourceAlicloudEssNotificationDelete(d *schema.ResourceData, meta interfac
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(essService.WaitForEssNotification(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssNotification(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
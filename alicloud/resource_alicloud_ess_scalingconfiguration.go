@@ -23,6 +23,12 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"active": {
@@ -184,6 +190,7 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsAllSchema(),
 
 			"instance_name": {
 				Type:         schema.TypeString,
@@ -410,15 +417,14 @@ func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 		d.SetPartial("instance_name")
 	}
 
-	if d.HasChange("tags") {
-		if v, ok := d.GetOk("tags"); ok {
-			tags := "{"
-			for key, value := range v.(map[string]interface{}) {
-				tags += "\"" + key + "\"" + ":" + "\"" + value.(string) + "\"" + ","
-			}
-			request.Tags = strings.TrimSuffix(tags, ",") + "}"
+	if d.HasChange("tags_all") {
+		tags := "{"
+		for key, value := range d.Get("tags_all").(map[string]interface{}) {
+			tags += "\"" + key + "\"" + ":" + "\"" + value.(string) + "\"" + ","
 		}
+		request.Tags = strings.TrimSuffix(tags, ",") + "}"
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("data_disk") {
@@ -483,19 +489,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 						sgId, strings.Join(csIds, ",")))
 				}
 
-				request := ess.CreateEnableScalingGroupRequest()
-				request.RegionId = client.RegionId
-				request.ScalingGroupId = sgId
-				request.ActiveScalingConfigurationId = activeConfig
-
-				raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
-					return essClient.EnableScalingGroup(request)
-				})
-				if err != nil {
-					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
-				}
-				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-				if err := essService.WaitForEssScalingGroup(sgId, Active, DefaultTimeout); err != nil {
+				if err := essService.EnableEssScalingGroup(sgId, activeConfig, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 					return WrapError(err)
 				}
 
@@ -513,7 +507,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-				if err := essService.WaitForEssScalingGroup(sgId, Inactive, DefaultTimeout); err != nil {
+				if err := essService.WaitForEssScalingGroup(sgId, Inactive, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -553,7 +547,9 @@ func resourceAliyunEssScalingConfigurationRead(d *schema.ResourceData, meta inte
 	d.Set("role_name", object.RamRoleName)
 	d.Set("key_name", object.KeyPairName)
 	d.Set("force_delete", d.Get("force_delete").(bool))
-	d.Set("tags", essTagsToMap(object.Tags.Tag))
+	if err := setTagsAndTagsAll(client, d, essTagsToMap(object.Tags.Tag)); err != nil {
+		return WrapError(err)
+	}
 	d.Set("instance_name", object.InstanceName)
 	d.Set("override", d.Get("override").(bool))
 
@@ -630,7 +626,7 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-			return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, DefaultTimeout))
+			return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 		}
 		return WrapError(Error("Current scaling configuration %s is the last configuration for the scaling group %s. Please launch a new "+
 			"active scaling configuration or set 'force_delete' to 'true' to delete it with deleting its scaling group.", d.Id(), object.ScalingGroupId))
@@ -651,7 +647,7 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 	}
 	addDebug(request.GetActionName(), rawDeleteScalingConfiguration, request.RpcRequest, request)
 
-	return WrapError(essService.WaitForScalingConfiguration(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForScalingConfiguration(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func buildAlicloudEssScalingConfigurationArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingConfigurationRequest, error) {
@@ -775,7 +771,7 @@ func buildAlicloudEssScalingConfigurationArgs(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tags := "{"
 		for key, value := range v.(map[string]interface{}) {
 			tags += "\"" + key + "\"" + ":" + "\"" + value.(string) + "\"" + ","
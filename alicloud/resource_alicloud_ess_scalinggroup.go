@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
 	"reflect"
@@ -23,6 +24,13 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: essScalingGroupLaunchTemplateCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"min_size": {
@@ -106,6 +114,35 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"launch_template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"launch_template_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateEssLaunchTemplateVersion,
+			},
+			"launch_template_override": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weighted_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateIntegerInRange(1, 500),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -137,7 +174,7 @@ func resourceAliyunEssScalingGroupCreate(d *schema.ResourceData, meta interface{
 	}); err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_ess_scalinggroup", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	if err := essService.WaitForEssScalingGroup(d.Id(), Inactive, DefaultTimeout); err != nil {
+	if err := essService.WaitForEssScalingGroup(d.Id(), Inactive, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -149,7 +186,7 @@ func resourceAliyunEssScalingGroupRead(d *schema.ResourceData, meta interface{})
 	client := meta.(*connectivity.AliyunClient)
 	essService := EssService{client}
 
-	object, err := essService.DescribeEssScalingGroup(d.Id())
+	object, overrides, err := essService.DescribeEssScalingGroupWithOverrides(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -167,6 +204,19 @@ func resourceAliyunEssScalingGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("on_demand_percentage_above_base_capacity", object.OnDemandPercentageAboveBaseCapacity)
 	d.Set("spot_instance_pools", object.SpotInstancePools)
 	d.Set("spot_instance_remedy", object.SpotInstanceRemedy)
+	d.Set("launch_template_id", object.LaunchTemplateId)
+	d.Set("launch_template_version", object.LaunchTemplateVersion)
+
+	var overrideList []map[string]interface{}
+	for _, v := range overrides {
+		overrideList = append(overrideList, map[string]interface{}{
+			"instance_type":     v.InstanceType,
+			"weighted_capacity": v.WeightedCapacity,
+		})
+	}
+	if err := d.Set("launch_template_override", overrideList); err != nil {
+		return WrapError(err)
+	}
 	var polices []string
 	if len(object.RemovalPolicies.RemovalPolicy) > 0 {
 		for _, v := range object.RemovalPolicies.RemovalPolicy {
@@ -204,6 +254,7 @@ func resourceAliyunEssScalingGroupRead(d *schema.ResourceData, meta interface{})
 func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AliyunClient)
+	essService := EssService{client}
 	request := ess.CreateModifyScalingGroupRequest()
 	request.RegionId = client.RegionId
 	request.ScalingGroupId = d.Id()
@@ -254,6 +305,18 @@ func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{
 		request.SpotInstanceRemedy = requests.NewBoolean(d.Get("spot_instance_remedy").(bool))
 	}
 
+	if d.HasChange("launch_template_id") {
+		request.LaunchTemplateId = d.Get("launch_template_id").(string)
+	}
+
+	if d.HasChange("launch_template_version") {
+		request.LaunchTemplateVersion = d.Get("launch_template_version").(string)
+	}
+
+	if d.HasChange("launch_template_override") {
+		setEssLaunchTemplateOverrides(request.QueryParams, d.Get("launch_template_override").([]interface{}))
+	}
+
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.ModifyScalingGroup(request)
 	})
@@ -270,8 +333,24 @@ func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{
 	d.SetPartial("on_demand_percentage_above_base_capacity")
 	d.SetPartial("spot_instance_pools")
 	d.SetPartial("spot_instance_remedy")
+	d.SetPartial("launch_template_id")
+	d.SetPartial("launch_template_version")
+	d.SetPartial("launch_template_override")
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
+	// The scaling group creating instances with a launch template has no scaling configuration to be activated with.
+	if d.HasChange("launch_template_id") && d.Get("launch_template_id").(string) != "" {
+		object, err := essService.DescribeEssScalingGroup(d.Id())
+		if err != nil {
+			return WrapError(err)
+		}
+		if object.LifecycleState == string(Inactive) && object.ActiveScalingConfigurationId == "" {
+			if err := essService.EnableEssScalingGroup(d.Id(), "", int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+
 	if d.HasChange("loadbalancer_ids") {
 		oldLoadbalancers, newLoadbalancers := d.GetChange("loadbalancer_ids")
 		err = attachOrDetachLoadbalancers(d, client, oldLoadbalancers.(*schema.Set), newLoadbalancers.(*schema.Set))
@@ -314,7 +393,7 @@ func resourceAliyunEssScalingGroupDelete(d *schema.ResourceData, meta interface{
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingGroupRequest, error) {
@@ -341,7 +420,7 @@ func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{})
 
 	if lbs, ok := d.GetOk("loadbalancer_ids"); ok {
 		for _, lb := range lbs.(*schema.Set).List() {
-			if err := slbService.WaitForSlb(lb.(string), Active, DefaultTimeout); err != nil {
+			if err := slbService.WaitForSlb(lb.(string), Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 				return nil, WrapError(err)
 			}
 		}
@@ -368,9 +447,37 @@ func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{})
 		request.SpotInstanceRemedy = requests.NewBoolean(v.(bool))
 	}
 
+	if v, ok := d.GetOk("launch_template_id"); ok {
+		request.LaunchTemplateId = v.(string)
+	}
+
+	if v, ok := d.GetOk("launch_template_version"); ok {
+		request.LaunchTemplateVersion = v.(string)
+	}
+
+	if v, ok := d.GetOk("launch_template_override"); ok {
+		setEssLaunchTemplateOverrides(request.QueryParams, v.([]interface{}))
+	}
+
 	return request, nil
 }
 
+// setEssLaunchTemplateOverrides sets the launch template overrides which are not supported by the ess sdk yet.
+// The API keeps the overrides when none is sent, so an override with an empty instance type clears all of them.
+func setEssLaunchTemplateOverrides(params map[string]string, overrides []interface{}) {
+	if len(overrides) == 0 {
+		params["LaunchTemplateOverride.1.InstanceType"] = ""
+		return
+	}
+	for i, v := range overrides {
+		override := v.(map[string]interface{})
+		params[fmt.Sprintf("LaunchTemplateOverride.%d.InstanceType", i+1)] = override["instance_type"].(string)
+		if weight := override["weighted_capacity"].(int); weight > 0 {
+			params[fmt.Sprintf("LaunchTemplateOverride.%d.WeightedCapacity", i+1)] = strconv.Itoa(weight)
+		}
+	}
+}
+
 func attachOrDetachLoadbalancers(d *schema.ResourceData, client *connectivity.AliyunClient, oldLoadbalancerSet *schema.Set, newLoadbalancerSet *schema.Set) error {
 	detachLoadbalancerSet := oldLoadbalancerSet.Difference(newLoadbalancerSet)
 	attachLoadbalancerSet := newLoadbalancerSet.Difference(oldLoadbalancerSet)
@@ -468,3 +575,12 @@ func partition(instanceIds *schema.Set, batchSize int) [][]string {
 	}
 	return res
 }
+
+// essScalingGroupLaunchTemplateCustomizeDiff rejects removing launch_template_id, because ModifyScalingGroup drops
+// the empty LaunchTemplateId and the scaling group would keep creating instances from the launch template.
+func essScalingGroupLaunchTemplateCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if o, n := d.GetChange("launch_template_id"); d.Id() != "" && o.(string) != "" && n.(string) == "" {
+		return WrapError(fmt.Errorf("launch_template_id of the scaling group %s can not be removed, set another launch template or recreate the scaling group", d.Id()))
+	}
+	return nil
+}
@@ -18,8 +18,8 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ess_scalinggroup", &resource.Sweeper{
-		Name: "alicloud_ess_scalinggroup",
+	addTestSweepers("alicloud_ess_scaling_group", &resource.Sweeper{
+		Name: "alicloud_ess_scaling_group",
 		F:    testSweepEssGroups,
 	})
 }
@@ -76,6 +76,7 @@ func testSweepEssGroups(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ess_scaling_group", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Scaling Group: %s (%s)", name, id)
 			continue
@@ -279,6 +280,83 @@ func TestAccAlicloudEssScalingGroup_costoptimized(t *testing.T) {
 
 }
 
+func TestAccAlicloudEssScalingGroup_launchTemplate(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 999999)
+	var v ess.ScalingGroup
+	resourceId := "alicloud_ess_scaling_group.default"
+
+	basicMap := map[string]string{
+		"min_size":                "0",
+		"max_size":                "2",
+		"scaling_group_name":      fmt.Sprintf("tf-testAccEssScalingGroup-%d", rand),
+		"vswitch_ids.#":           "2",
+		"multi_az_policy":         "COST_OPTIMIZED",
+		"on_demand_base_capacity": "0",
+		"on_demand_percentage_above_base_capacity":     "50",
+		"spot_instance_pools":                          "2",
+		"launch_template_id":                           CHECKSET,
+		"launch_template_version":                      "Default",
+		"launch_template_override.#":                   "1",
+		"launch_template_override.0.instance_type":     CHECKSET,
+		"launch_template_override.0.weighted_capacity": "1",
+	}
+
+	ra := resourceAttrInit(resourceId, basicMap)
+	rc := resourceCheckInit(resourceId, &v, func() interface{} {
+		return &EssService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	})
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEssScalingGroupLaunchTemplate(EcsInstanceCommonTestCase, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEssScalingGroupLaunchTemplateOverride(EcsInstanceCommonTestCase, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"launch_template_version":                      "Latest",
+						"launch_template_override.#":                   "2",
+						"launch_template_override.1.instance_type":     CHECKSET,
+						"launch_template_override.1.weighted_capacity": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccEssScalingGroupLaunchTemplateOverrideRemoved(EcsInstanceCommonTestCase, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"launch_template_override.#":                   "0",
+						"launch_template_override.0.instance_type":     REMOVEKEY,
+						"launch_template_override.0.weighted_capacity": REMOVEKEY,
+						"launch_template_override.1.instance_type":     REMOVEKEY,
+						"launch_template_override.1.weighted_capacity": REMOVEKEY,
+					}),
+				),
+			},
+		},
+	})
+
+}
 func TestAccAlicloudEssScalingGroup_vpc(t *testing.T) {
 	rand := acctest.RandIntRange(10000, 999999)
 	var v ess.ScalingGroup
@@ -1254,3 +1332,159 @@ func testAccEssScalingGroupSpotInstancePools(common string, rand int) string {
 		spot_instance_remedy = true
     }`, common, rand)
 }
+
+func testAccEssScalingGroupLaunchTemplate(common string, rand int) string {
+	return fmt.Sprintf(`
+    %s
+    variable "name" {
+        default = "tf-testAccEssScalingGroup-%d"
+    }
+
+    resource "alicloud_vswitch" "default2" {
+          vpc_id = "${alicloud_vpc.default.id}"
+          cidr_block = "172.16.1.0/24"
+          availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+          name = "${var.name}-bar"
+    }
+
+    resource "alicloud_launch_template" "default" {
+        name = "${var.name}"
+        image_id = "${data.alicloud_images.default.images.0.id}"
+        instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+        security_group_id = "${alicloud_security_group.default.id}"
+    }
+
+    resource "alicloud_ess_scaling_group" "default" {
+        min_size = 0
+        max_size = 2
+        scaling_group_name = "${var.name}"
+        vswitch_ids = ["${alicloud_vswitch.default.id}", "${alicloud_vswitch.default2.id}"]
+        multi_az_policy = "COST_OPTIMIZED"
+        on_demand_base_capacity = "0"
+        on_demand_percentage_above_base_capacity = "50"
+        spot_instance_pools = "2"
+        launch_template_id = "${alicloud_launch_template.default.id}"
+        launch_template_version = "Default"
+        launch_template_override {
+            instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+            weighted_capacity = 1
+        }
+    }`, common, rand)
+}
+
+func testAccEssScalingGroupLaunchTemplateOverride(common string, rand int) string {
+	return fmt.Sprintf(`
+    %s
+    variable "name" {
+        default = "tf-testAccEssScalingGroup-%d"
+    }
+
+    resource "alicloud_vswitch" "default2" {
+          vpc_id = "${alicloud_vpc.default.id}"
+          cidr_block = "172.16.1.0/24"
+          availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+          name = "${var.name}-bar"
+    }
+
+    resource "alicloud_launch_template" "default" {
+        name = "${var.name}"
+        image_id = "${data.alicloud_images.default.images.0.id}"
+        instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+        security_group_id = "${alicloud_security_group.default.id}"
+    }
+
+    resource "alicloud_ess_scaling_group" "default" {
+        min_size = 0
+        max_size = 2
+        scaling_group_name = "${var.name}"
+        vswitch_ids = ["${alicloud_vswitch.default.id}", "${alicloud_vswitch.default2.id}"]
+        multi_az_policy = "COST_OPTIMIZED"
+        on_demand_base_capacity = "0"
+        on_demand_percentage_above_base_capacity = "50"
+        spot_instance_pools = "2"
+        launch_template_id = "${alicloud_launch_template.default.id}"
+        launch_template_version = "Latest"
+        launch_template_override {
+            instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+            weighted_capacity = 1
+        }
+        launch_template_override {
+            instance_type = "${data.alicloud_instance_types.default.instance_types.1.id}"
+            weighted_capacity = 2
+        }
+    }`, common, rand)
+}
+
+func testAccEssScalingGroupLaunchTemplateOverrideRemoved(common string, rand int) string {
+	return fmt.Sprintf(`
+    %s
+    variable "name" {
+        default = "tf-testAccEssScalingGroup-%d"
+    }
+
+    resource "alicloud_vswitch" "default2" {
+          vpc_id = "${alicloud_vpc.default.id}"
+          cidr_block = "172.16.1.0/24"
+          availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+          name = "${var.name}-bar"
+    }
+
+    resource "alicloud_launch_template" "default" {
+        name = "${var.name}"
+        image_id = "${data.alicloud_images.default.images.0.id}"
+        instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+        security_group_id = "${alicloud_security_group.default.id}"
+    }
+
+    resource "alicloud_ess_scaling_group" "default" {
+        min_size = 0
+        max_size = 2
+        scaling_group_name = "${var.name}"
+        vswitch_ids = ["${alicloud_vswitch.default.id}", "${alicloud_vswitch.default2.id}"]
+        multi_az_policy = "COST_OPTIMIZED"
+        on_demand_base_capacity = "0"
+        on_demand_percentage_above_base_capacity = "50"
+        spot_instance_pools = "2"
+        launch_template_id = "${alicloud_launch_template.default.id}"
+        launch_template_version = "Latest"
+    }`, common, rand)
+}
+
+func TestDecodeEssLaunchTemplateOverrides(t *testing.T) {
+	content := []byte(`{"ScalingGroups":{"ScalingGroup":[{"ScalingGroupId":"asg-1","LaunchTemplateOverrides":{"LaunchTemplateOverride":[{"InstanceType":"ecs.g5.large","WeightedCapacity":2}]}}]}}`)
+	overrides, err := decodeEssLaunchTemplateOverrides(content, "asg-1")
+	if err != nil || len(overrides) != 1 || overrides[0].InstanceType != "ecs.g5.large" || overrides[0].WeightedCapacity != 2 {
+		t.Fatalf("unexpected overrides %#v, %v", overrides, err)
+	}
+	if _, err := decodeEssLaunchTemplateOverrides(content, "asg-2"); !NotFoundError(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestResourceAlicloudEssScalingGroupRemoveLaunchTemplate(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "asg-1",
+		Attributes: map[string]string{
+			"id":                 "asg-1",
+			"min_size":           "0",
+			"max_size":           "1",
+			"launch_template_id": "lt-1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"min_size": 0,
+		"max_size": 1,
+	})
+	if _, err := resourceAlicloudEssScalingGroup().Diff(state, config, &connectivity.AliyunClient{}); err == nil || !strings.Contains(err.Error(), "can not be removed") {
+		t.Fatalf("expected removing launch_template_id to be rejected, got %v", err)
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"min_size":           0,
+		"max_size":           1,
+		"launch_template_id": "lt-2",
+	})
+	if _, err := resourceAlicloudEssScalingGroup().Diff(state, config, &connectivity.AliyunClient{}); err != nil {
+		t.Fatalf("expected changing launch_template_id to be allowed, got %v", err)
+	}
+}
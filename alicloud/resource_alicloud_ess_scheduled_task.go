@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,6 +19,10 @@ func resourceAlicloudEssScheduledTask() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scheduled_action": {
 				Type:     schema.TypeString,
@@ -181,7 +187,7 @@ func resourceAliyunEssScheduledTaskDelete(d *schema.ResourceData, meta interface
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(essService.WaitForEssScheduledTask(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssScheduledTask(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func buildAlicloudEssScheduledTaskArgs(d *schema.ResourceData) *ess.CreateScheduledTaskRequest {
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ess_scheduled_task", &resource.Sweeper{
+	addTestSweepers("alicloud_ess_scheduled_task", &resource.Sweeper{
 		Name: "alicloud_ess_scheduled_task",
 		F:    testSweepEssSchedules,
 	})
@@ -75,6 +75,7 @@ func testSweepEssSchedules(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ess_scheduled_task", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Scheduled Task: %s (%s)", name, id)
 			continue
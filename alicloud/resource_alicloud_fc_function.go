@@ -23,6 +23,10 @@ func resourceAlicloudFCFunction() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"service": {
 				Type:     schema.TypeString,
@@ -302,7 +306,7 @@ func resourceAlicloudFCFunctionDelete(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteFunction", FcGoSdk)
 	}
 	addDebug("DeleteFunction", raw, requestInfo, request)
-	return WrapError(fcService.WaitForFcFunction(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(fcService.WaitForFcFunction(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func getFunctionCode(d *schema.ResourceData) (*fc.Code, error) {
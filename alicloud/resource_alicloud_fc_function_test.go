@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers(
+	addTestSweepers(
 		"alicloud_fc_function",
 		&resource.Sweeper{
 			Name: "alicloud_fc_function",
@@ -71,6 +71,7 @@ func testSweepFcFunction(region string) error {
 						break
 					}
 				}
+				skip = sweepSkip("alicloud_fc_function", skip, v)
 				if skip {
 					continue
 				}
@@ -22,6 +22,10 @@ func resourceAlicloudFCService() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -286,7 +290,7 @@ func resourceAlicloudFCServiceDelete(d *schema.ResourceData, meta interface{}) e
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteService", FcGoSdk)
 	}
 	addDebug("DeleteService", raw, requestInfo, request)
-	return WrapError(fcService.WaitForFcService(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(fcService.WaitForFcService(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 
 }
 
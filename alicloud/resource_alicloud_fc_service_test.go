@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_fc_service", &resource.Sweeper{
+	addTestSweepers("alicloud_fc_service", &resource.Sweeper{
 		Name: "alicloud_fc_service",
 		F:    testSweepFCServices,
 		Dependencies: []string{
@@ -57,6 +57,7 @@ func testSweepFCServices(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_fc_service", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping FC services: %s (%s)", name, id)
 			continue
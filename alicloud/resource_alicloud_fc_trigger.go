@@ -21,6 +21,10 @@ func resourceAlicloudFCTrigger() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"service": {
 				Type:     schema.TypeString,
@@ -288,5 +292,5 @@ func resourceAlicloudFCTriggerDelete(d *schema.ResourceData, meta interface{}) e
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteTrigger", FcGoSdk)
 	}
 	addDebug("DeleteTrigger", raw, requestInfo, request)
-	return WrapError(fcService.WaitForFcTrigger(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(fcService.WaitForFcTrigger(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers(
+	addTestSweepers(
 		"alicloud_fc_trigger",
 		&resource.Sweeper{
 			Name: "alicloud_fc_trigger",
@@ -82,7 +82,7 @@ func testSweepFcTrigger(region string) error {
 					for _, trigger := range response.Triggers {
 
 						for _, prefix := range prefixes {
-							if strings.HasPrefix(strings.ToLower(*trigger.TriggerName), strings.ToLower(prefix)) {
+							if strings.HasPrefix(strings.ToLower(*trigger.TriggerName), strings.ToLower(prefix)) && !sweepSkip("alicloud_fc_trigger", false, trigger) {
 								_, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
 									return fcClient.DeleteTrigger(&fc.DeleteTriggerInput{
 										ServiceName:  StringPointer(serviceName),
@@ -16,6 +16,15 @@ func resourceAliyunForwardEntry() *schema.Resource {
 		Read:   resourceAliyunForwardEntryRead,
 		Update: resourceAliyunForwardEntryUpdate,
 		Delete: resourceAliyunForwardEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": {
@@ -93,7 +102,7 @@ func resourceAliyunForwardEntryCreate(d *schema.ResourceData, meta interface{})
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*vpc.CreateForwardEntryResponse)
 	d.SetId(request.ForwardTableId + COLON_SEPARATED + response.ForwardEntryId)
-	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+	if err := vpcService.WaitForForwardEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunForwardEntryRead(d, meta)
@@ -173,7 +182,7 @@ func resourceAliyunForwardEntryUpdate(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForForwardEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunForwardEntryRead(d, meta)
@@ -213,5 +222,5 @@ func resourceAliyunForwardEntryDelete(d *schema.ResourceData, meta interface{})
 		}
 		WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForForwardEntry(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForForwardEntry(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -217,5 +217,5 @@ func resourceAlicloudGpdbConnectionDelete(d *schema.ResourceData, meta interface
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return WrapError(gpdbService.WaitForGpdbConnection(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(gpdbService.WaitForGpdbConnection(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -25,6 +25,8 @@ func resourceAlicloudGpdbInstance() *schema.Resource {
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffAll(productAvailableCustomizeDiff(connectivity.GPDBCode), tagsAllCustomizeDiff, tagApiTagsCustomizeDiff),
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -77,7 +79,8 @@ func resourceAlicloudGpdbInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -112,7 +115,9 @@ func resourceAlicloudGpdbInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("security_ip_list", security_ips)
 	d.Set("create_time", instance.CreationTime)
 	d.Set("instance_charge_type", instance.PayType)
-	d.Set("tags", gpdbService.tagsToMap(instance.Tags.Tag))
+	if err := setTagsAndTagsAll(client, d, gpdbService.tagsToMap(instance.Tags.Tag)); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
@@ -190,7 +195,8 @@ func resourceAlicloudGpdbInstanceUpdate(d *schema.ResourceData, meta interface{}
 		d.SetPartial("security_ip_list")
 	}
 
-	if err := gpdbService.setInstanceTags(d); err != nil {
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, connectivity.GPDBCode, TagResourceInstance); err != nil {
 		return WrapError(err)
 	}
 
@@ -17,7 +17,7 @@ import (
 // Create by yewei.oyyw@alibaba-inc.com on 2019-05-31
 
 func init() {
-	resource.AddTestSweepers("alicloud_gpdb_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_gpdb_instance", &resource.Sweeper{
 		Name: "alicloud_gpdb_instance",
 		F:    testSweepGpdbInstances,
 	})
@@ -83,6 +83,7 @@ func testSweepGpdbInstances(region string) error {
 				skip = !need
 			}
 		}
+		skip = sweepSkip("alicloud_gpdb_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping GPDB instance: %s (%s)\n", description, id)
 			continue
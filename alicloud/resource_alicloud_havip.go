@@ -20,6 +20,11 @@ func resourceAliyunHaVip() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(2 * DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -63,7 +68,7 @@ func resourceAliyunHaVipCreate(d *schema.ResourceData, meta interface{}) error {
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	havip, _ := raw.(*vpc.CreateHaVipResponse)
 	d.SetId(havip.HaVipId)
-	if err := haVipService.WaitForHaVip(havip.HaVipId, Available, 2*DefaultTimeout); err != nil {
+	if err := haVipService.WaitForHaVip(havip.HaVipId, Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return fmt.Errorf("WaitHaVip %s got error: %#v, %s", Available, err, havip.HaVipId)
 	}
 	return resourceAliyunHaVipRead(d, meta)
@@ -112,7 +117,7 @@ func resourceAliyunHaVipDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	haVipService := HaVipService{client}
 
-	if err := haVipService.WaitForHaVip(d.Id(), Available, 2*DefaultTimeout); err != nil {
+	if err := haVipService.WaitForHaVip(d.Id(), Available, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
 		return fmt.Errorf("WaitHaVip %s got error: %#v, %s", Available, err, d.Id())
 	}
 	request := vpc.CreateDeleteHaVipRequest()
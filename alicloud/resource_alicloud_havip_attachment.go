@@ -18,6 +18,10 @@ func resourceAliyunHaVipAttachment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"havip_id": {
 				Type:     schema.TypeString,
@@ -59,7 +63,7 @@ func resourceAliyunHaVipAttachmentCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 	//check the havip attachment
-	if err := haVipService.WaitForHaVipAttachment(request.HaVipId, request.InstanceId, 5*DefaultTimeout); err != nil {
+	if err := haVipService.WaitForHaVipAttachment(request.HaVipId, request.InstanceId, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return fmt.Errorf("Wait for havip attachment got error: %#v", err)
 	}
 
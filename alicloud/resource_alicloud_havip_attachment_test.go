@@ -12,7 +12,7 @@ import (
 
 // At present, only white list users can operate HaVip Resource. So close havip sweeper.
 //func init() {
-//	resource.AddTestSweepers("alicloud_havip_attachment", &resource.Sweeper{
+//	addTestSweepers("alicloud_havip_attachment", &resource.Sweeper{
 //		Name: "alicloud_havip_attachment",
 //		F:    testSweepHaVipAttachment,
 //	})
@@ -0,0 +1,254 @@
+package alicloud
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunImageCreate,
+		Read:   resourceAliyunImageRead,
+		Update: resourceAliyunImageUpdate,
+		Delete: resourceAliyunImageDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id", "disk_device_mapping"},
+			},
+			"snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"instance_id", "disk_device_mapping"},
+			},
+			"disk_device_mapping": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"instance_id", "snapshot_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+							Computed: true,
+						},
+						"device": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Computed: true,
+						},
+						"disk_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Computed:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"system", "data"}),
+						},
+					},
+				},
+			},
+			"image_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStringLengthInRange(2, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 256),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"x86_64", "i386"}),
+			},
+			"image_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+			"os_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunImageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateCreateImageRequest()
+	request.RegionId = client.RegionId
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.InstanceId = d.Get("instance_id").(string)
+	request.SnapshotId = d.Get("snapshot_id").(string)
+	request.ImageName = d.Get("image_name").(string)
+	request.Description = d.Get("description").(string)
+	request.Platform = d.Get("platform").(string)
+	request.Architecture = d.Get("architecture").(string)
+	request.ImageVersion = d.Get("image_version").(string)
+	request.ResourceGroupId = d.Get("resource_group_id").(string)
+	if v, ok := d.GetOk("disk_device_mapping"); ok {
+		var mappings []ecs.CreateImageDiskDeviceMapping
+		for _, m := range v.([]interface{}) {
+			mapping := m.(map[string]interface{})
+			size := ""
+			if mapping["size"].(int) > 0 {
+				size = strconv.Itoa(mapping["size"].(int))
+			}
+			mappings = append(mappings, ecs.CreateImageDiskDeviceMapping{
+				SnapshotId: mapping["snapshot_id"].(string),
+				Size:       size,
+				Device:     mapping["device"].(string),
+				DiskType:   mapping["disk_type"].(string),
+			})
+		}
+		request.DiskDeviceMapping = &mappings
+	}
+	var tags []ecs.CreateImageTag
+	for key, value := range d.Get("tags_all").(map[string]interface{}) {
+		tags = append(tags, ecs.CreateImageTag{Key: key, Value: value.(string)})
+	}
+	if len(tags) > 0 {
+		request.Tag = &tags
+	}
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.CreateImage(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_image", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.CreateImageResponse)
+	d.SetId(response.ImageId)
+
+	if err := ecsService.WaitForImage(d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAliyunImageRead(d, meta)
+}
+
+func resourceAliyunImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	image, err := ecsService.DescribeImage(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("image_name", image.ImageName)
+	d.Set("description", image.Description)
+	d.Set("platform", image.Platform)
+	d.Set("architecture", image.Architecture)
+	d.Set("image_version", image.ImageVersion)
+	d.Set("resource_group_id", image.ResourceGroupId)
+	d.Set("os_name", image.OSName)
+	d.Set("size", image.Size)
+	if err := d.Set("disk_device_mapping", imageDiskDeviceMappingsToList(image.DiskDeviceMappings.DiskDeviceMapping)); err != nil {
+		return WrapError(err)
+	}
+
+	tags, err := ecsService.DescribeTags(d.Id(), TagResourceImage)
+	if err != nil && !NotFoundError(err) {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAliyunImageUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	if err := ecsService.updateImage(d); err != nil {
+		return WrapError(err)
+	}
+	return resourceAliyunImageRead(d, meta)
+}
+
+func resourceAliyunImageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	return WrapError(ecsService.deleteImage(d))
+}
+
+func imageDiskDeviceMappingsToList(mappings []ecs.DiskDeviceMapping) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, mapping := range mappings {
+		size, _ := strconv.Atoi(mapping.Size)
+		result = append(result, map[string]interface{}{
+			"snapshot_id": mapping.SnapshotId,
+			"size":        size,
+			"device":      mapping.Device,
+			"disk_type":   mapping.Type,
+		})
+	}
+	return result
+}
@@ -0,0 +1,175 @@
+package alicloud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunImageCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunImageCopyCreate,
+		Read:   resourceAliyunImageCopyRead,
+		Update: resourceAliyunImageCopyUpdate,
+		Delete: resourceAliyunImageCopyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunImageCopyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"source_image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"image_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStringLengthInRange(2, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 256),
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceAliyunImageCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	sourceRegionId := client.RegionId
+	if v, ok := d.GetOk("source_region_id"); ok && v.(string) != "" {
+		sourceRegionId = v.(string)
+	}
+
+	// CopyImage is called in the region of the source image, the image is copied to the region of the provider.
+	request := ecs.CreateCopyImageRequest()
+	request.RegionId = sourceRegionId
+	request.ImageId = d.Get("source_image_id").(string)
+	request.DestinationRegionId = client.RegionId
+	request.DestinationImageName = d.Get("image_name").(string)
+	request.DestinationDescription = d.Get("description").(string)
+	request.Encrypted = requests.NewBoolean(d.Get("encrypted").(bool))
+	request.KMSKeyId = d.Get("kms_key_id").(string)
+	var tags []ecs.CopyImageTag
+	for key, value := range d.Get("tags_all").(map[string]interface{}) {
+		tags = append(tags, ecs.CopyImageTag{Key: key, Value: value.(string)})
+	}
+	if len(tags) > 0 {
+		request.Tag = &tags
+	}
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.CopyImage(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_image_copy", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.CopyImageResponse)
+	d.SetId(response.ImageId)
+	d.Set("source_region_id", sourceRegionId)
+
+	if err := ecsService.WaitForImage(d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAliyunImageCopyRead(d, meta)
+}
+
+func resourceAliyunImageCopyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	image, err := ecsService.DescribeImage(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("image_name", image.ImageName)
+	d.Set("description", image.Description)
+
+	tags, err := ecsService.DescribeTags(d.Id(), TagResourceImage)
+	if err != nil && !NotFoundError(err) {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAliyunImageCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	if err := ecsService.updateImage(d); err != nil {
+		return WrapError(err)
+	}
+	return resourceAliyunImageCopyRead(d, meta)
+}
+
+// resourceAliyunImageCopyImport reads the source image from the id formatted as <source_region_id>:<source_image_id>:<image_id>,
+// because the copied image does not record where it is copied from.
+func resourceAliyunImageCopyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), COLON_SEPARATED) {
+		parts, err := ParseResourceId(d.Id(), 3)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		d.Set("source_region_id", parts[0])
+		d.Set("source_image_id", parts[1])
+		d.SetId(parts[2])
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAliyunImageCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	return WrapError(ecsService.deleteImage(d))
+}
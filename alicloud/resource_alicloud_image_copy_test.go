@@ -0,0 +1,111 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudImageCopyBasic(t *testing.T) {
+	var v ecs.Image
+	resourceId := "alicloud_image_copy.default"
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccImageCopyBasic%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"source_image_id":  CHECKSET,
+		"source_region_id": CHECKSET,
+		"image_name":       name,
+		"description":      name,
+		"encrypted":        "false",
+		"tags.%":           "1",
+		"tags.version":     "1.0",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeImage")
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceImageCopyConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"source_image_id": "${alicloud_image.default.id}",
+					"image_name":      "${var.name}",
+					"description":     "${var.name}",
+					"tags": map[string]string{
+						"version": "1.0",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName: resourceId,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceId]
+					return strings.Join([]string{rs.Primary.Attributes["source_region_id"], rs.Primary.Attributes["source_image_id"], rs.Primary.ID}, COLON_SEPARATED), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encrypted", "kms_key_id", "force"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"image_name":  "${var.name}-update",
+					"description": "${var.name}-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"image_name":  name + "-update",
+						"description": name + "-update",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceImageCopyConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+variable "name" {
+  default = "%s"
+}
+
+resource "alicloud_instance" "default" {
+  vswitch_id = "${alicloud_vswitch.default.id}"
+  image_id = "${data.alicloud_images.default.images.0.id}"
+  instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  system_disk_category = "cloud_efficiency"
+  security_groups = ["${alicloud_security_group.default.id}"]
+  instance_name = "${var.name}"
+}
+
+resource "alicloud_image" "default" {
+  instance_id = "${alicloud_instance.default.id}"
+  image_name  = "${var.name}-source"
+}
+`, EcsInstanceCommonTestCase, name)
+}
@@ -0,0 +1,110 @@
+package alicloud
+
+import (
+	"log"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunImageExport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunImageExportCreate,
+		Read:   resourceAliyunImageExportRead,
+		Delete: resourceAliyunImageExportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"oss_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"oss_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"image_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "raw",
+				ValidateFunc: validateAllowedStringValue([]string{"raw", "vhd", "qcow2", "vmdk", "vdi"}),
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"task_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunImageExportCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateExportImageRequest()
+	request.RegionId = client.RegionId
+	request.ImageId = d.Get("image_id").(string)
+	request.OSSBucket = d.Get("oss_bucket").(string)
+	request.OSSPrefix = d.Get("oss_prefix").(string)
+	request.ImageFormat = d.Get("image_format").(string)
+	request.RoleName = d.Get("role_name").(string)
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ExportImage(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, request.ImageId, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.ExportImageResponse)
+	d.SetId(response.TaskId)
+
+	stateConf := BuildStateConf([]string{string(TaskWaiting), string(TaskProcessing)}, []string{string(TaskFinished)}, d.Timeout(schema.TimeoutCreate), 5*time.Second,
+		ecsService.TaskStateRefreshFunc(d.Id(), []string{string(TaskFailed), string(TaskDeleted)}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAliyunImageExportRead(d, meta)
+}
+
+func resourceAliyunImageExportRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	// The exported file is kept in the bucket after the image is deleted, so the export is gone with its task only.
+	task, err := ecsService.DescribeTask(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[WARN] The task %s exporting the image %s is not found, it has expired.", d.Id(), d.Get("image_id"))
+			return nil
+		}
+		return WrapError(err)
+	}
+	d.Set("task_status", task.TaskStatus)
+	return nil
+}
+
+func resourceAliyunImageExportDelete(d *schema.ResourceData, meta interface{}) error {
+	// The exported file is not deleted from the bucket, it can be managed by the alicloud_oss_bucket_object.
+	log.Printf("[WARN] Deleting the export %s only removes it from the state, the exported file is kept in the bucket %s.", d.Id(), d.Get("oss_bucket"))
+	return nil
+}
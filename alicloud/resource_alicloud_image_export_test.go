@@ -0,0 +1,72 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAlicloudImageExportBasic(t *testing.T) {
+	resourceId := "alicloud_image_export.default"
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testacc-image-export%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceImageExportConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"image_id":     "${alicloud_image.default.id}",
+					"oss_bucket":   "${alicloud_oss_bucket.default.id}",
+					"oss_prefix":   "export",
+					"image_format": "qcow2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceId, "image_id"),
+					resource.TestCheckResourceAttr(resourceId, "oss_bucket", name),
+					resource.TestCheckResourceAttr(resourceId, "oss_prefix", "export"),
+					resource.TestCheckResourceAttr(resourceId, "image_format", "qcow2"),
+					resource.TestCheckResourceAttr(resourceId, "task_status", string(TaskFinished)),
+				),
+			},
+		},
+	})
+}
+
+func resourceImageExportConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+variable "name" {
+  default = "%s"
+}
+
+resource "alicloud_instance" "default" {
+  vswitch_id = "${alicloud_vswitch.default.id}"
+  image_id = "${data.alicloud_images.default.images.0.id}"
+  instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  system_disk_category = "cloud_efficiency"
+  security_groups = ["${alicloud_security_group.default.id}"]
+  instance_name = "${var.name}"
+}
+
+resource "alicloud_image" "default" {
+  instance_id = "${alicloud_instance.default.id}"
+  image_name  = "tf-testAccImageExport${replace(var.name, "tf-testacc-image-export", "")}"
+}
+
+resource "alicloud_oss_bucket" "default" {
+  bucket        = "${var.name}"
+  force_destroy = true
+}
+`, EcsInstanceCommonTestCase, name)
+}
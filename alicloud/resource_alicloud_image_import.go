@@ -0,0 +1,232 @@
+package alicloud
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunImageImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunImageImportCreate,
+		Read:   resourceAliyunImageImportRead,
+		Update: resourceAliyunImageImportUpdate,
+		Delete: resourceAliyunImageImportDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"disk_device_mapping": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 17,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oss_bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"oss_object": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Computed:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"RAW", "VHD", "qcow2"}),
+						},
+						"device": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Computed: true,
+						},
+						"disk_image_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Computed:     true,
+							ValidateFunc: validateIntegerInRange(5, 2000),
+						},
+					},
+				},
+			},
+			"image_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStringLengthInRange(2, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 256),
+			},
+			"architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "x86_64",
+				ValidateFunc: validateAllowedStringValue([]string{"x86_64", "i386"}),
+			},
+			"os_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "linux",
+				ValidateFunc: validateAllowedStringValue([]string{"linux", "windows"}),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"license_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Auto",
+				ValidateFunc: validateAllowedStringValue([]string{"Auto", "Aliyun", "BYOL"}),
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceAliyunImageImportCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateImportImageRequest()
+	request.RegionId = client.RegionId
+	request.ImageName = d.Get("image_name").(string)
+	request.Description = d.Get("description").(string)
+	request.Architecture = d.Get("architecture").(string)
+	request.OSType = d.Get("os_type").(string)
+	request.Platform = d.Get("platform").(string)
+	request.LicenseType = d.Get("license_type").(string)
+	request.RoleName = d.Get("role_name").(string)
+	var mappings []ecs.ImportImageDiskDeviceMapping
+	for _, m := range d.Get("disk_device_mapping").([]interface{}) {
+		mapping := m.(map[string]interface{})
+		size := ""
+		if mapping["disk_image_size"].(int) > 0 {
+			size = strconv.Itoa(mapping["disk_image_size"].(int))
+		}
+		mappings = append(mappings, ecs.ImportImageDiskDeviceMapping{
+			OSSBucket:     mapping["oss_bucket"].(string),
+			OSSObject:     mapping["oss_object"].(string),
+			Format:        mapping["format"].(string),
+			Device:        mapping["device"].(string),
+			DiskImageSize: size,
+		})
+	}
+	request.DiskDeviceMapping = &mappings
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ImportImage(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_image_import", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.ImportImageResponse)
+	d.SetId(response.ImageId)
+
+	if err := ecsService.WaitForImage(d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapError(err)
+	}
+
+	// ImportImage can not tag the image, the tags are set after it is imported.
+	return resourceAliyunImageImportUpdate(d, meta)
+}
+
+func resourceAliyunImageImportRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	image, err := ecsService.DescribeImage(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("image_name", image.ImageName)
+	d.Set("description", image.Description)
+	d.Set("architecture", image.Architecture)
+	d.Set("os_type", image.OSType)
+	d.Set("platform", image.Platform)
+
+	var mappings []map[string]interface{}
+	for _, mapping := range image.DiskDeviceMappings.DiskDeviceMapping {
+		size, _ := strconv.Atoi(mapping.Size)
+		mappings = append(mappings, map[string]interface{}{
+			"oss_bucket":      mapping.ImportOSSBucket,
+			"oss_object":      mapping.ImportOSSObject,
+			"format":          mapping.Format,
+			"device":          mapping.Device,
+			"disk_image_size": size,
+		})
+	}
+	if err := d.Set("disk_device_mapping", mappings); err != nil {
+		return WrapError(err)
+	}
+
+	tags, err := ecsService.DescribeTags(d.Id(), TagResourceImage)
+	if err != nil && !NotFoundError(err) {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAliyunImageImportUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	if err := ecsService.updateImage(d); err != nil {
+		return WrapError(err)
+	}
+	return resourceAliyunImageImportRead(d, meta)
+}
+
+func resourceAliyunImageImportDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	return WrapError(ecsService.deleteImage(d))
+}
@@ -0,0 +1,87 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunImageSharePermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunImageSharePermissionCreate,
+		Read:   resourceAliyunImageSharePermissionRead,
+		Delete: resourceAliyunImageSharePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunImageSharePermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	imageId := d.Get("image_id").(string)
+	accountId := d.Get("account_id").(string)
+	if err := ecsService.ModifyImageSharePermission(imageId, []string{accountId}, nil); err != nil {
+		return WrapError(err)
+	}
+	d.SetId(imageId + COLON_SEPARATED + accountId)
+
+	// The share permission takes a while to be described.
+	time.Sleep(3 * time.Second)
+	return resourceAliyunImageSharePermissionRead(d, meta)
+}
+
+func resourceAliyunImageSharePermissionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	account, err := ecsService.DescribeImageSharePermission(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("image_id", parts[0])
+	d.Set("account_id", account.AliyunId)
+	return nil
+}
+
+func resourceAliyunImageSharePermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	if err := ecsService.ModifyImageSharePermission(parts[0], nil, []string{parts[1]}); err != nil {
+		if IsExceptedError(err, ImageNotFound) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	return nil
+}
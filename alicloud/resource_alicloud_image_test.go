@@ -0,0 +1,281 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func init() {
+	addTestSweepers("alicloud_image", &resource.Sweeper{
+		Name: "alicloud_image",
+		F:    testSweepImages,
+	})
+}
+
+func testSweepImages(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return WrapError(err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	var statuses []string
+	for _, status := range ImageStatuses {
+		statuses = append(statuses, string(status))
+	}
+	var images []ecs.Image
+	request := ecs.CreateDescribeImagesRequest()
+	request.RegionId = client.RegionId
+	request.ImageOwnerAlias = "self"
+	request.Status = strings.Join(statuses, ",")
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeImages(request)
+		})
+		if err != nil {
+			return WrapError(err)
+		}
+		response, _ := raw.(*ecs.DescribeImagesResponse)
+		if len(response.Images.Image) < 1 {
+			break
+		}
+		images = append(images, response.Images.Image...)
+
+		if len(response.Images.Image) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return err
+		} else {
+			request.PageNumber = page
+		}
+	}
+
+	sweeped := false
+	for _, v := range images {
+		name := v.ImageName
+		id := v.ImageId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		skip = sweepSkip("alicloud_image", skip, v)
+		if skip {
+			log.Printf("[INFO] Skipping image: %s (%s)", name, id)
+			continue
+		}
+		sweeped = true
+		log.Printf("[INFO] Deleting image: %s (%s)", name, id)
+		req := ecs.CreateDeleteImageRequest()
+		req.RegionId = client.RegionId
+		req.ImageId = id
+		req.Force = requests.NewBoolean(true)
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DeleteImage(req)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete image(%s (%s)): %s", name, id, err)
+		}
+	}
+
+	if sweeped {
+		time.Sleep(5 * time.Second)
+	}
+	return nil
+}
+
+func TestAccAlicloudImageBasic(t *testing.T) {
+	var v ecs.Image
+	resourceId := "alicloud_image.default"
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccImageBasic%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"instance_id":  CHECKSET,
+		"image_name":   name,
+		"description":  name,
+		"architecture": "x86_64",
+		"os_name":      CHECKSET,
+		"tags.%":       "1",
+		"tags.version": "1.0",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceImageConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_id": "${alicloud_instance.default.id}",
+					"image_name":  "${var.name}",
+					"description": "${var.name}",
+					"tags": map[string]string{
+						"version": "1.0",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_id", "force"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"image_name": "${var.name}-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"image_name": name + "-update",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"description": "${var.name}-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"description": name + "-update",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"tags": map[string]string{
+						"version": "1.0",
+						"Tag2":    "Tag2",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tags.%":    "2",
+						"tags.Tag2": "Tag2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"force": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"force": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAlicloudImageSnapshot(t *testing.T) {
+	var v ecs.Image
+	resourceId := "alicloud_image.default"
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testAccImageSnapshot%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"snapshot_id":           CHECKSET,
+		"image_name":            name,
+		"disk_device_mapping.#": "1",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceImageConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"snapshot_id": "${alicloud_snapshot.default.id}",
+					"image_name":  "${var.name}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+		},
+	})
+}
+
+func resourceImageConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+variable "name" {
+  default = "%s"
+}
+
+resource "alicloud_instance" "default" {
+  vswitch_id = "${alicloud_vswitch.default.id}"
+  image_id = "${data.alicloud_images.default.images.0.id}"
+  instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  system_disk_category = "cloud_efficiency"
+  security_groups = ["${alicloud_security_group.default.id}"]
+  instance_name = "${var.name}"
+}
+
+data "alicloud_disks" "default" {
+  instance_id = "${alicloud_instance.default.id}"
+  type        = "system"
+}
+
+resource "alicloud_snapshot" "default" {
+  disk_id = "${data.alicloud_disks.default.disks.0.id}"
+  name    = "${var.name}"
+}
+`, EcsInstanceCommonTestCase, name)
+}
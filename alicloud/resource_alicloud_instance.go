@@ -32,6 +32,8 @@ func resourceAliyunInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffAll(tagsAllCustomizeDiff, ecsInstanceDeploymentCustomizeDiff),
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -42,12 +44,14 @@ func resourceAliyunInstance() *schema.Resource {
 
 			"image_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"instance_type": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validateInstanceType,
 			},
 
@@ -64,7 +68,8 @@ func resourceAliyunInstance() *schema.Resource {
 			"security_groups": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"allocate_public_ip": {
@@ -342,23 +347,100 @@ func resourceAliyunInstance() *schema.Resource {
 				}),
 			},
 
+			"launch_template_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_template_name"},
+			},
+
+			"launch_template_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_template_id"},
+			},
+
+			"launch_template_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 65535),
+			},
+
+			"dedicated_host_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"deployment_set_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"hpc_cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"tags":        tagsSchema(),
+			"tags_all":    tagsAllSchema(),
 			"volume_tags": tagsSchemaComputed(),
 		},
 	}
 }
 
+// checkInstanceLaunchTemplate requires the image, the instance type and the security groups unless the instance is
+// created from a launch template, which provides them. They are computed, so they can not be checked at plan time.
+func checkInstanceLaunchTemplate(d *schema.ResourceData) error {
+	if d.Get("launch_template_id").(string) != "" || d.Get("launch_template_name").(string) != "" {
+		return nil
+	}
+	var missing []string
+	for _, key := range []string{"image_id", "instance_type", "security_groups"} {
+		if _, ok := d.GetOk(key); !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return WrapError(fmt.Errorf("%s is required when neither launch_template_id nor launch_template_name is set", strings.Join(missing, ", ")))
+	}
+	return nil
+}
+
+// ecsInstanceDeploymentCustomizeDiff rejects clearing the dedicated host or the deployment set of an existing instance,
+// because ModifyInstanceDeployment can only move the instance to another one.
+func ecsInstanceDeploymentCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	for _, key := range []string{"dedicated_host_id", "deployment_set_id"} {
+		if d.HasChange(key) && d.NewValueKnown(key) && d.Get(key).(string) == "" {
+			return WrapError(fmt.Errorf("%s of the instance %s can not be cleared, it can only be changed to another one", key, d.Id()))
+		}
+	}
+	return nil
+}
+
 func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	ecsService := EcsService{client}
 
-	// Ensure instance_type is valid
-	zoneId, validZones, err := ecsService.DescribeAvailableResources(d, meta, InstanceTypeResource)
-	if err != nil {
+	if err := checkInstanceLaunchTemplate(d); err != nil {
 		return WrapError(err)
 	}
-	if err := ecsService.InstanceTypeValidation(d.Get("instance_type").(string), zoneId, validZones); err != nil {
-		return WrapError(err)
+
+	// Ensure instance_type is valid, it is validated by RunInstances when it is read from the launch template
+	if instanceType := d.Get("instance_type").(string); instanceType != "" {
+		zoneId, validZones, err := ecsService.DescribeAvailableResources(d, meta, InstanceTypeResource)
+		if err != nil {
+			return WrapError(err)
+		}
+		if err := ecsService.InstanceTypeValidation(instanceType, zoneId, validZones); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	request, err := buildAliyunInstanceArgs(d, meta)
@@ -429,6 +511,9 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("host_name", instance.HostName)
 	d.Set("image_id", instance.ImageId)
 	d.Set("instance_type", instance.InstanceType)
+	if err := setInstanceLaunchTemplate(d, ecsService); err != nil {
+		return WrapError(err)
+	}
 	d.Set("system_disk_category", disk.Category)
 	d.Set("system_disk_size", disk.Size)
 	d.Set("password", d.Get("password").(string))
@@ -441,6 +526,9 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("internet_charge_type", instance.InternetChargeType)
 	d.Set("deletion_protection", instance.DeletionProtection)
 	d.Set("credit_specification", instance.CreditSpecification)
+	d.Set("dedicated_host_id", instance.DedicatedHostAttribute.DedicatedHostId)
+	d.Set("deployment_set_id", instance.DeploymentSetId)
+	d.Set("hpc_cluster_id", instance.HpcClusterId)
 
 	if len(instance.PublicIpAddress.IpAddress) > 0 {
 		d.Set("public_ip", instance.PublicIpAddress.IpAddress[0])
@@ -519,8 +607,8 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil && !NotFoundError(err) {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	ids, err := ecsService.QueryInstanceAllDisks(d.Id())
@@ -564,6 +652,7 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		return WrapError(err)
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if err := setVolumeTags(client, TagResourceDisk, d); err != nil {
@@ -617,7 +706,12 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return WrapError(err)
 	}
-	if imageUpdate || vpcUpdate || passwordUpdate || typeUpdate {
+
+	deploymentUpdate, err := modifyInstanceDeployment(d, meta, run)
+	if err != nil {
+		return WrapError(err)
+	}
+	if imageUpdate || vpcUpdate || passwordUpdate || typeUpdate || deploymentUpdate {
 		run = true
 		log.Printf("[INFO] Need rebooting to make all changes valid.")
 		instance, errDesc := ecsService.DescribeInstance(d.Id())
@@ -657,6 +751,10 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			return WrapError(err)
 		}
 
+		if _, err := modifyInstanceDeployment(d, meta, run); err != nil {
+			return WrapError(err)
+		}
+
 		log.Printf("[DEBUG] Start instance after changing image or password or vpc attribute")
 		startRequest := ecs.CreateStartInstanceRequest()
 		startRequest.InstanceId = d.Id()
@@ -710,23 +808,15 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 	// Only PrePaid instance can support modifying renewal attribute
 	if d.Get("instance_charge_type").(string) == string(PrePaid) &&
 		(d.HasChange("renewal_status") || d.HasChange("auto_renew_period")) {
-		status := d.Get("renewal_status").(string)
-		request := ecs.CreateModifyInstanceAutoRenewAttributeRequest()
-		request.InstanceId = d.Id()
-		request.RenewalStatus = status
-
-		if status == string(RenewAutoRenewal) {
-			request.PeriodUnit = d.Get("period_unit").(string)
-			request.Duration = requests.NewInteger(d.Get("auto_renew_period").(int))
+		bssOpenApiService := BssOpenApiService{client}
+		status := RenewalStatus(d.Get("renewal_status").(string))
+		// The renewal status Normal of ECS is the ManualRenewal of the BSS OpenAPI
+		if status == RenewNormal {
+			status = RenewManualRenewal
 		}
-
-		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-			return ecsClient.ModifyInstanceAutoRenewAttribute(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		if err := bssOpenApiService.SetRenewal(BssProductCodeEcs, "", d.Id(), status, d.Get("auto_renew_period").(int), RenewalPeriodUnitMonth); err != nil {
+			return WrapError(err)
 		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		d.SetPartial("renewal_status")
 		d.SetPartial("auto_renew_period")
 	}
@@ -783,6 +873,39 @@ func resourceAliyunInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// setInstanceLaunchTemplate reads the launch template recorded in the state, because the instance does not return
+// the template it is created from. The template may be deleted after the instance is created, and then it is kept.
+func setInstanceLaunchTemplate(d *schema.ResourceData, ecsService EcsService) error {
+	id := d.Get("launch_template_id").(string)
+	name := d.Get("launch_template_name").(string)
+	if id == "" && name == "" {
+		return nil
+	}
+	var template ecs.LaunchTemplateSet
+	var err error
+	if id != "" {
+		template, err = ecsService.DescribeLaunchTemplate(id)
+	} else {
+		template, err = ecsService.DescribeLaunchTemplateByName(name)
+	}
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	if id != "" {
+		d.Set("launch_template_id", template.LaunchTemplateId)
+	} else {
+		d.Set("launch_template_name", template.LaunchTemplateName)
+	}
+	// The instance is created from the default version when no version is specified.
+	if d.Get("launch_template_version").(int) == 0 {
+		d.Set("launch_template_version", int(template.DefaultVersionNumber))
+	}
+	return nil
+}
+
 func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.RunInstancesRequest, error) {
 	client := meta.(*connectivity.AliyunClient)
 	ecsService := EcsService{client}
@@ -927,6 +1050,27 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Run
 		value := v.(string)
 		request.SecurityEnhancementStrategy = value
 	}
+
+	// The arguments of the instance override the ones of the launch template, the default version is used if no version is specified.
+	if v := d.Get("launch_template_id").(string); v != "" {
+		request.LaunchTemplateId = v
+	}
+	if v := d.Get("launch_template_name").(string); v != "" {
+		request.LaunchTemplateName = v
+	}
+	if v, ok := d.GetOk("launch_template_version"); ok {
+		request.LaunchTemplateVersion = requests.NewInteger(v.(int))
+	}
+
+	if v := d.Get("dedicated_host_id").(string); v != "" {
+		request.DedicatedHostId = v
+	}
+	if v := d.Get("deployment_set_id").(string); v != "" {
+		request.DeploymentSetId = v
+	}
+	if v := d.Get("hpc_cluster_id").(string); v != "" {
+		request.HpcClusterId = v
+	}
 	request.DryRun = requests.NewBoolean(d.Get("dry_run").(bool))
 	request.DeletionProtection = requests.NewBoolean(d.Get("deletion_protection").(bool))
 	request.ClientToken = buildClientToken(request.GetActionName())
@@ -965,6 +1109,8 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Run
 	return request, nil
 }
 
+// modifyInstanceChargeType converts the charge type by the ECS API rather than the ConvertChargeType of the BSS OpenAPI,
+// which can neither convert the data disks together with the instance nor make a dry run.
 func modifyInstanceChargeType(d *schema.ResourceData, meta interface{}, forceDelete bool) error {
 	if d.IsNewResource() {
 		return nil
@@ -1053,7 +1199,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		// Ensure instance's image has been replaced successfully.
-		timeout := DefaultTimeoutMedium
+		timeout := int(d.Timeout(schema.TimeoutUpdate).Seconds())
 		for {
 			instance, errDesc := ecsService.DescribeInstance(d.Id())
 			if errDesc != nil {
@@ -1340,7 +1486,7 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 		}
 
 		// Ensure instance's type has been replaced successfully.
-		timeout := DefaultTimeoutMedium
+		timeout := int(d.Timeout(schema.TimeoutUpdate).Seconds())
 		for {
 			instance, err := ecsService.DescribeInstance(d.Id())
 
@@ -1364,6 +1510,58 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 	return update, nil
 }
 
+// modifyInstanceDeployment moves an existing instance to another dedicated host or deployment set instead of replacing it,
+// the instance has to be stopped before moving it.
+func modifyInstanceDeployment(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+	if d.IsNewResource() {
+		return false, nil
+	}
+	update := false
+	request := ecs.CreateModifyInstanceDeploymentRequest()
+	request.InstanceId = d.Id()
+	// Clearing them is rejected by ecsInstanceDeploymentCustomizeDiff.
+	if d.HasChange("dedicated_host_id") {
+		update = true
+		request.DedicatedHostId = d.Get("dedicated_host_id").(string)
+	}
+	if d.HasChange("deployment_set_id") {
+		update = true
+		request.DeploymentSetId = d.Get("deployment_set_id").(string)
+	}
+	if !update || !run {
+		return update, nil
+	}
+
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	instance, err := ecsService.DescribeInstance(d.Id())
+	if err != nil {
+		return update, WrapError(err)
+	}
+	if instance.Status != string(Stopped) {
+		return update, WrapError(Error("The instance %s must be stopped before moving it to another dedicated host or deployment set, but it is %s.", d.Id(), instance.Status))
+	}
+
+	request.RegionId = client.RegionId
+	request.Force = requests.NewBoolean(false)
+	var raw interface{}
+	invoker := NewProductInvoker(client, connectivity.ECSCode)
+	err = invoker.Run(func() error {
+		resp, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyInstanceDeployment(request)
+		})
+		raw = resp
+		return err
+	})
+	if err != nil {
+		return update, WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	d.SetPartial("dedicated_host_id")
+	d.SetPartial("deployment_set_id")
+	return update, nil
+}
+
 func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	if d.IsNewResource() {
 		return nil
@@ -1421,7 +1619,7 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 		}
 		ecsService := EcsService{client: client}
 
-		deadline := time.Now().Add(DefaultTimeout * time.Second)
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
 		for {
 			instance, err := ecsService.DescribeInstance(d.Id())
 			if err != nil {
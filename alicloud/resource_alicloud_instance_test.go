@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"strconv"
 	"testing"
 
 	"strings"
@@ -13,11 +15,13 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_instance", &resource.Sweeper{
 		Name: "alicloud_instance",
 		F:    testSweepInstances,
 		// When implemented, these should be removed firstly
@@ -80,6 +84,7 @@ func testSweepInstances(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Instance: %s (%s)", name, id)
 			continue
@@ -1363,6 +1368,201 @@ func TestAccAlicloudInstanceMulti(t *testing.T) {
 	})
 }
 
+func TestResourceAliyunInstanceLaunchTemplateDiff(t *testing.T) {
+	sgHash := strconv.Itoa(schema.HashString("sg-1"))
+	state := &terraform.InstanceState{
+		ID: "i-1",
+		Attributes: map[string]string{
+			"id":                        "i-1",
+			"launch_template_id":        "lt-1",
+			"launch_template_version":   "1",
+			"image_id":                  "ami-1",
+			"instance_type":             "ecs.g5.large",
+			"security_groups.#":         "1",
+			"security_groups." + sgHash: "sg-1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"launch_template_id": "lt-1",
+	})
+
+	diff, err := resourceAliyunInstance().Diff(state, config, &connectivity.AliyunClient{})
+	if err != nil {
+		t.Fatalf("diff returned an error: %#v", err)
+	}
+	if diff == nil {
+		return
+	}
+	for key, attr := range diff.Attributes {
+		for _, name := range []string{"image_id", "instance_type", "security_groups"} {
+			if key != name && !strings.HasPrefix(key, name+".") {
+				continue
+			}
+			// A computed set without configuration is always reported with an unknown count, which keeps the
+			// prior state in the plan. Only removals and changed known values move away from the template.
+			if attr.NewRemoved || (!attr.NewComputed && attr.New != attr.Old) {
+				t.Errorf("the %s read from the launch template is planned to change: %#v", key, attr)
+			}
+		}
+	}
+}
+
+func TestAccAlicloudInstanceLaunchTemplate(t *testing.T) {
+	var v ecs.Instance
+
+	resourceId := "alicloud_instance.default"
+	ra := resourceAttrInit(resourceId, testAccInstanceCheckMap)
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+
+	rand := acctest.RandIntRange(1000, 9999)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	name := fmt.Sprintf("tf-testAcc%sEcsInstanceConfigLaunchTemplate%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceInstanceLaunchTemplateConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"image_id":        "${data.alicloud_images.default.images.0.id}",
+					"security_groups": []string{"${alicloud_security_group.default.0.id}"},
+					"instance_type":   "${data.alicloud_instance_types.default.instance_types.0.id}",
+
+					"availability_zone":       "${data.alicloud_instance_types.default.instance_types.0.availability_zones.0}",
+					"system_disk_category":    "cloud_efficiency",
+					"vswitch_id":              "${alicloud_vswitch.default.id}",
+					"launch_template_id":      "${alicloud_launch_template.default.id}",
+					"launch_template_version": "${alicloud_launch_template.default.latest_version_number}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_name":           name,
+						"launch_template_id":      CHECKSET,
+						"launch_template_version": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"security_enhancement_strategy", "dry_run", "launch_template_id", "launch_template_version"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"image_id":        REMOVEKEY,
+					"security_groups": REMOVEKEY,
+					"instance_type":   REMOVEKEY,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"image_id":          CHECKSET,
+						"instance_type":     CHECKSET,
+						"security_groups.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceInstanceLaunchTemplateConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alicloud_launch_template" "default" {
+  name              = "${var.name}"
+  instance_name     = "${var.name}"
+  image_id          = "${data.alicloud_images.default.images.0.id}"
+  instance_type     = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  security_group_id = "${alicloud_security_group.default.0.id}"
+  vswitch_id        = "${alicloud_vswitch.default.id}"
+}
+`, resourceInstanceVpcConfigDependence(name))
+}
+
+func TestAccAlicloudInstanceDeploymentSet(t *testing.T) {
+	var v ecs.Instance
+
+	resourceId := "alicloud_instance.default"
+	ra := resourceAttrInit(resourceId, testAccInstanceCheckMap)
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+
+	rand := acctest.RandIntRange(1000, 9999)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	name := fmt.Sprintf("tf-testAcc%sEcsInstanceConfigDeploymentSet%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceInstanceDeploymentSetConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"image_id":        "${data.alicloud_images.default.images.0.id}",
+					"security_groups": []string{"${alicloud_security_group.default.0.id}"},
+					"instance_type":   "${data.alicloud_instance_types.default.instance_types.0.id}",
+
+					"availability_zone":    "${data.alicloud_instance_types.default.instance_types.0.availability_zones.0}",
+					"system_disk_category": "cloud_efficiency",
+					"instance_name":        "${var.name}",
+					"vswitch_id":           "${alicloud_vswitch.default.id}",
+					"deployment_set_id":    "${alicloud_ecs_deployment_set.default.0.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_name":     name,
+						"deployment_set_id": CHECKSET,
+						"dedicated_host_id": "",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"deployment_set_id": "${alicloud_ecs_deployment_set.default.1.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceId, "deployment_set_id", "alicloud_ecs_deployment_set.default.1", "id"),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"deployment_set_id": REMOVEKEY,
+				}),
+				ExpectError: regexp.MustCompile("deployment_set_id of the instance .* can not be cleared"),
+			},
+		},
+	})
+}
+
+func resourceInstanceDeploymentSetConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alicloud_ecs_deployment_set" "default" {
+  count               = 2
+  deployment_set_name = "${var.name}"
+}
+`, resourceInstanceVpcConfigDependence(name))
+}
+
 func resourceInstanceVpcConfigDependence(name string) string {
 	return fmt.Sprintf(`
 data "alicloud_instance_types" "default" {
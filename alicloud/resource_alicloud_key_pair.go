@@ -23,6 +23,10 @@ func resourceAlicloudKeyPair() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_name": {
 				Type:          schema.TypeString,
@@ -165,5 +169,5 @@ func resourceAlicloudKeyPairDelete(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForKeyPair(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ecsService.WaitForKeyPair(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -21,6 +21,10 @@ func resourceAlicloudKeyPairAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_name": {
 				Type:         schema.TypeString,
@@ -86,7 +90,7 @@ func resourceAlicloudKeyPairAttachmentCreate(d *schema.ResourceData, meta interf
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		}
 		for _, id := range newIds {
-			if err := ecsService.WaitForEcsInstance(id, Running, DefaultLongTimeout); err != nil {
+			if err := ecsService.WaitForEcsInstance(id, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_key_pair", &resource.Sweeper{
+	addTestSweepers("alicloud_key_pair", &resource.Sweeper{
 		Name: "alicloud_key_pair",
 		F:    testSweepKeyPairs,
 	})
@@ -77,6 +77,7 @@ func testSweepKeyPairs(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_key_pair", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Key Pair: %s", name)
 			continue
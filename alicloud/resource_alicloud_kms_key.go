@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
@@ -20,6 +21,10 @@ func resourceAlicloudKmsKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -165,5 +170,5 @@ func resourceAlicloudKmsKeyDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(kmsService.WaitForKmsKey(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(kmsService.WaitForKmsKey(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
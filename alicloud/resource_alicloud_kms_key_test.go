@@ -15,8 +15,8 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_kms_keys", &resource.Sweeper{
-		Name: "alicloud_kms_keys",
+	addTestSweepers("alicloud_kms_key", &resource.Sweeper{
+		Name: "alicloud_kms_key",
 		F:    testSweepKmsKey,
 	})
 }
@@ -56,21 +56,26 @@ func testSweepKmsKey(region string) error {
 
 			return WrapError(err)
 		}
+		skip := true
 		for _, description := range prefixes {
 			if strings.HasPrefix(strings.ToLower(key.KeyMetadata.Description), strings.ToLower(description)) {
-				req := kms.CreateScheduleKeyDeletionRequest()
-				req.KeyId = v.KeyId
-				req.PendingWindowInDays = requests.NewInteger(7)
-				raw, err = client.WithKmsClient(func(kmsclient *kms.Client) (interface{}, error) {
-					return kmsclient.ScheduleKeyDeletion(req)
-				})
-				swept = true
-				if err != nil {
-					return WrapErrorf(err, DataDefaultErrorMsg, v.KeyId, req.GetActionName(), AlibabaCloudSdkGoERROR)
-				}
+				skip = false
 				break
 			}
 		}
+		if sweepSkip("alicloud_kms_key", skip, key.KeyMetadata) {
+			continue
+		}
+		req := kms.CreateScheduleKeyDeletionRequest()
+		req.KeyId = v.KeyId
+		req.PendingWindowInDays = requests.NewInteger(7)
+		raw, err = client.WithKmsClient(func(kmsclient *kms.Client) (interface{}, error) {
+			return kmsclient.ScheduleKeyDeletion(req)
+		})
+		swept = true
+		if err != nil {
+			return WrapErrorf(err, DataDefaultErrorMsg, v.KeyId, req.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
 	}
 	if swept {
 		time.Sleep(5 * time.Second)
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
 
 	"strconv"
@@ -32,6 +31,8 @@ func resourceAlicloudKVStoreInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffAll(productAvailableCustomizeDiff(connectivity.KVSTORECode), tagsAllCustomizeDiff, tagApiTagsCustomizeDiff),
+
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
 				Type:         schema.TypeString,
@@ -127,7 +128,13 @@ func resourceAlicloudKVStoreInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"security_ips": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -214,7 +221,12 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 	d.Partial(true)
 	stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging", "Changing"}, []string{"Normal"}, d.Timeout(schema.TimeoutUpdate), 1*time.Minute, kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
-	if err := kvstoreService.setInstanceTags(d); err != nil {
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, connectivity.KVSTORECode, TagResourceInstance); err != nil {
+		return WrapError(err)
+	}
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupKVStoreInstance); err != nil {
 		return WrapError(err)
 	}
 	if d.HasChange("parameters") {
@@ -295,51 +307,28 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if !d.IsNewResource() && (d.HasChange("instance_charge_type") || d.HasChange("period")) {
-		prePaidRequest := r_kvstore.CreateTransformToPrePaidRequest()
-		prePaidRequest.RegionId = client.RegionId
-		prePaidRequest.InstanceId = d.Id()
-		prePaidRequest.Period = requests.Integer(strconv.Itoa(d.Get("period").(int)))
-
-		// for now we just support charge change from PostPaid to PrePaid
-		configPayType := PayType(d.Get("instance_charge_type").(string))
-		if configPayType == PrePaid {
-			raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
-				return rkvClient.TransformToPrePaid(prePaidRequest)
-			})
-			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), prePaidRequest.GetActionName(), AlibabaCloudSdkGoERROR)
-			}
-			addDebug(prePaidRequest.GetActionName(), raw, prePaidRequest.RpcRequest, prePaidRequest)
-			// wait instance status is Normal after modifying
-			if _, err := stateConf.WaitForState(); err != nil {
-				return WrapError(err)
-			}
-			d.SetPartial("instance_charge_type")
-			d.SetPartial("period")
+	if !d.IsNewResource() && d.HasChange("instance_charge_type") {
+		bssOpenApiService := BssOpenApiService{client}
+		period := 0
+		if PayType(d.Get("instance_charge_type").(string)) == PrePaid {
+			period = d.Get("period").(int)
 		}
+		if err := bssOpenApiService.ConvertChargeType(BssProductCodeKvstore, "", d.Id(), period); err != nil {
+			return WrapError(err)
+		}
+		// wait instance status is Normal after modifying
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapError(err)
+		}
+		d.SetPartial("instance_charge_type")
+		d.SetPartial("period")
 	}
 
 	if d.HasChange("auto_renew") || d.HasChange("auto_renew_period") {
-		request := r_kvstore.CreateModifyInstanceAutoRenewalAttributeRequest()
-		request.RegionId = client.RegionId
-		request.DBInstanceId = d.Id()
-
-		auto_renew := d.Get("auto_renew").(bool)
-		if auto_renew {
-			request.AutoRenew = "True"
-		} else {
-			request.AutoRenew = "False"
-		}
-		request.Duration = strconv.Itoa(d.Get("auto_renew_period").(int))
-
-		raw, err := client.WithRkvClient(func(client *r_kvstore.Client) (interface{}, error) {
-			return client.ModifyInstanceAutoRenewalAttribute(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		bssOpenApiService := BssOpenApiService{client}
+		if err := bssOpenApiService.SetAutoRenew(BssProductCodeKvstore, d.Id(), d.Get("auto_renew").(bool), d.Get("auto_renew_period").(int)); err != nil {
+			return WrapError(err)
 		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		d.SetPartial("auto_renew")
 		d.SetPartial("auto_renew_period")
 	}
@@ -495,11 +484,20 @@ func resourceAlicloudKVStoreInstanceRead(d *schema.ResourceData, meta interface{
 	d.Set("vpc_auth_mode", object.VpcAuthMode)
 	d.Set("maintain_start_time", object.MaintainStartTime)
 	d.Set("maintain_end_time", object.MaintainEndTime)
-	tags, err := kvstoreService.DescribeTags(d.Id(), TagResourceInstance)
+	tagService := TagService{client}
+	tags, err := tagService.ListResourceTags(d.Id(), connectivity.KVSTORECode, TagResourceInstance)
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tags); err != nil {
+		return WrapError(err)
+	}
+	resourceManagerService := ResourceManagerService{client}
+	resourceGroupId, err := resourceManagerService.DescribeResourceGroupId(d.Id(), ResourceGroupKVStoreInstance)
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", kvstoreService.tagsToMap(tags))
+	d.Set("resource_group_id", resourceGroupId)
 
 	if object.ChargeType == string(Prepaid) {
 		request := r_kvstore.CreateDescribeInstanceAutoRenewalAttributeRequest()
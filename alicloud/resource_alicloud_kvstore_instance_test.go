@@ -23,7 +23,7 @@ var memcacheInstanceClassForTest = "memcache.master.small.default"
 var memcacheInstanceClassForTestUpdateClass = "memcache.master.mid.default"
 
 func init() {
-	resource.AddTestSweepers("alicloud_kvstore_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_kvstore_instance", &resource.Sweeper{
 		Name: "alicloud_kvstore_instance",
 		F:    testSweepKVStoreInstances,
 	})
@@ -84,6 +84,7 @@ func testSweepKVStoreInstances(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_kvstore_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping KVStore Instance: %s (%s)", name, id)
 			continue
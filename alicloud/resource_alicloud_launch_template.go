@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -19,6 +20,11 @@ func resourceAliyunLaunchTemplate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -34,6 +40,31 @@ func resourceAliyunLaunchTemplate() *schema.Resource {
 				ValidateFunc: validateLaunchTemplateDescription,
 			},
 
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateLaunchTemplateVersionDescription,
+			},
+
+			"update_default_version": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"default_version_number"},
+			},
+
+			"default_version_number": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"update_default_version"},
+			},
+
+			"latest_version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"host_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -165,6 +196,7 @@ func resourceAliyunLaunchTemplate() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsAllSchema(),
 
 			"resource_group_id": {
 				Type:     schema.TypeString,
@@ -269,6 +301,7 @@ func resourceAliyunLaunchTemplateCreate(d *schema.ResourceData, meta interface{}
 	request := ecs.CreateCreateLaunchTemplateRequest()
 	request.RegionId = client.RegionId
 	request.LaunchTemplateName = d.Get("name").(string)
+	request.VersionDescription = d.Get("version_description").(string)
 	request.Description = d.Get("description").(string)
 	request.HostName = d.Get("host_name").(string)
 	request.ImageId = d.Get("image_id").(string)
@@ -334,7 +367,7 @@ func resourceAliyunLaunchTemplateCreate(d *schema.ResourceData, meta interface{}
 
 		request.DataDisk = &disks
 	}
-	tagsRaw := d.Get("tags").(map[string]interface{})
+	tagsRaw := d.Get("tags_all").(map[string]interface{})
 	var tags []ecs.CreateLaunchTemplateTag
 	for key, value := range tagsRaw {
 		tags = append(tags, ecs.CreateLaunchTemplateTag{
@@ -378,7 +411,10 @@ func resourceAliyunLaunchTemplateRead(d *schema.ResourceData, meta interface{})
 		return WrapError(err)
 	}
 
+	d.Set("latest_version_number", object.LatestVersionNumber)
+	d.Set("default_version_number", object.DefaultVersionNumber)
 	d.Set("name", latestVersion.LaunchTemplateName)
+	d.Set("version_description", latestVersion.VersionDescription)
 	d.Set("description", latestVersion.LaunchTemplateData.Description)
 	d.Set("host_name", latestVersion.LaunchTemplateData.HostName)
 	d.Set("image_id", latestVersion.LaunchTemplateData.ImageId)
@@ -438,36 +474,74 @@ func resourceAliyunLaunchTemplateRead(d *schema.ResourceData, meta interface{})
 		return WrapError(err)
 	}
 
-	tags := make(map[string]interface{})
+	tags := make(map[string]string)
 	for _, tag := range latestVersion.LaunchTemplateData.Tags.InstanceTag {
 		tags[tag.Key] = tag.Value
 	}
-	d.Set("tags", tags)
+	if err := setTagsAndTagsAll(client, d, tags); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
 
 func resourceAliyunLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	versions, err := getLaunchTemplateVersions(d.Id(), meta)
-	if err != nil {
-		return WrapError(err)
+	d.Partial(true)
+
+	// Any change of the template data creates a new version, the existing versions are kept to be rolled back to.
+	versionChanged := false
+	for key := range resourceAliyunLaunchTemplate().Schema {
+		if key == "name" || key == "update_default_version" || key == "default_version_number" || key == "latest_version_number" {
+			continue
+		}
+		if d.HasChange(key) {
+			versionChanged = true
+			break
+		}
 	}
-	// Remove one of the oldest and non-default version when the total number reach 30
-	if len(versions) > 29 {
-		var oldestVersion int64
-		for _, version := range versions {
-			if !version.DefaultVersion && (oldestVersion == 0 || version.VersionNumber < oldestVersion) {
-				oldestVersion = version.VersionNumber
-			}
+
+	if versionChanged {
+		versions, err := getLaunchTemplateVersions(d.Id(), meta)
+		if err != nil {
+			return WrapError(err)
 		}
+		// Remove one of the oldest and non-default version when the total number reach 30
+		if len(versions) > 29 {
+			var oldestVersion int64
+			for _, version := range versions {
+				if !version.DefaultVersion && (oldestVersion == 0 || version.VersionNumber < oldestVersion) {
+					oldestVersion = version.VersionNumber
+				}
+			}
 
-		err = deleteLaunchTemplateVersion(d.Id(), int(oldestVersion), meta)
+			err = deleteLaunchTemplateVersion(d.Id(), int(oldestVersion), meta)
+			if err != nil {
+				return WrapError(err)
+			}
+		}
+		version, err := createLaunchTemplateVersion(d, meta)
 		if err != nil {
 			return WrapError(err)
 		}
+		if d.Get("update_default_version").(bool) {
+			if err := modifyLaunchTemplateDefaultVersion(d.Id(), int(version), meta); err != nil {
+				return WrapError(err)
+			}
+		}
+		d.SetPartial("version_description")
+	}
+
+	if d.HasChange("default_version_number") {
+		if v, ok := d.GetOk("default_version_number"); ok {
+			if err := modifyLaunchTemplateDefaultVersion(d.Id(), v.(int), meta); err != nil {
+				return WrapError(err)
+			}
+		}
+		d.SetPartial("default_version_number")
 	}
-	return WrapError(createLaunchTemplateVersion(d, meta))
 
+	d.Partial(false)
+	return resourceAliyunLaunchTemplateRead(d, meta)
 }
 
 func resourceAliyunLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
@@ -485,7 +559,7 @@ func resourceAliyunLaunchTemplateDelete(d *schema.ResourceData, meta interface{}
 	}
 	addDebug(request.GetAcceptFormat(), raw, request.RpcRequest, request)
 	ecsService := EcsService{client}
-	if err := ecsService.WaitForLaunchTemplate(d.Id(), Deleted, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForLaunchTemplate(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunLaunchTemplateRead(d, meta)
@@ -528,11 +602,28 @@ func deleteLaunchTemplateVersion(id string, version int, meta interface{}) error
 	return nil
 }
 
-func createLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) error {
+func modifyLaunchTemplateDefaultVersion(id string, version int, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	request := ecs.CreateModifyLaunchTemplateDefaultVersionRequest()
+	request.RegionId = client.RegionId
+	request.LaunchTemplateId = id
+	request.DefaultVersionNumber = requests.NewInteger(version)
+	raw, err := client.WithEcsClient(func(client *ecs.Client) (interface{}, error) {
+		return client.ModifyLaunchTemplateDefaultVersion(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
+
+func createLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) (int64, error) {
 	client := meta.(*connectivity.AliyunClient)
 	request := ecs.CreateCreateLaunchTemplateVersionRequest()
 	request.RegionId = client.RegionId
 	request.LaunchTemplateId = d.Id()
+	request.VersionDescription = d.Get("version_description").(string)
 	request.Description = d.Get("description").(string)
 	request.HostName = d.Get("host_name").(string)
 	request.ImageId = d.Get("image_id").(string)
@@ -598,7 +689,7 @@ func createLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) error
 
 		request.DataDisk = &disks
 	}
-	tagsRaw := d.Get("tags").(map[string]interface{})
+	tagsRaw := d.Get("tags_all").(map[string]interface{})
 	var tags []ecs.CreateLaunchTemplateVersionTag
 	for key, value := range tagsRaw {
 		tags = append(tags, ecs.CreateLaunchTemplateVersionTag{
@@ -612,9 +703,9 @@ func createLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) error
 		return client.CreateLaunchTemplateVersion(request)
 	})
 	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		return 0, WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return nil
-
+	response, _ := raw.(*ecs.CreateLaunchTemplateVersionResponse)
+	return response.LaunchTemplateVersionNumber, nil
 }
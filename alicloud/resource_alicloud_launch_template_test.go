@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_launch_template", &resource.Sweeper{
+	addTestSweepers("alicloud_launch_template", &resource.Sweeper{
 		Name: "alicloud_launch_template",
 		F:    testAlicloudLaunchTemplate,
 	})
@@ -39,7 +39,7 @@ func testAlicloudLaunchTemplate(region string) error {
 
 	var ids []string
 	for _, tpl := range response.LaunchTemplateSets.LaunchTemplateSet {
-		if strings.HasPrefix(tpl.LaunchTemplateName, "tf-testAcc") {
+		if !sweepSkip("alicloud_launch_template", !strings.HasPrefix(tpl.LaunchTemplateName, "tf-testAcc"), tpl) {
 			ids = append(ids, tpl.LaunchTemplateId)
 		}
 	}
@@ -153,9 +153,10 @@ func TestAccAlicloudLaunchTemplateBasic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceId,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"update_default_version"},
 			},
 			{
 				Config: testAccConfig(map[string]interface{}{
@@ -744,6 +745,97 @@ func TestAccAlicloudLaunchTemplateMulti(t *testing.T) {
 		},
 	})
 }
+func TestAccAlicloudLaunchTemplateVersions(t *testing.T) {
+	var v ecs.LaunchTemplateSet
+
+	resourceId := "alicloud_launch_template.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"image_id":               CHECKSET,
+		"instance_type":          CHECKSET,
+		"security_group_id":      CHECKSET,
+		"version_description":    "v1",
+		"update_default_version": "false",
+		"latest_version_number":  "1",
+		"default_version_number": "1",
+	})
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandInt()
+	name := fmt.Sprintf("tf-testaccLaunchTemplateVersions%v", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLaunchTemplateConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":                "${var.name}",
+					"image_id":            "${data.alicloud_images.default.images.0.id}",
+					"instance_type":       "${data.alicloud_instance_types.default.instance_types.0.id}",
+					"security_group_id":   "${alicloud_security_group.default.id}",
+					"version_description": "v1",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_name":          "${var.name}",
+					"version_description":    "v2",
+					"update_default_version": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_name":          name,
+						"version_description":    "v2",
+						"update_default_version": "true",
+						"latest_version_number":  "2",
+						"default_version_number": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"version_description":    "v3",
+					"update_default_version": "false",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"version_description":    "v3",
+						"update_default_version": "false",
+						"latest_version_number":  "3",
+						"default_version_number": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"default_version_number": "1",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"latest_version_number":  "3",
+						"default_version_number": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func resourceLaunchTemplateConfigDependence(name string) string {
 	return fmt.Sprintf(`
 variable "name" {
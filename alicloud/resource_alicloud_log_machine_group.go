@@ -20,6 +20,10 @@ func resourceAlicloudLogMachineGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -193,5 +197,5 @@ func resourceAlicloudLogMachineGroupDelete(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_log_store", "ListShards", AliyunLogGoSdkERROR)
 	}
-	return WrapError(logService.WaitForLogMachineGroup(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(logService.WaitForLogMachineGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -20,6 +20,10 @@ func resourceAlicloudLogProject() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -130,5 +134,5 @@ func resourceAlicloudLogProjectDelete(d *schema.ResourceData, meta interface{})
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteProject", AliyunLogGoSdkERROR)
 	}
-	return WrapError(logService.WaitForLogProject(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(logService.WaitForLogProject(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
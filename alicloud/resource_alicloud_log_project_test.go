@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_log_project", &resource.Sweeper{
+	addTestSweepers("alicloud_log_project", &resource.Sweeper{
 		Name: "alicloud_log_project",
 		F:    testSweepLogProjects,
 	})
@@ -50,6 +50,7 @@ func testSweepLogProjects(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_log_project", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Log Project: %s", name)
 			continue
@@ -21,6 +21,10 @@ func resourceAlicloudLogStore() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -279,5 +283,5 @@ func resourceAlicloudLogStoreDelete(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteLogStore", AliyunLogGoSdkERROR)
 	}
 	addDebug("DeleteLogStore", nil)
-	return WrapError(logService.WaitForLogStore(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(logService.WaitForLogStore(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -21,6 +21,10 @@ func resourceAlicloudLogtailConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"name": {
@@ -244,7 +248,7 @@ func resourceAlicloudLogtailConfigDelete(d *schema.ResourceData, meta interface{
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteConfig", AliyunLogGoSdkERROR)
 	}
-	return WrapError(logService.WaitForLogtailConfig(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(logService.WaitForLogtailConfig(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 // This function is used to assert and convert the type to sls.LogConfig
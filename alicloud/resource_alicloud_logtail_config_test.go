@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_logtail_config", &resource.Sweeper{
+	addTestSweepers("alicloud_logtail_config", &resource.Sweeper{
 		Name: "alicloud_logtail_config",
 		F:    testSweepLogConfigs,
 	})
@@ -64,6 +64,7 @@ func testSweepLogConfigs(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_logtail_config", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Log Project: %s", name)
 			continue
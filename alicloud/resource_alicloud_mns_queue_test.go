@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_mns_queue", &resource.Sweeper{
+	addTestSweepers("alicloud_mns_queue", &resource.Sweeper{
 		Name: "alicloud_mns_queue",
 		F:    testSweepMnsQueues,
 	})
@@ -60,6 +60,7 @@ func testSweepMnsQueues(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_mns_queue", skip, queueAttr)
 		if skip {
 			log.Printf("[INFO] Skipping mns queque: %s ", name)
 			continue
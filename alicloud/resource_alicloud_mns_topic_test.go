@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_mns_topic", &resource.Sweeper{
+	addTestSweepers("alicloud_mns_topic", &resource.Sweeper{
 		Name: "alicloud_mns_topic",
 		F:    testSweepMnsTopics,
 	})
@@ -60,6 +60,7 @@ func testSweepMnsTopics(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_mns_topic", skip, topicAttr)
 		if skip {
 			log.Printf("[INFO] Skipping mns topic : %s ", name)
 			continue
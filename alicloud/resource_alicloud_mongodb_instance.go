@@ -27,6 +27,7 @@ func resourceAlicloudMongoDBInstance() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+		CustomizeDiff: customizeDiffAll(productAvailableCustomizeDiff(connectivity.DDSCode), tagsAllCustomizeDiff, tagApiTagsCustomizeDiff),
 		Schema: map[string]*schema.Schema{
 			"engine_version": {
 				Type:     schema.TypeString,
@@ -138,6 +139,13 @@ func resourceAlicloudMongoDBInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -208,6 +216,8 @@ func buildMongoDBCreateRequest(d *schema.ResourceData, meta interface{}) (*dds.C
 		request.SecurityIPList = strings.Join(expandStringList(d.Get("security_ip_list").(*schema.Set).List())[:], COMMA_SEPARATED)
 	}
 
+	request.ResourceGroupId = d.Get("resource_group_id").(string)
+
 	request.ClientToken = buildClientToken(request.GetActionName())
 	return request, nil
 }
@@ -279,11 +289,21 @@ func resourceAlicloudMongoDBInstanceRead(d *schema.ResourceData, meta interface{
 	d.Set("storage_engine", instance.StorageEngine)
 	d.Set("maintain_start_time", instance.MaintainStartTime)
 	d.Set("maintain_end_time", instance.MaintainEndTime)
+	d.Set("resource_group_id", instance.ResourceGroupId)
 
 	if replication_factor, err := strconv.Atoi(instance.ReplicationFactor); err == nil {
 		d.Set("replication_factor", replication_factor)
 	}
 
+	tagService := TagService{client}
+	tags, err := tagService.ListResourceTags(d.Id(), connectivity.DDSCode, TagResourceInstance)
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tags); err != nil {
+		return WrapError(err)
+	}
+
 	return nil
 }
 
@@ -319,11 +339,21 @@ func resourceAlicloudMongoDBInstanceUpdate(d *schema.ResourceData, meta interfac
 		d.SetPartial("maintain_end_time")
 	}
 
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, connectivity.DDSCode, TagResourceInstance); err != nil {
+		return WrapError(err)
+	}
+
 	if d.IsNewResource() {
 		d.Partial(false)
 		return resourceAlicloudMongoDBInstanceRead(d, meta)
 	}
 
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupMongoDBInstance); err != nil {
+		return WrapError(err)
+	}
+
 	if d.HasChange("name") {
 		request := dds.CreateModifyDBInstanceDescriptionRequest()
 		request.DBInstanceId = d.Id()
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_mongodb_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_mongodb_instance", &resource.Sweeper{
 		Name: "alicloud_mongodb_instance",
 		F:    testSweepMongoDBInstances,
 	})
@@ -101,6 +101,7 @@ func testSweepMongoDBInstances(region string) error {
 				skip = !need
 			}
 		}
+		skip = sweepSkip("alicloud_mongodb_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping MongoDB instance: %s (%s)\n", name, id)
 			continue
@@ -22,6 +22,13 @@ func resourceAlicloudMongoDBShardingInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffAll(productAvailableCustomizeDiff(connectivity.DDSCode), tagsAllCustomizeDiff, tagApiTagsCustomizeDiff),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"engine_version": {
 				Type:     schema.TypeString,
@@ -157,6 +164,13 @@ func resourceAlicloudMongoDBShardingInstance() *schema.Resource {
 				MinItems: 2,
 				MaxItems: 32,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -271,7 +285,7 @@ func resourceAlicloudMongoDBShardingInstanceCreate(d *schema.ResourceData, meta
 
 	d.SetId(response.DBInstanceId)
 
-	if err := ddsService.WaitForMongoDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
+	if err := ddsService.WaitForMongoDBInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -302,6 +316,7 @@ func resourceAlicloudMongoDBShardingInstanceRead(d *schema.ResourceData, meta in
 	d.Set("name", instance.DBInstanceDescription)
 	d.Set("engine_version", instance.EngineVersion)
 	d.Set("storage_engine", instance.StorageEngine)
+	d.Set("resource_group_id", instance.ResourceGroupId)
 	d.Set("zone_id", instance.ZoneId)
 	d.Set("instance_charge_type", instance.ChargeType)
 	d.Set("vswitch_id", instance.VSwitchId)
@@ -342,6 +357,15 @@ func resourceAlicloudMongoDBShardingInstanceRead(d *schema.ResourceData, meta in
 
 	d.Set("security_ip_list", ips)
 
+	tagService := TagService{client}
+	tags, err := tagService.ListResourceTags(d.Id(), connectivity.DDSCode, TagResourceInstance)
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tags); err != nil {
+		return WrapError(err)
+	}
+
 	return nil
 }
 
@@ -358,6 +382,16 @@ func resourceAlicloudMongoDBShardingInstanceUpdate(d *schema.ResourceData, meta
 		d.SetPartial("backup_period")
 	}
 
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, connectivity.DDSCode, TagResourceInstance); err != nil {
+		return WrapError(err)
+	}
+
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupMongoDBInstance); err != nil {
+		return WrapError(err)
+	}
+
 	if d.IsNewResource() {
 		d.Partial(false)
 		return resourceAlicloudMongoDBInstanceRead(d, meta)
@@ -463,5 +497,5 @@ func resourceAlicloudMongoDBShardingInstanceDelete(d *schema.ResourceData, meta
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ddsService.WaitForMongoDBInstance(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ddsService.WaitForMongoDBInstance(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
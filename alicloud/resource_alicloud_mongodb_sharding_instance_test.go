@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_mongodb_sharding_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_mongodb_sharding_instance", &resource.Sweeper{
 		Name: "alicloud_mongodb_sharding_instance",
 		F:    testSweepMongoDBShardingInstances,
 	})
@@ -89,6 +89,7 @@ func testSweepMongoDBShardingInstances(region string) error {
 				skip = !need
 			}
 		}
+		skip = sweepSkip("alicloud_mongodb_sharding_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping MongoDB sharding instance: %s (%s)\n", name, id)
 			continue
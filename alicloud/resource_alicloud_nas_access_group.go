@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
@@ -16,6 +18,10 @@ func resourceAlicloudNasAccessGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -115,5 +121,5 @@ func resourceAlicloudNasAccessGroupDelete(d *schema.ResourceData, meta interface
 	}
 
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(nasService.WaitForNasAccessGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(nasService.WaitForNasAccessGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_nas_access_group", &resource.Sweeper{
+	addTestSweepers("alicloud_nas_access_group", &resource.Sweeper{
 		Name: "alicloud_nas_access_group",
 		F:    testSweepNasAccessGroup,
 		Dependencies: []string{
@@ -76,6 +76,7 @@ func testSweepNasAccessGroup(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_nas_access_group", skip, fs)
 		if skip {
 			log.Printf("[INFO] Skipping AccessGroup: %s (%s)", AccessGroupType, id)
 			continue
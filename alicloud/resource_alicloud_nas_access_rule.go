@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
@@ -19,6 +20,10 @@ func resourceAlicloudNasAccessRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"access_group_name": {
 				Type:     schema.TypeString,
@@ -154,6 +159,6 @@ func resourceAlicloudNasAccessRuleDelete(d *schema.ResourceData, meta interface{
 	}
 
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(nasService.WaitForNasAccessRule(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(nasService.WaitForNasAccessRule(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 
 }
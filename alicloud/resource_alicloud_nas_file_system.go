@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
@@ -16,6 +18,10 @@ func resourceAlicloudNasFileSystem() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
 				Type:     schema.TypeString,
@@ -40,6 +46,11 @@ func resourceAlicloudNasFileSystem() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateNASDescription,
 			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -79,6 +90,11 @@ func resourceAlicloudNasFileSystemUpdate(d *schema.ResourceData, meta interface{
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
+
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupNasFileSystem); err != nil {
+		return WrapError(err)
+	}
 	return resourceAlicloudNasFileSystemRead(d, meta)
 }
 
@@ -97,6 +113,13 @@ func resourceAlicloudNasFileSystemRead(d *schema.ResourceData, meta interface{})
 	d.Set("description", object.Description)
 	d.Set("protocol_type", object.ProtocolType)
 	d.Set("storage_type", object.StorageType)
+
+	resourceManagerService := ResourceManagerService{client}
+	resourceGroupId, err := resourceManagerService.DescribeResourceGroupId(d.Id(), ResourceGroupNasFileSystem)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("resource_group_id", resourceGroupId)
 	return nil
 }
 
@@ -119,5 +142,5 @@ func resourceAlicloudNasFileSystemDelete(d *schema.ResourceData, meta interface{
 	}
 
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(nasService.WaitForNasFileSystem(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(nasService.WaitForNasFileSystem(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -14,9 +14,9 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_nas_file_system",
+	addTestSweepers("alicloud_nas_file_system",
 		&resource.Sweeper{
-			Name: "alicloud_nas_file_syster",
+			Name: "alicloud_nas_file_system",
 			F:    testSweepNasFileSystem,
 			// When implemented, these should be removed firstly
 			Dependencies: []string{
@@ -78,6 +78,7 @@ func testSweepNasFileSystem(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_nas_file_system", skip, fs)
 		if skip {
 			log.Printf("[INFO] Skipping FileSystem: %s (%s)", destription, id)
 			continue
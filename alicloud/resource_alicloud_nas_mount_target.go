@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +19,11 @@ func resourceAlicloudNasMountTarget() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
 				Type:     schema.TypeString,
@@ -70,7 +76,7 @@ func resourceAlicloudNasMountTargetCreate(d *schema.ResourceData, meta interface
 	}
 	response, _ := raw.(*nas.CreateMountTargetResponse)
 	d.SetId(response.MountTargetDomain)
-	err = nasService.WaitForNasMountTarget(d.Id(), Active, DefaultTimeout)
+	err = nasService.WaitForNasMountTarget(d.Id(), Active, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -144,5 +150,5 @@ func resourceAlicloudNasMountTargetDelete(d *schema.ResourceData, meta interface
 	}
 
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(nasService.WaitForNasMountTarget(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(nasService.WaitForNasMountTarget(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -2,8 +2,11 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -11,6 +14,90 @@ import (
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
+func init() {
+	addTestSweepers("alicloud_nas_mount_target", &resource.Sweeper{
+		Name: "alicloud_nas_mount_target",
+		F:    testSweepNasMountTarget,
+	})
+}
+
+// testSweepNasMountTarget deletes the mount targets using the testing access groups, whatever file systems they belong to.
+func testSweepNasMountTarget(region string) error {
+	if testSweepPreCheckWithRegions(region, false, connectivity.NasNoSupportedRegions) {
+		log.Printf("[INFO] Skipping Nas unsupported region: %s", region)
+		return nil
+	}
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	var filesystems []nas.DescribeFileSystemsFileSystem1
+	req := nas.CreateDescribeFileSystemsRequest()
+	req.RegionId = client.RegionId
+	req.PageSize = requests.NewInteger(PageSizeLarge)
+	req.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithNasClient(func(nasClient *nas.Client) (interface{}, error) {
+			return nasClient.DescribeFileSystems(req)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Error retrieving filesystem: %s", err)
+		}
+		resp, _ := raw.(*nas.DescribeFileSystemsResponse)
+		if resp == nil || len(resp.FileSystems.FileSystem) < 1 {
+			break
+		}
+		filesystems = append(filesystems, resp.FileSystems.FileSystem...)
+
+		if len(resp.FileSystems.FileSystem) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(req.PageNumber); err != nil {
+			return err
+		} else {
+			req.PageNumber = page
+		}
+	}
+
+	for _, fs := range filesystems {
+		for _, mountTarget := range fs.MountTargets.MountTarget {
+			domain := mountTarget.MountTargetDomain
+			name := mountTarget.AccessGroupName
+			skip := true
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+					skip = false
+					break
+				}
+			}
+			skip = sweepSkip("alicloud_nas_mount_target", skip, mountTarget)
+			if skip {
+				log.Printf("[INFO] Skipping MountTarget: %s (%s)", domain, name)
+				continue
+			}
+			log.Printf("[INFO] Deleting MountTarget: %s (%s)", domain, name)
+			request := nas.CreateDeleteMountTargetRequest()
+			request.FileSystemId = fs.FileSystemId
+			request.MountTargetDomain = domain
+			_, err := client.WithNasClient(func(nasClient *nas.Client) (interface{}, error) {
+				return nasClient.DeleteMountTarget(request)
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete MountTarget (%s (%s)): %s", domain, name, err)
+			}
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudNas_MountTarget_update(t *testing.T) {
 	var v nas.DescribeMountTargetsMountTarget1
 	rand1 := acctest.RandIntRange(10000, 499999)
@@ -24,6 +24,12 @@ func resourceAliyunNatGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -95,7 +101,6 @@ func resourceAliyunNatGateway() *schema.Resource {
 			"instance_charge_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				Computed:     true,
 				ValidateFunc: validateInstanceChargeType,
 			},
@@ -103,7 +108,6 @@ func resourceAliyunNatGateway() *schema.Resource {
 			"period": {
 				Type:             schema.TypeInt,
 				Optional:         true,
-				ForceNew:         true,
 				Default:          1,
 				DiffSuppressFunc: ecsPostPaidDiffSuppressFunc,
 				ValidateFunc:     validateRouterInterfaceChargeTypePeriod,
@@ -173,7 +177,7 @@ func resourceAliyunNatGatewayCreate(d *schema.ResourceData, meta interface{}) er
 	}); err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_nat_gateway", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNatGateway(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunNatGatewayRead(d, meta)
@@ -280,8 +284,35 @@ func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 		addDebug(modifyNatGatewaySpecRequest.GetActionName(), raw, modifyNatGatewaySpecRequest.RpcRequest, modifyNatGatewaySpecRequest)
 	}
+
+	if d.HasChange("instance_charge_type") {
+		bssOpenApiService := BssOpenApiService{client}
+		chargeType := d.Get("instance_charge_type").(string)
+		period := 0
+		if chargeType == string(PrePaid) {
+			period = d.Get("period").(int)
+		}
+		if err := bssOpenApiService.ConvertChargeType(BssProductCodeNat, "", d.Id(), period); err != nil {
+			return WrapError(err)
+		}
+		// Wait for the charge type of the nat gateway to be changed
+		if err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			object, err := vpcService.DescribeNatGateway(d.Id())
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if object.InstanceChargeType != chargeType {
+				return resource.RetryableError(Error("Waiting for the nat gateway %s to be %s timeout.", d.Id(), chargeType))
+			}
+			return nil
+		}); err != nil {
+			return WrapError(err)
+		}
+		d.SetPartial("instance_charge_type")
+		d.SetPartial("period")
+	}
 	d.Partial(false)
-	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNatGateway(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunNatGatewayRead(d, meta)
@@ -319,7 +350,7 @@ func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForNatGateway(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForNatGateway(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
@@ -404,7 +435,7 @@ func getPackage(packageId string, meta interface{}, d *schema.ResourceData) (pac
 	request.NatGatewayId = d.Id()
 	request.BandwidthPackageId = packageId
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeBandwidthPackages(request)
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_nat_gateway", &resource.Sweeper{
+	addTestSweepers("alicloud_nat_gateway", &resource.Sweeper{
 		Name: "alicloud_nat_gateway",
 		F:    testSweepNatGateways,
 		// When implemented, these should be removed firstly
@@ -86,6 +86,7 @@ func testSweepNatGateways(region string) error {
 				skip = !need
 			}
 		}
+		skip = sweepSkip("alicloud_nat_gateway", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Nat Gateway: %s (%s)", name, id)
 			continue
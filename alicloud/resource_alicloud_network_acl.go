@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +20,12 @@ func resourceAliyunNetworkAcl() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -77,7 +84,7 @@ func resourceAliyunNetworkAclCreate(d *schema.ResourceData, meta interface{}) er
 	response, _ := raw.(*vpc.CreateNetworkAclResponse)
 	d.SetId(response.NetworkAclId)
 
-	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -126,7 +133,7 @@ func resourceAliyunNetworkAclUpdate(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -136,7 +143,7 @@ func resourceAliyunNetworkAclUpdate(d *schema.ResourceData, meta interface{}) er
 func resourceAliyunNetworkAclDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	vpcService := VpcService{client}
-	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -153,5 +160,5 @@ func resourceAliyunNetworkAclDelete(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(vpcService.WaitForNetworkAcl(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForNetworkAcl(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -16,6 +16,11 @@ func resourceAliyunNetworkAclAttachment() *schema.Resource {
 		Update: resourceAliyunNetworkAclAttachmentUpdate,
 		Delete: resourceAliyunNetworkAclAttachmentDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"network_acl_id": {
@@ -130,7 +135,7 @@ func resourceAliyunNetworkAclAttachmentUpdate(d *schema.ResourceData, meta inter
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, DefaultTimeout); err != nil {
+			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -168,7 +173,7 @@ func resourceAliyunNetworkAclAttachmentUpdate(d *schema.ResourceData, meta inter
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, DefaultTimeout); err != nil {
+			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -223,5 +228,5 @@ func resourceAliyunNetworkAclAttachmentDelete(d *schema.ResourceData, meta inter
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return vpcService.WaitForNetworkAclAttachment(networkAclId, vpcResource, Deleted, DefaultTimeout)
+	return vpcService.WaitForNetworkAclAttachment(networkAclId, vpcResource, Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds()))
 }
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_network_acl_attachment", &resource.Sweeper{
+	addTestSweepers("alicloud_network_acl_attachment", &resource.Sweeper{
 		Name: "alicloud_network_acl_attachment",
 		F:    testSweepNetworkAclAttachment,
 	})
@@ -80,6 +80,7 @@ func testSweepNetworkAclAttachment(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_network_acl_attachment", skip, nacl)
 		if skip {
 			log.Printf("[INFO] Skipping Network Acl: %s (%s)", name, id)
 			continue
@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,6 +18,14 @@ func resourceAliyunNetworkAclEntries() *schema.Resource {
 		Read:   resourceAliyunNetworkAclEntriesRead,
 		Update: resourceAliyunNetworkAclEntriesUpdate,
 		Delete: resourceAliyunNetworkAclEntriesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunNetworkAclEntriesImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -126,38 +135,63 @@ func resourceAliyunNetworkAclEntriesRead(d *schema.ResourceData, meta interface{
 		return WrapError(err)
 	}
 
-	ingress := []vpc.UpdateNetworkAclEntriesIngressAclEntries{}
-	for _, e := range d.Get("ingress").([]interface{}) {
-		ingress = append(ingress, vpc.UpdateNetworkAclEntriesIngressAclEntries{
-			Protocol:            e.(map[string]interface{})["protocol"].(string),
-			Port:                e.(map[string]interface{})["port"].(string),
-			SourceCidrIp:        e.(map[string]interface{})["source_cidr_ip"].(string),
-			NetworkAclEntryName: e.(map[string]interface{})["name"].(string),
-			EntryType:           e.(map[string]interface{})["entry_type"].(string),
-			Policy:              e.(map[string]interface{})["policy"].(string),
-			Description:         e.(map[string]interface{})["description"].(string),
+	// The system entries are created by the network acl itself, so only the custom entries are managed.
+	ingress := make([]map[string]interface{}, 0)
+	for _, e := range object.IngressAclEntries.IngressAclEntry {
+		if e.EntryType == "system" {
+			continue
+		}
+		ingress = append(ingress, map[string]interface{}{
+			"protocol":       e.Protocol,
+			"port":           e.Port,
+			"source_cidr_ip": e.SourceCidrIp,
+			"name":           e.NetworkAclEntryName,
+			"entry_type":     e.EntryType,
+			"policy":         e.Policy,
+			"description":    e.Description,
 		})
 	}
 
-	egress := []vpc.UpdateNetworkAclEntriesEgressAclEntries{}
-	for _, e := range d.Get("egress").([]interface{}) {
-		egress = append(egress, vpc.UpdateNetworkAclEntriesEgressAclEntries{
-			Protocol:            e.(map[string]interface{})["protocol"].(string),
-			Port:                e.(map[string]interface{})["port"].(string),
-			DestinationCidrIp:   e.(map[string]interface{})["destination_cidr_ip"].(string),
-			NetworkAclEntryName: e.(map[string]interface{})["name"].(string),
-			EntryType:           e.(map[string]interface{})["entry_type"].(string),
-			Policy:              e.(map[string]interface{})["policy"].(string),
-			Description:         e.(map[string]interface{})["description"].(string),
+	egress := make([]map[string]interface{}, 0)
+	for _, e := range object.EgressAclEntries.EgressAclEntry {
+		if e.EntryType == "system" {
+			continue
+		}
+		egress = append(egress, map[string]interface{}{
+			"protocol":            e.Protocol,
+			"port":                e.Port,
+			"destination_cidr_ip": e.DestinationCidrIp,
+			"name":                e.NetworkAclEntryName,
+			"entry_type":          e.EntryType,
+			"policy":              e.Policy,
+			"description":         e.Description,
 		})
 	}
 	d.Set("network_acl_id", object.NetworkAclId)
-	d.Set("egress", egress)
-	d.Set("ingress", ingress)
+	if err := d.Set("egress", egress); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ingress", ingress); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
 
+// resourceAliyunNetworkAclEntriesImport imports the entries of a network acl by its id, and it also accepts
+// the id <network acl id>:<unique id> of the resource.
+func resourceAliyunNetworkAclEntriesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), COLON_SEPARATED) {
+		d.SetId(d.Id() + COLON_SEPARATED + resource.UniqueId())
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	d.Set("network_acl_id", parts[0])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAliyunNetworkAclEntriesUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	vpcService := VpcService{client}
@@ -205,7 +239,7 @@ func resourceAliyunNetworkAclEntriesUpdate(d *schema.ResourceData, meta interfac
 		request.UpdateEgressAclEntries = requests.NewBoolean(true)
 	}
 	// Check the network acl status.
-	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -224,7 +258,7 @@ func resourceAliyunNetworkAclEntriesUpdate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout)
+	return vpcService.WaitForNetworkAcl(networkAclId, Available, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 }
 
 func resourceAliyunNetworkAclEntriesDelete(d *schema.ResourceData, meta interface{}) error {
@@ -246,7 +280,7 @@ func resourceAliyunNetworkAclEntriesDelete(d *schema.ResourceData, meta interfac
 	request.UpdateIngressAclEntries = requests.NewBoolean(true)
 	request.UpdateEgressAclEntries = requests.NewBoolean(true)
 	// Check the network acl status.
-	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -265,5 +299,5 @@ func resourceAliyunNetworkAclEntriesDelete(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout)
+	return vpcService.WaitForNetworkAcl(networkAclId, Available, int(d.Timeout(schema.TimeoutDelete).Seconds()))
 }
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_network_acl_entries", &resource.Sweeper{
+	addTestSweepers("alicloud_network_acl_entries", &resource.Sweeper{
 		Name: "alicloud_network_acl_entries",
 		F:    testSweepNetworkAclEntries,
 	})
@@ -79,6 +79,7 @@ func testSweepNetworkAclEntries(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_network_acl_entries", skip, nacl)
 		if skip {
 			log.Printf("[INFO] Skipping Network Acl: %s (%s)", name, id)
 			continue
@@ -142,6 +143,11 @@ func TestAccAlicloudNetworkAclEntries_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccNetworkAclEntries_modify(rand),
 				Check: resource.ComposeTestCheckFunc(
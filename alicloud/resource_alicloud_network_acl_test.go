@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_network_acl", &resource.Sweeper{
+	addTestSweepers("alicloud_network_acl", &resource.Sweeper{
 		Name: "alicloud_network_acl",
 		F:    testSweepNetworkAcl,
 		// When implemented, these should be removed firstly
@@ -85,6 +85,7 @@ func testSweepNetworkAcl(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_network_acl", skip, nacl)
 		if skip {
 			log.Printf("[INFO] Skipping Network Acl: %s (%s)", name, id)
 			continue
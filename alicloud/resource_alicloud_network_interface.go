@@ -22,6 +22,13 @@ func resourceAliyunNetworkInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -76,7 +83,8 @@ func resourceAliyunNetworkInterface() *schema.Resource {
 				Optional: true,
 				Default:  "",
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -117,7 +125,7 @@ func resourceAliyunNetworkInterfaceCreate(d *schema.ResourceData, meta interface
 	object := raw.(*ecs.CreateNetworkInterfaceResponse)
 	d.SetId(object.NetworkInterfaceId)
 
-	if err := ecsService.WaitForNetworkInterface(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForNetworkInterface(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -158,8 +166,8 @@ func resourceAliyunNetworkInterfaceRead(d *schema.ResourceData, meta interface{}
 		return WrapError(err)
 	}
 
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	return nil
@@ -335,6 +343,7 @@ func resourceAliyunNetworkInterfaceUpdate(d *schema.ResourceData, meta interface
 		return WrapError(err)
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -366,5 +375,5 @@ func resourceAliyunNetworkInterfaceDelete(d *schema.ResourceData, meta interface
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForNetworkInterface(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ecsService.WaitForNetworkInterface(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -19,7 +19,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_network_interface", &resource.Sweeper{
+	addTestSweepers("alicloud_network_interface", &resource.Sweeper{
 		Name: "alicloud_network_interface",
 		F:    testAlicloudNetworkInterface,
 	})
@@ -86,6 +86,7 @@ func testAlicloudNetworkInterface(region string) error {
 				skip = !need
 			}
 		}
+		skip = sweepSkip("alicloud_network_interface", skip, eni)
 		if skip {
 			log.Printf("[INFO] Skipping NetworkInterface %s", name)
 			continue
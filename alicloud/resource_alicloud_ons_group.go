@@ -20,6 +20,11 @@ func resourceAlicloudOnsGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -82,7 +87,7 @@ func resourceAlicloudOnsGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(instanceId + ":" + groupId)
 
-	if err = onsService.WaitForOnsGroup(d.Id(), Available, DefaultTimeout); err != nil {
+	if err = onsService.WaitForOnsGroup(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAlicloudOnsGroupRead(d, meta)
@@ -181,5 +186,5 @@ func resourceAlicloudOnsGroupDelete(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(onsService.WaitForOnsGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(onsService.WaitForOnsGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
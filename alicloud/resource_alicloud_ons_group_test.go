@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ons_group", &resource.Sweeper{
+	addTestSweepers("alicloud_ons_group", &resource.Sweeper{
 		Name: "alicloud_ons_group",
 		F:    testSweepOnsGroup,
 	})
@@ -77,6 +77,7 @@ func testSweepOnsGroup(region string) error {
 					break
 				}
 			}
+			skip = sweepSkip("alicloud_ons_group", skip, v)
 			if skip {
 				log.Printf("[INFO] Skipping ons group: %s ", groupId)
 				continue
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ons_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_ons_instance", &resource.Sweeper{
 		Name: "alicloud_ons_instance",
 		F:    testSweepOnsInstance,
 		Dependencies: []string{
@@ -63,6 +63,7 @@ func testSweepOnsInstance(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ons_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping ons instance: %s ", name)
 			continue
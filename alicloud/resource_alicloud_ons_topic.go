@@ -20,6 +20,11 @@ func resourceAlicloudOnsTopic() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -89,7 +94,7 @@ func resourceAlicloudOnsTopicCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	d.SetId(instanceId + ":" + topic)
 
-	if err = onsService.WaitForOnsTopic(d.Id(), Available, DefaultTimeout); err != nil {
+	if err = onsService.WaitForOnsTopic(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAlicloudOnsTopicRead(d, meta)
@@ -188,5 +193,5 @@ func resourceAlicloudOnsTopicDelete(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(onsService.WaitForOnsTopic(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(onsService.WaitForOnsTopic(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ons_topic", &resource.Sweeper{
+	addTestSweepers("alicloud_ons_topic", &resource.Sweeper{
 		Name: "alicloud_ons_topic",
 		F:    testSweepOnsTopic,
 	})
@@ -75,6 +75,7 @@ func testSweepOnsTopic(region string) error {
 					break
 				}
 			}
+			skip = sweepSkip("alicloud_ons_topic", skip, v)
 			if skip {
 				log.Printf("[INFO] Skipping ons topic: %s ", name)
 				continue
@@ -24,6 +24,12 @@ func resourceAlicloudOssBucket() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -232,7 +238,14 @@ func resourceAlicloudOssBucket() *schema.Resource {
 				MaxItems: 1,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 
 			"force_destroy": {
 				Type:     schema.TypeBool,
@@ -542,10 +555,17 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 			tagsMap[t.Key] = t.Value
 		}
 	}
-	if err := d.Set("tags", tagsMap); err != nil {
+	if err := setTagsAndTagsAll(client, d, tagsMap); err != nil {
 		return WrapError(err)
 	}
 
+	resourceManagerService := ResourceManagerService{client}
+	resourceGroupId, err := resourceManagerService.DescribeResourceGroupId(d.Id(), ResourceGroupOssBucket)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("resource_group_id", resourceGroupId)
+
 	return nil
 }
 
@@ -617,11 +637,12 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("server_side_encryption_rule")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := resourceAlicloudOssBucketTaggingUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("versioning") {
@@ -631,6 +652,11 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("versioning")
 	}
 
+	resourceManagerService := ResourceManagerService{client}
+	if err := resourceManagerService.SetResourceGroup(d, ResourceGroupOssBucket); err != nil {
+		return WrapError(err)
+	}
+
 	d.Partial(false)
 	return resourceAlicloudOssBucketRead(d, meta)
 }
@@ -967,7 +993,7 @@ func resourceAlicloudOssBucketEncryptionUpdate(client *connectivity.AliyunClient
 }
 
 func resourceAlicloudOssBucketTaggingUpdate(client *connectivity.AliyunClient, d *schema.ResourceData) error {
-	tagsMap := d.Get("tags").(map[string]interface{})
+	tagsMap := d.Get("tags_all").(map[string]interface{})
 	var requestInfo *oss.Client
 	if tagsMap == nil || len(tagsMap) == 0 {
 		raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
@@ -1095,7 +1121,7 @@ func resourceAlicloudOssBucketDelete(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteBucket", AliyunOssGoSdk)
 	}
-	return WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func expirationHash(v interface{}) int {
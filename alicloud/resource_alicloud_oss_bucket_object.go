@@ -20,6 +20,13 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 		Read:   resourceAlicloudOssBucketObjectRead,
 		Update: resourceAlicloudOssBucketObjectPut,
 		Delete: resourceAlicloudOssBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudOssBucketObjectImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -190,6 +197,22 @@ func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{
 		"options":   options,
 	})
 
+	acl, err := bucket.GetObjectACL(d.Get("key").(string))
+	if err != nil {
+		// Reading the ACL needs the permission oss:GetObjectAcl besides reading the object, and the acl in the state
+		// is kept when it is denied.
+		if !IsExceptedError(err, AccessDenied) {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetObjectACL", AliyunOssGoSdk)
+		}
+		log.Printf("[WARN] Reading the ACL of the object %s is denied and its acl is not refreshed: %#v", d.Get("key").(string), err)
+	} else {
+		addDebug("GetObjectACL", acl, requestInfo, map[string]string{"objectKey": d.Get("key").(string)})
+		// The object inherits the ACL of its bucket when it has no ACL of its own.
+		if acl.ACL != string(oss.ACLDefault) {
+			d.Set("acl", acl.ACL)
+		}
+	}
+
 	d.Set("content_type", object.Get("Content-Type"))
 	d.Set("content_length", object.Get("Content-Length"))
 	d.Set("cache_control", object.Get("Cache-Control"))
@@ -203,6 +226,19 @@ func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// resourceAlicloudOssBucketObjectImport imports an object by the id <bucket>:<key>. The key can contain colons,
+// so only the first colon separates it from the bucket name.
+func resourceAlicloudOssBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), COLON_SEPARATED, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, WrapError(Error("Invalid alicloud_oss_bucket_object import id %s. Expected id format is <bucket>:<key>.", d.Id()))
+	}
+	d.Set("bucket", parts[0])
+	d.Set("key", parts[1])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAlicloudOssBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	ossService := OssService{client}
@@ -225,7 +261,7 @@ func resourceAlicloudOssBucketObjectDelete(d *schema.ResourceData, meta interfac
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteObject", AliyunOssGoSdk)
 	}
 
-	return WrapError(ossService.WaitForOssBucketObject(bucket, d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ossService.WaitForOssBucketObject(bucket, d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 
 }
 
@@ -62,6 +62,13 @@ func TestAccAlicloudOssBucketObject_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s:test-object-source-key", name),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source", "content_md5"},
+			},
 			{
 				Config: testAccConfig(map[string]interface{}{
 					"source":  REMOVEKEY,
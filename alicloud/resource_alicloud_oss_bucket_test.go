@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_oss_bucket", &resource.Sweeper{
+	addTestSweepers("alicloud_oss_bucket", &resource.Sweeper{
 		Name: "alicloud_oss_bucket",
 		F:    testSweepOSSBuckets,
 	})
@@ -57,6 +57,7 @@ func testSweepOSSBuckets(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_oss_bucket", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping OSS bucket: %s", name)
 			continue
@@ -19,6 +19,14 @@ func resourceAlicloudOtsInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -53,7 +61,8 @@ func resourceAlicloudOtsInstance() *schema.Resource {
 					return d.Id() != ""
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -93,7 +102,7 @@ func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) e
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	d.SetId(request.InstanceName)
-	if err := otsService.WaitForOtsInstance(request.InstanceName, Running, DefaultTimeout); err != nil {
+	if err := otsService.WaitForOtsInstance(request.InstanceName, Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunOtsInstanceUpdate(d, meta)
@@ -115,7 +124,9 @@ func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("accessed_by", convertInstanceAccessedByRevert(object.Network))
 	d.Set("instance_type", convertInstanceTypeRevert(object.ClusterType))
 	d.Set("description", object.Description)
-	d.Set("tags", otsTagsToMap(object.TagInfos.TagInfo))
+	if err := setTagsAndTagsAll(client, d, otsTagsToMap(object.TagInfos.TagInfo)); err != nil {
+		return WrapError(err)
+	}
 	return nil
 }
 
@@ -140,8 +151,8 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("accessed_by")
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
@@ -188,8 +199,9 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		}
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
-	if err := otsService.WaitForOtsInstance(d.Id(), Running, DefaultTimeout); err != nil {
+	if err := otsService.WaitForOtsInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	d.Partial(false)
@@ -221,5 +233,5 @@ func resourceAliyunOtsInstanceDelete(d *schema.ResourceData, meta interface{}) e
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(otsService.WaitForOtsInstance(d.Id(), Deleted, DefaultLongTimeout))
+	return WrapError(otsService.WaitForOtsInstance(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
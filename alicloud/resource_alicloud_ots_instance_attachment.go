@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
@@ -11,6 +13,13 @@ func resourceAlicloudOtsInstanceAttachment() *schema.Resource {
 		Create: resourceAliyunOtsInstanceAttachmentCreate,
 		Read:   resourceAliyunOtsInstanceAttachmentRead,
 		Delete: resourceAliyunOtsInstanceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunOtsInstanceAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -76,13 +85,25 @@ func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interf
 		}
 		return WrapError(err)
 	}
-	// There is a bug that inst does not contain instance name and vswitch ID, so this resource does not support import function.
-	//d.Set("instance_name", inst.InstanceName)
+	// The vpc info does not contain the vswitch ID, so it is kept from the state or set by the importer.
+	d.Set("instance_name", d.Id())
 	d.Set("vpc_name", object.InstanceVpcName)
 	d.Set("vpc_id", object.VpcId)
 	return nil
 }
 
+// resourceAliyunOtsInstanceAttachmentImport imports an attachment by the id <instance name>:<vswitch id>, because
+// the vswitch of the attachment can not be read from the instance.
+func resourceAliyunOtsInstanceAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, WrapError(Error("Invalid alicloud_ots_instance_attachment import id %s. Expected id format is <instance name>:<vswitch id>.", d.Id()))
+	}
+	d.Set("vswitch_id", parts[1])
+	d.SetId(parts[0])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	otsService := OtsService{client}
@@ -108,5 +129,5 @@ func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta inte
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(otsService.WaitForOtsInstanceVpc(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(otsService.WaitForOtsInstanceVpc(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -51,6 +52,15 @@ func TestAccAlicloudOtsInstanceAttachment_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName: resourceId,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceId]
+					return rs.Primary.ID + COLON_SEPARATED + rs.Primary.Attributes["vswitch_id"], nil
+				},
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ots_instance", &resource.Sweeper{
+	addTestSweepers("alicloud_ots_instance", &resource.Sweeper{
 		Name: "alicloud_ots_instance",
 		F:    testSweepOtsInstances,
 	})
@@ -79,6 +79,7 @@ func testSweepOtsInstances(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ots_instance", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping OTS Instance: %s", name)
 			continue
@@ -23,6 +23,10 @@ func resourceAlicloudOtsTable() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
 				Type:     schema.TypeString,
@@ -245,7 +249,7 @@ func resourceAliyunOtsTableDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteTable", AliyunTablestoreGoSdk)
 	}
-	return WrapError(otsService.WaitForOtsTable(instanceName, tableName, Deleted, DefaultTimeout))
+	return WrapError(otsService.WaitForOtsTable(instanceName, tableName, Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func parseId(d *schema.ResourceData, meta interface{}) (instanceName, tableName string, err error) {
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_pvtz_zone", &resource.Sweeper{
+	addTestSweepers("alicloud_pvtz_zone", &resource.Sweeper{
 		Name: "alicloud_pvtz_zone",
 		F:    testSweepPvtzZones,
 	})
@@ -76,6 +76,7 @@ func testSweepPvtzZones(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_pvtz_zone", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Private Zone: %s (%s)", name, id)
 			continue
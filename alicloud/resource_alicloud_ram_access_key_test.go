@@ -41,6 +41,16 @@ func TestAccAlicloudRamAccessKey_basic(t *testing.T) {
 					testAccCheck(map[string]string{"user_name": fmt.Sprintf("tf-testAcc%sRamAccessKeyConfig%d", defaultRegionToTest, rand)}),
 				),
 			},
+			{
+				ResourceName: resourceAKId,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceAKId]
+					return rs.Primary.Attributes["user_name"] + COLON_SEPARATED + rs.Primary.ID, nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_file", "pgp_key", "key_fingerprint", "encrypted_secret"},
+			},
 			{
 				Config: testAccRamAccessKeyStatus(rand),
 				Check: resource.ComposeTestCheckFunc(
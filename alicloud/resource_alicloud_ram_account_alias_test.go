@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ram_account_alias", &resource.Sweeper{
+	addTestSweepers("alicloud_ram_account_alias", &resource.Sweeper{
 		Name: "alicloud_ram_account_alias",
 		F:    testSweepAccountAliases,
 	})
@@ -51,6 +51,7 @@ func testSweepAccountAliases(region string) error {
 			break
 		}
 	}
+	skip = sweepSkip("alicloud_ram_account_alias", skip, name)
 	if skip {
 		log.Printf("[INFO] Skipping Ram account alias: %s", name)
 		return nil
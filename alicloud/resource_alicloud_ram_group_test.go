@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ram_group", &resource.Sweeper{
+	addTestSweepers("alicloud_ram_group", &resource.Sweeper{
 		Name: "alicloud_ram_group",
 		F:    testSweepRamGroups,
 		// When implemented, these should be removed firstly
@@ -68,6 +68,7 @@ func testSweepRamGroups(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ram_group", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Ram Group: %s", name)
 			continue
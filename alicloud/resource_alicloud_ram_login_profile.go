@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
@@ -19,6 +20,11 @@ func resourceAlicloudRamLoginProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"user_name": {
 				Type:     schema.TypeString,
@@ -67,7 +73,7 @@ func resourceAlicloudRamLoginProfileCreate(d *schema.ResourceData, meta interfac
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	d.SetId(request.UserName)
-	err = ramSercvice.WaitForRamLoginProfile(d.Id(), Normal, DefaultTimeout)
+	err = ramSercvice.WaitForRamLoginProfile(d.Id(), Normal, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -138,6 +144,6 @@ func resourceAlicloudRamLoginProfileDelete(d *schema.ResourceData, meta interfac
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(ramService.WaitForRamLoginProfile(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamLoginProfile(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 
 }
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ram_policy", &resource.Sweeper{
+	addTestSweepers("alicloud_ram_policy", &resource.Sweeper{
 		Name: "alicloud_ram_policy",
 		F:    testSweepRamPolicies,
 		Dependencies: []string{
@@ -60,6 +60,7 @@ func testSweepRamPolicies(region string) error {
 					break
 				}
 			}
+			skip = sweepSkip("alicloud_ram_policy", skip, v)
 			if skip {
 				log.Printf("[INFO] Skipping Ram policy: %s", name)
 				continue
@@ -14,6 +14,13 @@ func resourceAlicloudRamRoleAttachment() *schema.Resource {
 		Create: resourceAlicloudInstanceRoleAttachmentCreate,
 		Read:   resourceAlicloudInstanceRoleAttachmentRead,
 		Delete: resourceAlicloudInstanceRoleAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -123,5 +130,5 @@ func resourceAlicloudInstanceRoleAttachmentDelete(d *schema.ResourceData, meta i
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ramService.WaitForRamRoleAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamRoleAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +19,10 @@ func resourceAlicloudRamRolePolicyAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:     schema.TypeString,
@@ -118,5 +123,5 @@ func resourceAlicloudRamRolePolicyAttachmentDelete(d *schema.ResourceData, meta
 
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(ramService.WaitForRamRolePolicyAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamRolePolicyAttachment(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ram_role", &resource.Sweeper{
+	addTestSweepers("alicloud_ram_role", &resource.Sweeper{
 		Name: "alicloud_ram_role",
 		F:    testSweepRamRoles,
 		// When implemented, these should be removed firstly
@@ -62,6 +62,7 @@ func testSweepRamRoles(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ram_role", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Ram Role: %s (%s)", name, id)
 			continue
@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
@@ -16,6 +18,11 @@ func resourceAlicloudRamUser() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -78,7 +85,7 @@ func resourceAlicloudRamUserCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(response.User.UserId)
 
-	err = ramService.WaitForRamUser(d.Id(), Normal, DefaultTimeout)
+	err = ramService.WaitForRamUser(d.Id(), Normal, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -293,5 +300,5 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(deleteUserRequest.GetActionName(), raw, deleteUserRequest.RpcRequest, deleteUserRequest)
-	return WrapError(ramService.WaitForRamUser(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamUser(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
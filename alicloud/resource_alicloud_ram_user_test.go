@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ram_user", &resource.Sweeper{
+	addTestSweepers("alicloud_ram_user", &resource.Sweeper{
 		Name: "alicloud_ram_user",
 		F:    testSweepRamUsers,
 		// When implemented, these should be removed firstly
@@ -69,6 +69,7 @@ func testSweepRamUsers(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ram_user", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Ram User: %s (%s)", name, id)
 			continue
@@ -0,0 +1,153 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudRenewal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudRenewalCreate,
+		Read:   resourceAlicloudRenewalRead,
+		Update: resourceAlicloudRenewalUpdate,
+		Delete: resourceAlicloudRenewalDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"renewal_status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateAllowedStringValue([]string{
+					string(RenewAutoRenewal),
+					string(RenewManualRenewal),
+					string(RenewNotRenewal)}),
+			},
+			"renewal_period": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateFunc:     validateIntegerInRange(1, 12),
+				DiffSuppressFunc: renewalNotAutoRenewDiffSuppressFunc,
+			},
+			"renewal_period_unit": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          RenewalPeriodUnitMonth,
+				ValidateFunc:     validateAllowedStringValue([]string{RenewalPeriodUnitMonth, RenewalPeriodUnitYear}),
+				DiffSuppressFunc: renewalNotAutoRenewDiffSuppressFunc,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudRenewalCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	bssOpenApiService := BssOpenApiService{client}
+
+	id := fmt.Sprintf("%s%s%s", d.Get("product_code").(string), COLON_SEPARATED, d.Get("instance_id").(string))
+	if _, err := bssOpenApiService.DescribeSubscription(id); err != nil {
+		return WrapError(err)
+	}
+	d.SetId(id)
+
+	return resourceAlicloudRenewalUpdate(d, meta)
+}
+
+func resourceAlicloudRenewalRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	bssOpenApiService := BssOpenApiService{client}
+
+	object, err := bssOpenApiService.DescribeSubscription(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("product_code", parts[0])
+	d.Set("instance_id", parts[1])
+	d.Set("product_type", object.ProductType)
+	d.Set("renewal_status", object.RenewStatus)
+	if RenewalStatus(object.RenewStatus) == RenewAutoRenewal {
+		d.Set("renewal_period", object.RenewalDuration)
+		d.Set("renewal_period_unit", object.RenewalDurationUnit)
+	}
+	d.Set("region", object.Region)
+	d.Set("status", object.Status)
+	d.Set("create_time", object.CreateTime)
+	d.Set("end_time", object.EndTime)
+
+	return nil
+}
+
+func resourceAlicloudRenewalUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	bssOpenApiService := BssOpenApiService{client}
+
+	if d.HasChange("renewal_status") || d.HasChange("renewal_period") || d.HasChange("renewal_period_unit") {
+		if err := bssOpenApiService.SetRenewal(d.Get("product_code").(string), d.Get("product_type").(string), d.Get("instance_id").(string),
+			RenewalStatus(d.Get("renewal_status").(string)), d.Get("renewal_period").(int), d.Get("renewal_period_unit").(string)); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	return resourceAlicloudRenewalRead(d, meta)
+}
+
+func resourceAlicloudRenewalDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	bssOpenApiService := BssOpenApiService{client}
+
+	// The subscription is kept and only its renewal is set back to manual.
+	err := bssOpenApiService.SetRenewal(d.Get("product_code").(string), d.Get("product_type").(string), d.Get("instance_id").(string),
+		RenewManualRenewal, 0, "")
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	return nil
+}
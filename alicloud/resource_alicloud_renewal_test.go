@@ -0,0 +1,105 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudRenewal_basic(t *testing.T) {
+	var v bssopenapi.Instance
+	resourceId := "alicloud_renewal.default"
+	ra := resourceAttrInit(resourceId, nil)
+	serviceFunc := func() interface{} {
+		return &BssOpenApiService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeSubscription")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAccRenewal%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceRenewalConfigDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"product_code":   "ecs",
+					"instance_id":    "${alicloud_instance.default.id}",
+					"renewal_status": "AutoRenewal",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"product_code":        "ecs",
+						"instance_id":         CHECKSET,
+						"renewal_status":      "AutoRenewal",
+						"renewal_period":      "1",
+						"renewal_period_unit": "M",
+						"end_time":            CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"product_code":   "ecs",
+					"instance_id":    "${alicloud_instance.default.id}",
+					"renewal_status": "AutoRenewal",
+					"renewal_period": "3",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"renewal_period": "3",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"product_code":   "ecs",
+					"instance_id":    "${alicloud_instance.default.id}",
+					"renewal_status": "NotRenewal",
+					"renewal_period": "3",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"renewal_status": "NotRenewal",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceRenewalConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+variable "name" {
+  default = "%s"
+}
+
+resource "alicloud_instance" "default" {
+  image_id             = "${data.alicloud_images.default.images.0.id}"
+  instance_type        = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  instance_name        = "${var.name}"
+  security_groups      = ["${alicloud_security_group.default.id}"]
+  vswitch_id           = "${alicloud_vswitch.default.id}"
+  instance_charge_type = "PrePaid"
+  force_delete         = true
+}
+`, EcsInstanceCommonTestCase, name)
+}
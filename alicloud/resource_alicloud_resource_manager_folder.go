@@ -0,0 +1,108 @@
+package alicloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudResourceManagerFolder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudResourceManagerFolderCreate,
+		Read:   resourceAlicloudResourceManagerFolderRead,
+		Update: resourceAlicloudResourceManagerFolderUpdate,
+		Delete: resourceAlicloudResourceManagerFolderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"folder_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 24),
+			},
+			"parent_folder_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudResourceManagerFolderCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	request := resourceManagerService.newRequest("CreateFolder")
+	request.QueryParams["FolderName"] = d.Get("folder_name").(string)
+	if v, ok := d.GetOk("parent_folder_id"); ok {
+		request.QueryParams["ParentFolderId"] = v.(string)
+	}
+	response := struct {
+		Folder Folder
+	}{}
+	if err := resourceManagerService.doRequest("alicloud_resource_manager_folder", request, &response); err != nil {
+		return WrapError(err)
+	}
+	d.SetId(response.Folder.FolderId)
+
+	return resourceAlicloudResourceManagerFolderRead(d, meta)
+}
+
+func resourceAlicloudResourceManagerFolderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	object, err := resourceManagerService.DescribeResourceManagerFolder(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("folder_name", object.FolderName)
+	d.Set("parent_folder_id", object.ParentFolderId)
+	d.Set("create_time", object.CreateTime)
+
+	return nil
+}
+
+func resourceAlicloudResourceManagerFolderUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	if d.HasChange("folder_name") {
+		request := resourceManagerService.newRequest("UpdateFolder")
+		request.QueryParams["FolderId"] = d.Id()
+		request.QueryParams["NewFolderName"] = d.Get("folder_name").(string)
+		if err := resourceManagerService.doRequest(d.Id(), request, nil); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	return resourceAlicloudResourceManagerFolderRead(d, meta)
+}
+
+func resourceAlicloudResourceManagerFolderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	request := resourceManagerService.newRequest("DeleteFolder")
+	request.QueryParams["FolderId"] = d.Id()
+	if err := resourceManagerService.doRequest(d.Id(), request, nil); err != nil {
+		if IsExceptedError(err, FolderNotFound) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	// because DeleteFolder is called synchronously, there is no wait or describe here.
+	return nil
+}
@@ -0,0 +1,147 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func init() {
+	addTestSweepers("alicloud_resource_manager_folder", &resource.Sweeper{
+		Name: "alicloud_resource_manager_folder",
+		F:    testSweepResourceManagerFolders,
+	})
+}
+
+// testSweepResourceManagerFolders deletes the testing folders of the account, the sub folders before their parents.
+func testSweepResourceManagerFolders(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	var sweep func(parentId string, swept bool) error
+	sweep = func(parentId string, swept bool) error {
+		var folders []Folder
+		for pageNumber := 1; ; pageNumber++ {
+			request := resourceManagerService.newRequest("ListFoldersForParent")
+			request.QueryParams["PageNumber"] = strconv.Itoa(pageNumber)
+			request.QueryParams["PageSize"] = strconv.Itoa(PageSizeLarge)
+			if parentId != "" {
+				request.QueryParams["ParentFolderId"] = parentId
+			}
+			response := struct {
+				Folders struct {
+					Folder []Folder
+				}
+			}{}
+			if err := resourceManagerService.doRequest(parentId, request, &response); err != nil {
+				return fmt.Errorf("Error retrieving Folders: %s", err)
+			}
+			folders = append(folders, response.Folders.Folder...)
+			if len(response.Folders.Folder) < PageSizeLarge {
+				break
+			}
+		}
+
+		for _, v := range folders {
+			name := v.FolderName
+			id := v.FolderId
+			skip := !swept
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+					skip = false
+					break
+				}
+			}
+			skip = sweepSkip("alicloud_resource_manager_folder", skip, v)
+			// The sub folders of the folders which are not swept are still visited, they may be testing ones.
+			if err := sweep(id, !skip); err != nil {
+				return err
+			}
+			if skip {
+				log.Printf("[INFO] Skipping Folder: %s (%s)", name, id)
+				continue
+			}
+			log.Printf("[INFO] Deleting Folder: %s (%s)", name, id)
+			request := resourceManagerService.newRequest("DeleteFolder")
+			request.QueryParams["FolderId"] = id
+			if err := resourceManagerService.doRequest(id, request, nil); err != nil {
+				log.Printf("[ERROR] Failed to delete Folder (%s (%s)): %s", name, id, err)
+			}
+		}
+		return nil
+	}
+	return sweep("", false)
+}
+
+func TestAccAlicloudResourceManagerFolder_basic(t *testing.T) {
+	var v Folder
+	resourceId := "alicloud_resource_manager_folder.default"
+	ra := resourceAttrInit(resourceId, nil)
+	serviceFunc := func() interface{} {
+		return &ResourceManagerService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAcc%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceResourceManagerFolderDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"folder_name": name,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"folder_name":      name,
+						"parent_folder_id": CHECKSET,
+						"create_time":      CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"folder_name": fmt.Sprintf("%s-update", name),
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"folder_name": fmt.Sprintf("%s-update", name),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceResourceManagerFolderDependence(name string) string {
+	return ""
+}
@@ -0,0 +1,133 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAlicloudResourceManagerResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlicloudResourceManagerResourceGroupCreate,
+		Read:   resourceAlicloudResourceManagerResourceGroupRead,
+		Update: resourceAlicloudResourceManagerResourceGroupUpdate,
+		Delete: resourceAlicloudResourceManagerResourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(3, 12),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 30),
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlicloudResourceManagerResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	request := resourceManagerService.newRequest("CreateResourceGroup")
+	request.QueryParams["Name"] = d.Get("name").(string)
+	request.QueryParams["DisplayName"] = d.Get("display_name").(string)
+	response := struct {
+		ResourceGroup ResourceGroup
+	}{}
+	if err := resourceManagerService.doRequest("alicloud_resource_manager_resource_group", request, &response); err != nil {
+		return WrapError(err)
+	}
+	d.SetId(response.ResourceGroup.Id)
+
+	stateConf := BuildStateConf([]string{"Creating"}, []string{"OK"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, resourceManagerService.ResourceManagerResourceGroupStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlicloudResourceManagerResourceGroupRead(d, meta)
+}
+
+func resourceAlicloudResourceManagerResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	object, err := resourceManagerService.DescribeResourceManagerResourceGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("name", object.Name)
+	d.Set("display_name", object.DisplayName)
+	d.Set("account_id", object.AccountId)
+	d.Set("status", object.Status)
+	d.Set("create_date", object.CreateDate)
+
+	return nil
+}
+
+func resourceAlicloudResourceManagerResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	if d.HasChange("display_name") {
+		request := resourceManagerService.newRequest("UpdateResourceGroup")
+		request.QueryParams["ResourceGroupId"] = d.Id()
+		request.QueryParams["NewDisplayName"] = d.Get("display_name").(string)
+		if err := resourceManagerService.doRequest(d.Id(), request, nil); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	return resourceAlicloudResourceManagerResourceGroupRead(d, meta)
+}
+
+func resourceAlicloudResourceManagerResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	request := resourceManagerService.newRequest("DeleteResourceGroup")
+	request.QueryParams["ResourceGroupId"] = d.Id()
+	if err := resourceManagerService.doRequest(d.Id(), request, nil); err != nil {
+		if IsExceptedError(err, ResourceGroupNotFound) {
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	// The resource group is deleted asynchronously, and it fails to be deleted if it still contains any resource.
+	stateConf := BuildStateConf([]string{"PendingDelete", "OK"}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, resourceManagerService.ResourceManagerResourceGroupStateRefreshFunc(d.Id(), []string{"DeleteFailed"}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func init() {
+	addTestSweepers("alicloud_resource_manager_resource_group", &resource.Sweeper{
+		Name: "alicloud_resource_manager_resource_group",
+		F:    testSweepResourceManagerResourceGroups,
+	})
+}
+
+// testSweepResourceManagerResourceGroups deletes the testing resource groups, which belong to the account rather than the region.
+// Their names are random, so they are selected by their display names.
+func testSweepResourceManagerResourceGroups(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+	resourceManagerService := ResourceManagerService{client}
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	groups, err := resourceManagerService.ListResourceGroups("OK")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Resource Groups: %s", err)
+	}
+	for _, v := range groups {
+		name := v.DisplayName
+		id := v.Id
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		skip = sweepSkip("alicloud_resource_manager_resource_group", skip, v)
+		if skip {
+			log.Printf("[INFO] Skipping Resource Group: %s (%s)", name, id)
+			continue
+		}
+		log.Printf("[INFO] Deleting Resource Group: %s (%s)", name, id)
+		request := resourceManagerService.newRequest("DeleteResourceGroup")
+		request.QueryParams["ResourceGroupId"] = id
+		if err := resourceManagerService.doRequest(id, request, nil); err != nil {
+			log.Printf("[ERROR] Failed to delete Resource Group (%s (%s)): %s", name, id, err)
+		}
+	}
+	return nil
+}
+
+func TestAccAlicloudResourceManagerResourceGroup_basic(t *testing.T) {
+	var v ResourceGroup
+	resourceId := "alicloud_resource_manager_resource_group.default"
+	ra := resourceAttrInit(resourceId, nil)
+	serviceFunc := func() interface{} {
+		return &ResourceManagerService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceResourceManagerResourceGroupDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":         name,
+					"display_name": fmt.Sprintf("tf-testAcc%d", rand),
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name":         name,
+						"display_name": fmt.Sprintf("tf-testAcc%d", rand),
+						"status":       "OK",
+						"account_id":   CHECKSET,
+						"create_date":  CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"display_name": fmt.Sprintf("tf-testAcc%d-update", rand),
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"display_name": fmt.Sprintf("tf-testAcc%d-update", rand),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceResourceManagerResourceGroupDependence(name string) string {
+	return ""
+}
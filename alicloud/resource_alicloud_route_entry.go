@@ -20,6 +20,11 @@ func resourceAliyunRouteEntry() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"router_id": {
 				Type:       schema.TypeString,
@@ -93,7 +98,7 @@ func resourceAliyunRouteEntryCreate(d *schema.ResourceData, meta interface{}) er
 
 	// retry 10 min to create lots of entries concurrently
 	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
-		if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, DefaultTimeout); err != nil {
+		if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 			return resource.NonRetryableError(err)
 		}
 		args := *request
@@ -128,7 +133,7 @@ func resourceAliyunRouteEntryCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(rtId + ":" + table.VRouterId + ":" + cidr + ":" + nt + ":" + ni)
 
-	if err := vpcService.WaitForRouteEntry(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForRouteEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunRouteEntryRead(d, meta)
@@ -169,7 +174,7 @@ func resourceAliyunRouteEntryDelete(d *schema.ResourceData, meta interface{}) er
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 5)
 	rtId := parts[0]
-	if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	retryTimes := 7
@@ -195,7 +200,7 @@ func resourceAliyunRouteEntryDelete(d *schema.ResourceData, meta interface{}) er
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForRouteEntry(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForRouteEntry(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func buildAliyunRouteEntryDeleteArgs(d *schema.ResourceData, meta interface{}) (*vpc.DeleteRouteEntryRequest, error) {
@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +20,13 @@ func resourceAliyunRouteTable() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -47,7 +55,8 @@ func resourceAliyunRouteTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -74,7 +83,7 @@ func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 	response, _ := raw.(*vpc.CreateRouteTableResponse)
 	d.SetId(response.RouteTableId)
 
-	if err := vpcService.WaitForRouteTable(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+	if err := vpcService.WaitForRouteTable(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -99,7 +108,9 @@ func resourceAliyunRouteTableRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", vpcService.tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, vpcService.tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
 	return nil
 }
 
@@ -150,5 +161,5 @@ func resourceAliyunRouteTableDelete(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(routeTableService.WaitForRouteTable(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(routeTableService.WaitForRouteTable(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
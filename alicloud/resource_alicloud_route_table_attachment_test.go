@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_route_table_attachment", &resource.Sweeper{
+	addTestSweepers("alicloud_route_table_attachment", &resource.Sweeper{
 		Name: "alicloud_route_table_attachment",
 		F:    testSweepRouteTableAttachment,
 	})
@@ -79,6 +79,7 @@ func testSweepRouteTableAttachment(region string) error {
 					break
 				}
 			}
+			skip = sweepSkip("alicloud_route_table_attachment", skip, vswitch)
 			if skip {
 				log.Printf("[INFO] Skipping Route Table: %s (%s)", name, id)
 				continue
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_route_table", &resource.Sweeper{
+	addTestSweepers("alicloud_route_table", &resource.Sweeper{
 		Name: "alicloud_route_table",
 		F:    testSweepRouteTable,
 		// When implemented, these should be removed firstly
@@ -82,6 +82,7 @@ func testSweepRouteTable(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_route_table", skip, vtb)
 		if skip {
 			log.Printf("[INFO] Skipping Route Table: %s (%s)", name, id)
 			continue
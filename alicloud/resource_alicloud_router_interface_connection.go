@@ -19,6 +19,12 @@ func resourceAlicloudRouterInterfaceConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Read:   schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"interface_id": {
 				Type:     schema.TypeString,
@@ -82,7 +88,7 @@ func resourceAlicloudRouterInterfaceConnectionCreate(d *schema.ResourceData, met
 				return WrapError(err)
 			}
 			d.SetId(object.RouterInterfaceId)
-			if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
+			if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 				return WrapError(err)
 			}
 			return resourceAlicloudRouterInterfaceConnectionRead(d, meta)
@@ -136,7 +142,7 @@ func resourceAlicloudRouterInterfaceConnectionCreate(d *schema.ResourceData, met
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	d.SetId(interfaceId)
 
-	if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Idle, DefaultTimeout); err != nil {
+	if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Idle, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -160,7 +166,7 @@ func resourceAlicloudRouterInterfaceConnectionCreate(d *schema.ResourceData, met
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), connectRequest.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 
-		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
+		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), connectRequest.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 	}
@@ -184,7 +190,7 @@ func resourceAlicloudRouterInterfaceConnectionRead(d *schema.ResourceData, meta
 		if err := vpcService.ActivateRouterInterface(d.Id()); err != nil {
 			return WrapError(err)
 		}
-		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
+		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, int(d.Timeout(schema.TimeoutRead).Seconds())); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -223,5 +229,5 @@ func resourceAlicloudRouterInterfaceConnectionDelete(d *schema.ResourceData, met
 		}
 	}
 
-	return WrapError(vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Inactive, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Inactive, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_router_interface", &resource.Sweeper{
+	addTestSweepers("alicloud_router_interface", &resource.Sweeper{
 		Name: "alicloud_router_interface",
 		F:    testSweepRouterInterfaces,
 	})
@@ -79,6 +79,7 @@ func testSweepRouterInterfaces(region string) error {
 				skip = !need
 			}
 		}
+		skip = sweepSkip("alicloud_router_interface", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Router Interface: %s (%s)", name, id)
 			continue
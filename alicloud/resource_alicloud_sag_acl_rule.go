@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/smartag"
@@ -19,6 +20,10 @@ func resourceAlicloudSagAclRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"acl_id": {
 				Type:     schema.TypeString,
@@ -206,5 +211,5 @@ func resourceAlicloudSagAclRuleDelete(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(sagService.WaitForSagAclRule(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(sagService.WaitForSagAclRule(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -2,14 +2,99 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/smartag"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
+func init() {
+	addTestSweepers("alicloud_sag_acl", &resource.Sweeper{
+		Name: "alicloud_sag_acl",
+		F:    testSweepSagAcls,
+	})
+}
+
+func testSweepSagAcls(region string) error {
+	if testSweepPreCheckWithRegions(region, true, connectivity.SmartagSupportedRegions) {
+		log.Printf("[INFO] Skipping Smartag unsupported region: %s", region)
+		return nil
+	}
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+		"tf-testSag",
+	}
+
+	var acls []smartag.Acl
+	request := smartag.CreateDescribeACLsRequest()
+	request.RegionId = client.RegionId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
+			return sagClient.DescribeACLs(request)
+		})
+		if err != nil {
+			return fmt.Errorf("Error retrieving Sag Acls: %s", err)
+		}
+		response, _ := raw.(*smartag.DescribeACLsResponse)
+		if len(response.Acls.Acl) < 1 {
+			break
+		}
+		acls = append(acls, response.Acls.Acl...)
+
+		if len(response.Acls.Acl) < PageSizeLarge {
+			break
+		}
+
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return err
+		}
+		request.PageNumber = page
+	}
+
+	for _, v := range acls {
+		name := v.Name
+		id := v.AclId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		skip = sweepSkip("alicloud_sag_acl", skip, v)
+		if skip {
+			log.Printf("[INFO] Skipping Sag Acl: %s (%s)", name, id)
+			continue
+		}
+		log.Printf("[INFO] Deleting Sag Acl: %s (%s)", name, id)
+		deleteRequest := smartag.CreateDeleteACLRequest()
+		deleteRequest.RegionId = client.RegionId
+		deleteRequest.AclId = id
+		_, err := client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
+			return sagClient.DeleteACL(deleteRequest)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete Sag Acl (%s (%s)): %s", name, id, err)
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudSagAcl_basic(t *testing.T) {
 	var acl smartag.Acl
 	resourceId := "alicloud_sag_acl.default"
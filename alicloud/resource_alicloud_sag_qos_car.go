@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/smartag"
@@ -19,6 +20,10 @@ func resourceAlicloudSagQosCar() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"qos_id": {
 				Type:     schema.TypeString,
@@ -214,5 +219,5 @@ func resourceAlicloudSagQosCarDelete(d *schema.ResourceData, meta interface{}) e
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(sagService.WaitForSagQosCar(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(sagService.WaitForSagQosCar(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -2,14 +2,99 @@ package alicloud
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/smartag"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
+func init() {
+	addTestSweepers("alicloud_sag_qos", &resource.Sweeper{
+		Name: "alicloud_sag_qos",
+		F:    testSweepSagQoses,
+	})
+}
+
+func testSweepSagQoses(region string) error {
+	if testSweepPreCheckWithRegions(region, true, connectivity.SmartagSupportedRegions) {
+		log.Printf("[INFO] Skipping Smartag unsupported region: %s", region)
+		return nil
+	}
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+		"tf-testSag",
+	}
+
+	var qoses []smartag.Qos
+	request := smartag.CreateDescribeQosesRequest()
+	request.RegionId = client.RegionId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
+			return sagClient.DescribeQoses(request)
+		})
+		if err != nil {
+			return fmt.Errorf("Error retrieving Sag Qoss: %s", err)
+		}
+		response, _ := raw.(*smartag.DescribeQosesResponse)
+		if len(response.Qoses.Qos) < 1 {
+			break
+		}
+		qoses = append(qoses, response.Qoses.Qos...)
+
+		if len(response.Qoses.Qos) < PageSizeLarge {
+			break
+		}
+
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return err
+		}
+		request.PageNumber = page
+	}
+
+	for _, v := range qoses {
+		name := v.QosName
+		id := v.QosId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		skip = sweepSkip("alicloud_sag_qos", skip, v)
+		if skip {
+			log.Printf("[INFO] Skipping Sag Qos: %s (%s)", name, id)
+			continue
+		}
+		log.Printf("[INFO] Deleting Sag Qos: %s (%s)", name, id)
+		deleteRequest := smartag.CreateDeleteQosRequest()
+		deleteRequest.RegionId = client.RegionId
+		deleteRequest.QosId = id
+		_, err := client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
+			return sagClient.DeleteQos(deleteRequest)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete Sag Qos (%s (%s)): %s", name, id, err)
+		}
+	}
+	return nil
+}
+
 func TestAccAlicloudSagQos_basic(t *testing.T) {
 	var qos smartag.Qos
 	resourceId := "alicloud_sag_qos.default"
@@ -19,6 +19,12 @@ func resourceAliyunSecurityGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -66,7 +72,8 @@ func resourceAliyunSecurityGroup() *schema.Resource {
 					return d.Get("security_group_type").(string) == "enterprise"
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -147,8 +154,8 @@ func resourceAliyunSecurityGroupRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil && !NotFoundError(err) {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	return nil
@@ -163,6 +170,7 @@ func resourceAliyunSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		return WrapError(err)
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("inner_access_policy") || d.HasChange("inner_access") || d.IsNewResource() {
@@ -250,6 +258,6 @@ func resourceAliyunSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return WrapErrorf(err, DefaultTimeoutMsg, d.Id(), request.GetActionName(), ProviderERROR)
 	}
-	return WrapError(ecsService.WaitForSecurityGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ecsService.WaitForSecurityGroup(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 
 }
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_security_group", &resource.Sweeper{
+	addTestSweepers("alicloud_security_group", &resource.Sweeper{
 		Name: "alicloud_security_group",
 		F:    testSweepSecurityGroups,
 		//When implemented, these should be removed firstly
@@ -86,6 +86,7 @@ func testSweepSecurityGroups(region string) error {
 				skip = !need
 			}
 		}
+		skip = sweepSkip("alicloud_security_group", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Security Group: %s (%s)", name, id)
 			continue
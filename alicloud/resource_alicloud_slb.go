@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -20,6 +21,13 @@ func resourceAliyunSlb() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffAll(productAvailableCustomizeDiff(connectivity.SLBCode), tagsAllCustomizeDiff, tagApiTagsCustomizeDiff),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -233,6 +241,8 @@ func resourceAliyunSlb() *schema.Resource {
 				ValidateFunc: validateSlbInstanceTagNum,
 			},
 
+			"tags_all": tagsAllSchema(),
+
 			"instance_charge_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -386,7 +396,7 @@ func resourceAliyunSlbCreate(d *schema.ResourceData, meta interface{}) error {
 	response, _ := raw.(*slb.CreateLoadBalancerResponse)
 	d.SetId(response.LoadBalancerId)
 
-	if err := slbService.WaitForSlb(response.LoadBalancerId, Active, DefaultTimeout); err != nil {
+	if err := slbService.WaitForSlb(response.LoadBalancerId, Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -430,11 +440,13 @@ func resourceAliyunSlbRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("instance_charge_type", PostPaid)
 	}
 	d.Set("delete_protection", object.DeleteProtection)
-	tags, _ := slbService.describeTags(d.Id())
-	if len(tags) > 0 {
-		if err := d.Set("tags", slbService.slbTagsToMap(tags)); err != nil {
-			return WrapError(err)
-		}
+	tagService := TagService{client}
+	tags, err := tagService.ListResourceTags(d.Id(), connectivity.SLBCode, TagResourceInstance)
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := setTagsAndTagsAll(client, d, tags); err != nil {
+		return WrapError(err)
 	}
 	return nil
 }
@@ -442,11 +454,11 @@ func resourceAliyunSlbRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAliyunSlbUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AliyunClient)
-	slbService := SlbService{client}
 	d.Partial(true)
 
 	// set instance tags
-	if err := slbService.setSlbInstanceTags(d); err != nil {
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, connectivity.SLBCode, TagResourceInstance); err != nil {
 		return WrapError(err)
 	}
 
@@ -587,5 +599,5 @@ func resourceAliyunSlbDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(slbService.WaitForSlb(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlb(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
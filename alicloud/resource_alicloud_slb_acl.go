@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +19,10 @@ func resourceAlicloudSlbAcl() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -155,5 +160,5 @@ func resourceAlicloudSlbAclDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return WrapError(slbService.WaitForSlbAcl(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlbAcl(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -12,7 +12,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_slb_acl", &resource.Sweeper{
+	addTestSweepers("alicloud_slb_acl", &resource.Sweeper{
 		Name: "alicloud_slb_acl",
 		F:    testSweepSlbAcl,
 	})
@@ -51,6 +51,7 @@ func testSweepSlbAcl(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_slb_acl", skip, acl)
 		if skip {
 			log.Printf("[INFO] Skipping Slb Acl: %s (%s)", name, id)
 			continue
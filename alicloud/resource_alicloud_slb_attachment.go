@@ -20,6 +20,12 @@ func resourceAliyunSlbAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"slb_id": {
@@ -125,6 +131,10 @@ func resourceAliyunSlbAttachmentUpdate(d *schema.ResourceData, meta interface{})
 	update := false
 	weight := d.Get("weight").(int)
 	oldServerType, serverType := d.GetChange("server_type")
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
 
 	if d.HasChange("server_type") {
 		update = true
@@ -146,7 +156,7 @@ func resourceAliyunSlbAttachmentUpdate(d *schema.ResourceData, meta interface{})
 			request.RegionId = client.RegionId
 			request.LoadBalancerId = d.Id()
 			request.BackendServers = expandBackendServersToString(ns.Difference(os).List(), weight, serverType.(string))
-			if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			if err := resource.Retry(timeout, func() *resource.RetryError {
 				raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 					return slbClient.AddBackendServers(request)
 				})
@@ -167,7 +177,7 @@ func resourceAliyunSlbAttachmentUpdate(d *schema.ResourceData, meta interface{})
 			request.RegionId = client.RegionId
 			request.LoadBalancerId = d.Id()
 			request.BackendServers = expandBackendServersToString(os.Difference(ns).List(), weight, oldServerType.(string))
-			if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			if err := resource.Retry(timeout, func() *resource.RetryError {
 				raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 					return slbClient.RemoveBackendServers(request)
 				})
@@ -195,7 +205,7 @@ func resourceAliyunSlbAttachmentUpdate(d *schema.ResourceData, meta interface{})
 		request.RegionId = client.RegionId
 		request.LoadBalancerId = d.Id()
 		request.BackendServers = expandBackendServersToString(d.Get("instance_ids").(*schema.Set).List(), weight, serverType.(string))
-		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := resource.Retry(timeout, func() *resource.RetryError {
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 				return slbClient.SetBackendServers(request)
 			})
@@ -227,7 +237,7 @@ func resourceAliyunSlbAttachmentDelete(d *schema.ResourceData, meta interface{})
 		request.RegionId = client.RegionId
 		request.LoadBalancerId = d.Id()
 		request.BackendServers = expandBackendServersToString(d.Get("instance_ids").(*schema.Set).List(), weight, serverType)
-		if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		if err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 				return slbClient.RemoveBackendServers(request)
 			})
@@ -243,5 +253,5 @@ func resourceAliyunSlbAttachmentDelete(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 	}
-	return WrapError(slbService.WaitSlbAttribute(d.Id(), instanceSet, DefaultTimeout))
+	return WrapError(slbService.WaitSlbAttribute(d.Id(), instanceSet, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -19,6 +19,10 @@ func resourceAlicloudSlbCACertificate() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -144,5 +148,5 @@ func resourceAlicloudSlbCACertificateDelete(d *schema.ResourceData, meta interfa
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(slbService.WaitForSlbCACertificate(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlbCACertificate(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
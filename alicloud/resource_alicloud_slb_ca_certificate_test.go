@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_slb_ca_certificate", &resource.Sweeper{
+	addTestSweepers("alicloud_slb_ca_certificate", &resource.Sweeper{
 		Name: "alicloud_slb_ca_certificate",
 		F:    testSweepSlbCACertificate,
 	})
@@ -53,6 +53,7 @@ func testSweepSlbCACertificate(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_slb_ca_certificate", skip, caCertificate)
 		if skip {
 			log.Printf("[INFO] Skipping Slb CA Certificate: %s (%s)", name, id)
 			continue
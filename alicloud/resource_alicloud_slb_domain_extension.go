@@ -20,6 +20,10 @@ func resourceAlicloudSlbDomainExtension() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -153,5 +157,5 @@ func resourceAliyunSlbDomainExtensionDelete(d *schema.ResourceData, meta interfa
 		return WrapErrorf(err, DataDefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 
 	}
-	return WrapError(slbService.WaitForSlbDomainExtension(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlbDomainExtension(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -24,6 +24,10 @@ func resourceAliyunSlbRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -364,6 +368,6 @@ func resourceAliyunSlbRuleDelete(d *schema.ResourceData, meta interface{}) error
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return WrapError(slbService.WaitForSlbRule(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlbRule(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 
 }
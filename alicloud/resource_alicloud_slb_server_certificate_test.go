@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_slb_server_certificate", &resource.Sweeper{
+	addTestSweepers("alicloud_slb_server_certificate", &resource.Sweeper{
 		Name: "alicloud_slb_server_certificate",
 		F:    testSweepSlbServerCertificate,
 	})
@@ -51,6 +51,7 @@ func testSweepSlbServerCertificate(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_slb_server_certificate", skip, serverCertificate)
 		if skip {
 			log.Printf("[INFO] Skipping Slb Server Certificate: %s (%s)", name, id)
 			continue
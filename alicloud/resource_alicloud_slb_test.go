@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_slb", &resource.Sweeper{
+	addTestSweepers("alicloud_slb", &resource.Sweeper{
 		Name: "alicloud_slb",
 		F:    testSweepSLBs,
 		// When implemented, these should be removed firstly
@@ -102,6 +102,7 @@ func testSweepSLBs(region string) error {
 				}
 			}
 		}
+		skip = sweepSkip("alicloud_slb", skip, loadBalancer)
 		if skip {
 			log.Printf("[INFO] Skipping SLB: %s (%s)", name, id)
 			continue
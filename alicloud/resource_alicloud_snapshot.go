@@ -25,6 +25,8 @@ func resourceAliyunSnapshot() *schema.Resource {
 			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
 				Type:     schema.TypeString,
@@ -41,7 +43,8 @@ func resourceAliyunSnapshot() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -101,8 +104,8 @@ func resourceAliyunSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil && !NotFoundError(err) {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	return nil
@@ -21,6 +21,11 @@ func resourceAliyunSnapshotPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -65,7 +70,7 @@ func resourceAliyunSnapshotPolicyCreate(d *schema.ResourceData, meta interface{}
 	d.SetId(response.AutoSnapshotPolicyId)
 
 	ecsService := EcsService{client}
-	if err := ecsService.WaitForSnapshotPolicy(d.Id(), SnapshotPolicyNormal, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForSnapshotPolicy(d.Id(), SnapshotPolicyNormal, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -152,5 +157,5 @@ func resourceAliyunSnapshotPolicyDelete(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(ecsService.WaitForSnapshotPolicy(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ecsService.WaitForSnapshotPolicy(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_snapshot_policy", &resource.Sweeper{
+	addTestSweepers("alicloud_snapshot_policy", &resource.Sweeper{
 		Name: "alicloud_snapshot_policy",
 		F:    testSweepSnapshotPolicy,
 	})
@@ -72,6 +72,7 @@ func testSweepSnapshotPolicy(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_snapshot_policy", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping snapshot: %s (%s)", name, id)
 			continue
@@ -16,9 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_snapshot", &resource.Sweeper{
+	addTestSweepers("alicloud_snapshot", &resource.Sweeper{
 		Name: "alicloud_snapshot",
 		F:    testSweepSnapshots,
+		Dependencies: []string{
+			"alicloud_image",
+		},
 	})
 }
 
@@ -74,6 +77,7 @@ func testSweepSnapshots(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_snapshot", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping snapshot: %s (%s)", name, id)
 			continue
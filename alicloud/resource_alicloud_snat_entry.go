@@ -23,6 +23,12 @@ func resourceAliyunSnatEntry() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"snat_table_id": {
 				Type:     schema.TypeString,
@@ -75,7 +81,7 @@ func resourceAliyunSnatEntryCreate(d *schema.ResourceData, meta interface{}) err
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_snat_entry", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	if err := vpcService.WaitForSnatEntry(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForSnatEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -135,7 +141,7 @@ func resourceAliyunSnatEntryUpdate(d *schema.ResourceData, meta interface{}) err
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		if err := vpcService.WaitForSnatEntry(d.Id(), Available, DefaultTimeout); err != nil {
+		if err := vpcService.WaitForSnatEntry(d.Id(), Available, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -177,5 +183,5 @@ func resourceAliyunSnatEntryDelete(d *schema.ResourceData, meta interface{}) err
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForSnatEntry(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForSnatEntry(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -19,6 +19,11 @@ func resourceAliyunSslVpnClientCert() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"ssl_vpn_server_id": {
 				Type:     schema.TypeString,
@@ -73,7 +78,7 @@ func resourceAliyunSslVpnClientCertCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(response.SslVpnClientCertId)
 
-	err = vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Ssl_Cert_Normal, DefaultTimeout)
+	err = vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Ssl_Cert_Normal, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
@@ -149,5 +154,5 @@ func resourceAliyunSslVpnClientCertDelete(d *schema.ResourceData, meta interface
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
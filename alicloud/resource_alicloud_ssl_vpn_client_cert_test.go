@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ssl_vpn_client_cert", &resource.Sweeper{
+	addTestSweepers("alicloud_ssl_vpn_client_cert", &resource.Sweeper{
 		Name: "alicloud_ssl_vpn_client_cert",
 		F:    testSweepSslVpnClientCerts,
 	})
@@ -78,6 +78,7 @@ func testSweepSslVpnClientCerts(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ssl_vpn_client_cert", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Ssl Client Cert: %s (%s)", name, id)
 			continue
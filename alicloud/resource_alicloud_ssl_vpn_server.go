@@ -20,6 +20,11 @@ func resourceAliyunSslVpnServer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
 				Type:     schema.TypeString,
@@ -123,7 +128,7 @@ func resourceAliyunSslVpnServerCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(response.SslVpnServerId)
-	err = vpnGatewayService.WaitForSslVpnServer(d.Id(), Null, DefaultTimeout)
+	err = vpnGatewayService.WaitForSslVpnServer(d.Id(), Null, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 
 	if err != nil {
 		return WrapError(err)
@@ -241,5 +246,5 @@ func resourceAliyunSslVpnServerDelete(d *schema.ResourceData, meta interface{})
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpnGatewayService.WaitForSslVpnServer(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpnGatewayService.WaitForSslVpnServer(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
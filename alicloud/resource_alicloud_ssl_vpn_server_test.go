@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_ssl_vpn_server", &resource.Sweeper{
+	addTestSweepers("alicloud_ssl_vpn_server", &resource.Sweeper{
 		Name: "alicloud_ssl_vpn_server",
 		F:    testSweepSslVpnServers,
 	})
@@ -77,6 +77,7 @@ func testSweepSslVpnServers(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_ssl_vpn_server", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping Ssl Vpn Server: %s (%s)", name, id)
 			continue
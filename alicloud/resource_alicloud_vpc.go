@@ -22,6 +22,13 @@ func resourceAliyunVpc() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
 				Type:         schema.TypeString,
@@ -55,7 +62,8 @@ func resourceAliyunVpc() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateStringLengthInRange(2, 256),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"router_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -104,7 +112,7 @@ func resourceAliyunVpcCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(response.VpcId)
 
-	err = vpcService.WaitForVpc(d.Id(), Available, DefaultTimeout)
+	err = vpcService.WaitForVpc(d.Id(), Available, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -134,7 +142,9 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", vpcService.tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, vpcService.tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
 	// Retrieve all route tables and filter to get system
 	request := vpc.CreateDescribeRouteTablesRequest()
 	request.RegionId = client.RegionId
@@ -244,7 +254,7 @@ func resourceAliyunVpcDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForVpc(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForVpc(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func buildAliyunVpcArgs(d *schema.ResourceData, meta interface{}) *vpc.CreateVpcRequest {
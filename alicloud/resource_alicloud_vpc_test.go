@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_vpc", &resource.Sweeper{
+	addTestSweepers("alicloud_vpc", &resource.Sweeper{
 		Name: "alicloud_vpc",
 		F:    testSweepVpcs,
 		// When implemented, these should be removed firstly
@@ -27,6 +27,7 @@ func init() {
 			"alicloud_ots_instance",
 			"alicloud_router_interface",
 			"alicloud_route_table",
+			"alicloud_cen_instance_attachment",
 		},
 	})
 }
@@ -90,6 +91,7 @@ func testSweepVpcs(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_vpc", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping VPC: %s (%s)", name, id)
 			continue
@@ -154,7 +156,8 @@ func testAccCheckVpcDestroy(s *terraform.State) error {
 
 func TestAccAlicloudVpcBasic(t *testing.T) {
 	var v vpc.DescribeVpcAttributeResponse
-	rand := acctest.RandInt()
+	recorder := testAccRecorder(t)
+	rand := testAccRecordedRandInt(recorder)
 	resourceId := "alicloud_vpc.default"
 	ra := resourceAttrInit(resourceId, testAccCheckVpcCheckMap)
 	serviceFunc := func() interface{} {
@@ -163,14 +166,13 @@ func TestAccAlicloudVpcBasic(t *testing.T) {
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
-	resource.Test(t, resource.TestCase{
+	testAccRecordedTest(t, recorder, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
 
 		// module name
 		IDRefreshName: resourceId,
-		Providers:     testAccProviders,
 		CheckDestroy:  testAccCheckVpcDestroy,
 		Steps: []resource.TestStep{
 			{
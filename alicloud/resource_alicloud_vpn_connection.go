@@ -23,6 +23,11 @@ func resourceAliyunVpnConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"customer_gateway_id": {
 				Type:     schema.TypeString,
@@ -202,7 +207,7 @@ func resourceAliyunVpnConnectionCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(response.VpnConnectionId)
 
-	if err := vpnGatewayService.WaitForVpnConnection(d.Id(), Null, DefaultTimeoutMedium); err != nil {
+	if err := vpnGatewayService.WaitForVpnConnection(d.Id(), Null, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 
@@ -323,7 +328,7 @@ func resourceAliyunVpnConnectionDelete(d *schema.ResourceData, meta interface{})
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpnGatewayService.WaitForVpnConnection(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpnGatewayService.WaitForVpnConnection(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func buildAliyunVpnConnectionArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateVpnConnectionRequest, error) {
@@ -19,6 +19,11 @@ func resourceAliyunVpnCustomerGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"ip_address": {
 				Type:         schema.TypeString,
@@ -82,7 +87,7 @@ func resourceAliyunVpnCustomerGatewayCreate(d *schema.ResourceData, meta interfa
 
 	d.SetId(response.CustomerGatewayId)
 
-	err = vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Null, 60)
+	err = vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Null, int(d.Timeout(schema.TimeoutCreate).Seconds()))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -166,5 +171,5 @@ func resourceAliyunVpnCustomerGatewayDelete(d *schema.ResourceData, meta interfa
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_vpn_customer_gateway", &resource.Sweeper{
+	addTestSweepers("alicloud_vpn_customer_gateway", &resource.Sweeper{
 		Name: "alicloud_vpn_customer_gateway",
 		F:    testSweepVPNCustomerGateways,
 	})
@@ -79,6 +79,7 @@ func testSweepVPNCustomerGateways(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_vpn_customer_gateway", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping VPN Customer Gateway: %s (%s)", name, id)
 			continue
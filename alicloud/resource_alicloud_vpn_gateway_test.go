@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_vpn_gateway", &resource.Sweeper{
+	addTestSweepers("alicloud_vpn_gateway", &resource.Sweeper{
 		Name: "alicloud_vpn_gateway",
 		F:    testSweepVPNGateways,
 		Dependencies: []string{
@@ -82,6 +82,7 @@ func testSweepVPNGateways(region string) error {
 				break
 			}
 		}
+		skip = sweepSkip("alicloud_vpn_gateway", skip, v)
 		if skip {
 			log.Printf("[INFO] Skipping VPN Gateway: %s (%s)", name, id)
 			continue
@@ -20,6 +20,11 @@ func resourceAliyunVpnRouteEntry() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
 				Type:     schema.TypeString,
@@ -91,7 +96,7 @@ func resourceAliyunVpnRouteEntryCreate(d *schema.ResourceData, meta interface{})
 	id := response.VpnInstanceId + ":" + response.NextHop + ":" + response.RouteDest
 	d.SetId(id)
 
-	if err := vpnRouteEntryService.WaitForVpnRouteEntry(d.Id(), Active, 2*DefaultTimeoutMedium); err != nil {
+	if err := vpnRouteEntryService.WaitForVpnRouteEntry(d.Id(), Active, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunVpnRouteEntryRead(d, meta)
@@ -203,5 +208,5 @@ func resourceAliyunVpnRouteEntryDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpnRouteEntryService.WaitForVpnRouteEntry(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpnRouteEntryService.WaitForVpnRouteEntry(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
@@ -19,6 +19,13 @@ func resourceAliyunSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -44,7 +51,8 @@ func resourceAliyunSubnet() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -79,7 +87,7 @@ func resourceAliyunSwitchCreate(d *schema.ResourceData, meta interface{}) error
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_vswitch", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	d.SetId(vswitchID)
-	if err := vpcService.WaitForVSwitch(vswitchID, Available, DefaultTimeoutMedium); err != nil {
+	if err := vpcService.WaitForVSwitch(vswitchID, Available, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunSwitchUpdate(d, meta)
@@ -106,7 +114,9 @@ func resourceAliyunSwitchRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", vpcService.tagsToMap(tags))
+	if err := setTagsAndTagsAll(client, d, vpcService.tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
 	return nil
 }
 
@@ -172,7 +182,7 @@ func resourceAliyunSwitchDelete(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForVSwitch(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForVSwitch(d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
 }
 
 func buildAliyunSwitchArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateVSwitchRequest, error) {
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("alicloud_vswitch", &resource.Sweeper{
+	addTestSweepers("alicloud_vswitch", &resource.Sweeper{
 		Name: "alicloud_vswitch",
 		F:    testSweepVSwitches,
 		// When implemented, these should be removed firstly
@@ -26,7 +26,7 @@ func init() {
 			"alicloud_instance",
 			"alicloud_db_instance",
 			"alicloud_slb",
-			"alicloud_ess_scalinggroup",
+			"alicloud_ess_scaling_group",
 			"alicloud_fc_service",
 			"alicloud_cs_cluster",
 			"alicloud_kvstore_instance",
@@ -38,6 +38,7 @@ func init() {
 			"alicloud_vpn_gateway",
 			"alicloud_mongodb_instance",
 			"alicloud_mongodb_sharding_instance",
+			"alicloud_nas_mount_target",
 			"alicloud_gpdb_instance",
 		},
 	})
@@ -108,6 +109,7 @@ func testSweepVSwitches(region string) error {
 				skip = !need
 			}
 		}
+		skip = sweepSkip("alicloud_vswitch", skip, vsw)
 		if skip {
 			log.Printf("[INFO] Skipping VSwitch: %s (%s)", name, id)
 			continue
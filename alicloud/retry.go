@@ -0,0 +1,103 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// Invoker runs an API call and retries it when the call fails with the error of one of its catchers.
+// The waiting time between two attempts grows exponentially according to its retry policy.
+type Invoker struct {
+	catchers []*Catcher
+	policy   connectivity.RetryPolicy
+	sleep    func(time.Duration)
+}
+
+// Catcher defines a retryable error code. RetryCount is the max number of attempts for the error code,
+// and 0 means using the max attempts of the retry policy. RetryWaitSeconds is the waiting time after the first failure.
+type Catcher struct {
+	Reason           string
+	RetryCount       int
+	RetryWaitSeconds int
+}
+
+const DefaultRetryWaitSeconds = 5
+
+var ClientErrorCatcher = Catcher{AliyunGoClientFailure, 0, 5}
+var ServiceBusyCatcher = Catcher{"ServiceUnavailable", 0, 5}
+var ThrottlingCatcher = Catcher{Throttling, 0, 10}
+
+// NewInvoker returns an invoker with the default retry policy.
+func NewInvoker() Invoker {
+	return NewInvokerWithPolicy(connectivity.DefaultRetryPolicy())
+}
+
+// NewProductInvoker returns an invoker with the retry policy of the specified product set in the provider block.
+func NewProductInvoker(client *connectivity.AliyunClient, serviceCode connectivity.ServiceCode) Invoker {
+	return NewInvokerWithPolicy(client.GetRetryPolicy(serviceCode))
+}
+
+func NewInvokerWithPolicy(policy connectivity.RetryPolicy) Invoker {
+	i := Invoker{policy: policy}
+	i.AddCatcher(ClientErrorCatcher)
+	i.AddCatcher(ServiceBusyCatcher)
+	i.AddCatcher(ThrottlingCatcher)
+	for _, code := range policy.ExtraRetryableCodes {
+		i.AddCatcher(Catcher{code, 0, DefaultRetryWaitSeconds})
+	}
+	return i
+}
+
+func (a *Invoker) AddCatcher(catcher Catcher) {
+	a.catchers = append(a.catchers, &catcher)
+}
+
+func (a *Invoker) Run(f func() error) error {
+	policy := a.policy
+	if policy.MaxAttempts <= 0 {
+		policy = connectivity.DefaultRetryPolicy()
+	}
+	sleep := a.sleep
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+
+	// The attempts are counted for the whole call, so switching between the retryable errors does not extend it.
+	// The max attempts is decided by the catcher of the last error.
+	attempts := 0
+	for {
+		err := f()
+		if err == nil {
+			return nil
+		}
+
+		catcher := a.catch(err)
+		if catcher == nil {
+			return err
+		}
+		attempts++
+		maxAttempts := catcher.RetryCount
+		if maxAttempts <= 0 {
+			maxAttempts = policy.MaxAttempts
+		}
+		if attempts >= maxAttempts {
+			return fmt.Errorf("Retry timeout and got an error: %#v.", err)
+		}
+
+		backoff := policy.Backoff(attempts, time.Duration(catcher.RetryWaitSeconds)*time.Second)
+		log.Printf("[DEBUG] Retrying the error %s (attempt %d/%d) after %s.", catcher.Reason, attempts, maxAttempts, backoff)
+		sleep(backoff)
+	}
+}
+
+func (a *Invoker) catch(err error) *Catcher {
+	for _, catcher := range a.catchers {
+		if IsExceptedErrors(err, []string{catcher.Reason}) {
+			return catcher
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package alicloud
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func testInvoker(policy connectivity.RetryPolicy, sleeps *[]time.Duration) Invoker {
+	invoker := NewInvokerWithPolicy(policy)
+	invoker.sleep = func(d time.Duration) {
+		*sleeps = append(*sleeps, d)
+	}
+	return invoker
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := connectivity.RetryPolicy{MaxAttempts: 10, MaxBackoff: 30 * time.Second}
+	expected := []time.Duration{5, 10, 20, 30, 30}
+	for i, e := range expected {
+		if backoff := policy.Backoff(i+1, 5*time.Second); backoff != e*time.Second {
+			t.Fatalf("attempt %d: expected backoff %s, got %s.", i+1, e*time.Second, backoff)
+		}
+	}
+
+	policy.Jitter = true
+	for attempt := 1; attempt <= 10; attempt++ {
+		full := (connectivity.RetryPolicy{MaxBackoff: policy.MaxBackoff}).Backoff(attempt, 2*time.Second)
+		backoff := policy.Backoff(attempt, 2*time.Second)
+		if backoff < full/2 || backoff > full {
+			t.Fatalf("attempt %d: the jittered backoff %s should be between %s and %s.", attempt, backoff, full/2, full)
+		}
+	}
+}
+
+func TestRetryPolicyMerge(t *testing.T) {
+	policy := connectivity.RetryPolicy{MaxAttempts: 10, MaxBackoff: 30 * time.Second, Jitter: true, ExtraRetryableCodes: []string{"A"}}
+	merged := policy.Merge(connectivity.RetryPolicy{MaxAttempts: 20, ExtraRetryableCodes: []string{"B"}})
+	if merged.MaxAttempts != 20 || merged.MaxBackoff != 30*time.Second || !merged.Jitter {
+		t.Fatalf("unexpected merged policy %#v.", merged)
+	}
+	if strings.Join(merged.ExtraRetryableCodes, ",") != "A,B" {
+		t.Fatalf("expected extra retryable codes A,B, got %v.", merged.ExtraRetryableCodes)
+	}
+	if len(policy.ExtraRetryableCodes) != 1 {
+		t.Fatalf("merging should not modify the origin policy, got %v.", policy.ExtraRetryableCodes)
+	}
+}
+
+func TestInvokerRetryUntilSuccess(t *testing.T) {
+	var sleeps []time.Duration
+	invoker := testInvoker(connectivity.RetryPolicy{MaxAttempts: 5, MaxBackoff: time.Minute}, &sleeps)
+	calls := 0
+	err := invoker.Run(func() error {
+		calls++
+		if calls < 3 {
+			return Error("%s: Request was denied due to request throttling.", Throttling)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected success, got %#v.", err)
+	}
+	if calls != 3 || len(sleeps) != 2 {
+		t.Fatalf("expected 3 calls and 2 waits, got %d calls and %d waits.", calls, len(sleeps))
+	}
+	if sleeps[0] != 10*time.Second || sleeps[1] != 20*time.Second {
+		t.Fatalf("expected exponential waits 10s and 20s, got %v.", sleeps)
+	}
+}
+
+func TestInvokerRetryMaxAttempts(t *testing.T) {
+	var sleeps []time.Duration
+	invoker := testInvoker(connectivity.RetryPolicy{MaxAttempts: 4, MaxBackoff: time.Minute}, &sleeps)
+	calls := 0
+	err := invoker.Run(func() error {
+		calls++
+		return Error("ServiceUnavailable: The request has failed due to a temporary failure of the server.")
+	})
+	if err == nil || !strings.Contains(err.Error(), "Retry timeout") {
+		t.Fatalf("expected a retry timeout error, got %#v.", err)
+	}
+	if calls != 4 {
+		t.Fatalf("expected 4 attempts, got %d.", calls)
+	}
+
+	// The attempts are counted for each run, so the invoker can be reused.
+	calls = 0
+	invoker.Run(func() error {
+		calls++
+		return Error("ServiceUnavailable")
+	})
+	if calls != 4 {
+		t.Fatalf("expected 4 attempts in the second run, got %d.", calls)
+	}
+}
+
+func TestInvokerNonRetryableError(t *testing.T) {
+	var sleeps []time.Duration
+	invoker := testInvoker(connectivity.DefaultRetryPolicy(), &sleeps)
+	calls := 0
+	err := invoker.Run(func() error {
+		calls++
+		return Error("InvalidParameter")
+	})
+	if err == nil || calls != 1 || len(sleeps) != 0 {
+		t.Fatalf("a non-retryable error should be returned at once, got %d calls and error %#v.", calls, err)
+	}
+}
+
+func TestInvokerExtraRetryableCodes(t *testing.T) {
+	var sleeps []time.Duration
+	invoker := testInvoker(connectivity.RetryPolicy{MaxAttempts: 3, ExtraRetryableCodes: []string{"IncorrectVSwitchStatus"}}, &sleeps)
+	calls := 0
+	err := invoker.Run(func() error {
+		calls++
+		return Error("IncorrectVSwitchStatus")
+	})
+	if err == nil || calls != 3 {
+		t.Fatalf("expected 3 attempts for the extra retryable code, got %d.", calls)
+	}
+}
+
+func TestInvokerCatcherRetryCount(t *testing.T) {
+	var sleeps []time.Duration
+	invoker := testInvoker(connectivity.RetryPolicy{MaxAttempts: 10}, &sleeps)
+	invoker.AddCatcher(Catcher{"OperationDenied.DBInstanceStatus", 2, 1})
+	calls := 0
+	invoker.Run(func() error {
+		calls++
+		return Error("OperationDenied.DBInstanceStatus")
+	})
+	if calls != 2 {
+		t.Fatalf("the retry count of the catcher should take precedence over the policy, got %d attempts.", calls)
+	}
+}
+
+func TestInvokerAttemptsPerCall(t *testing.T) {
+	var sleeps []time.Duration
+	invoker := testInvoker(connectivity.RetryPolicy{MaxAttempts: 4, MaxBackoff: time.Minute}, &sleeps)
+	calls := 0
+	invoker.Run(func() error {
+		calls++
+		// Switching between two retryable errors should not reset the attempts
+		if calls%2 == 0 {
+			return Error("ServiceUnavailable")
+		}
+		return Error(Throttling)
+	})
+	if calls != 4 {
+		t.Fatalf("the attempts of different errors should be counted together, got %d attempts.", calls)
+	}
+}
+
+func TestRetryPolicyMergeJitter(t *testing.T) {
+	policy := connectivity.RetryPolicy{MaxAttempts: 10, Jitter: true}
+	if merged := policy.Merge(connectivity.RetryPolicy{}); !merged.Jitter {
+		t.Fatalf("an override without jitter should inherit it.")
+	}
+	disabled := false
+	if merged := policy.Merge(connectivity.RetryPolicy{JitterOverride: &disabled}); merged.Jitter {
+		t.Fatalf("an override should be able to disable the jitter.")
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
-
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
@@ -135,19 +133,15 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaTopic(id string) (*alika
 	request.InstanceId = instanceId
 	request.RegionId = alikafkaService.client.RegionId
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(alikafkaService.client, connectivity.ALIKAFKACode)
+	err = invoker.Run(func() error {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.GetTopicList(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, AlikafkaThrottlingUser) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
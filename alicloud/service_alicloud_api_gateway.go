@@ -387,7 +387,7 @@ func (s *CloudApiService) DescribeTags(resourceId string, resourceTags map[strin
 }
 
 func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := d.GetChange("tags_all")
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -427,6 +427,7 @@ func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType T
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return nil
 }
@@ -0,0 +1,177 @@
+package alicloud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+type BssOpenApiService struct {
+	client *connectivity.AliyunClient
+}
+
+const (
+	SubscriptionTypePayAsYouGo   = "PayAsYouGo"
+	SubscriptionTypeSubscription = "Subscription"
+)
+
+const (
+	RenewalPeriodUnitMonth = "M"
+	RenewalPeriodUnitYear  = "Y"
+)
+
+// The product codes of the subscription instances which are managed by the resources of the provider.
+const (
+	BssProductCodeEcs     = "ecs"
+	BssProductCodeRds     = "rds"
+	BssProductCodeKvstore = "redisa"
+	BssProductCodeNat     = "nat_gw"
+)
+
+// SubscriptionFilter narrows the subscription instances returned by QueryAvailableInstances.
+type SubscriptionFilter struct {
+	ProductCode   string
+	ProductType   string
+	InstanceIds   []string
+	RenewalStatus string
+	Region        string
+	EndTimeStart  time.Time
+	EndTimeEnd    time.Time
+}
+
+// retryBssOpenApi calls the BSS OpenAPI and retries it according to the retry policy of the product.
+func (s *BssOpenApiService) retryBssOpenApi(do func(*bssopenapi.Client) (interface{}, error)) (raw interface{}, err error) {
+	invoker := NewProductInvoker(s.client, connectivity.BSSOPENAPICode)
+	err = invoker.Run(func() error {
+		raw, err = s.client.WithBssopenapiClient(do)
+		return err
+	})
+	return
+}
+
+// QueryAvailableInstances lists the subscription instances of the account matching the filter.
+func (s *BssOpenApiService) QueryAvailableInstances(filter SubscriptionFilter) (instances []bssopenapi.Instance, err error) {
+	request := bssopenapi.CreateQueryAvailableInstancesRequest()
+	request.ProductCode = filter.ProductCode
+	request.ProductType = filter.ProductType
+	request.SubscriptionType = SubscriptionTypeSubscription
+	request.InstanceIDs = strings.Join(filter.InstanceIds, ",")
+	request.RenewStatus = filter.RenewalStatus
+	request.Region = filter.Region
+	if !filter.EndTimeStart.IsZero() {
+		request.EndTimeStart = filter.EndTimeStart.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	if !filter.EndTimeEnd.IsZero() {
+		request.EndTimeEnd = filter.EndTimeEnd.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNum = requests.NewInteger(1)
+	for {
+		raw, err := s.retryBssOpenApi(func(bssopenapiClient *bssopenapi.Client) (interface{}, error) {
+			return bssopenapiClient.QueryAvailableInstances(request)
+		})
+		if err != nil {
+			return instances, WrapErrorf(err, DefaultErrorMsg, filter.ProductCode, request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*bssopenapi.QueryAvailableInstancesResponse)
+		// execute errors including in the bssopenapi response
+		if !response.Success {
+			return instances, WrapErrorf(Error(response.Message), DefaultErrorMsg, filter.ProductCode, request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		instances = append(instances, response.Data.InstanceList...)
+		if len(response.Data.InstanceList) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNum)
+		if err != nil {
+			return instances, WrapError(err)
+		}
+		request.PageNum = page
+	}
+	return instances, nil
+}
+
+// DescribeSubscription describes the subscription of an instance, id is formatted as <product_code>:<instance_id>.
+func (s *BssOpenApiService) DescribeSubscription(id string) (instance bssopenapi.Instance, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return instance, WrapError(err)
+	}
+	instances, err := s.QueryAvailableInstances(SubscriptionFilter{ProductCode: parts[0], InstanceIds: []string{parts[1]}})
+	if err != nil {
+		return instance, WrapError(err)
+	}
+	for _, object := range instances {
+		if object.InstanceID == parts[1] || object.InstanceId == parts[1] {
+			return object, nil
+		}
+	}
+	return instance, WrapErrorf(Error(GetNotFoundMessage("Subscription", id)), NotFoundMsg, ProviderERROR)
+}
+
+// SetRenewal sets the renewal of a subscription instance. The renewal period is ignored unless the status is AutoRenewal.
+func (s *BssOpenApiService) SetRenewal(productCode, productType, instanceId string, status RenewalStatus, period int, periodUnit string) error {
+	request := bssopenapi.CreateSetRenewalRequest()
+	request.ProductCode = productCode
+	request.ProductType = productType
+	request.SubscriptionType = SubscriptionTypeSubscription
+	request.InstanceIDs = instanceId
+	request.RenewalStatus = string(status)
+	if status == RenewAutoRenewal {
+		request.RenewalPeriod = requests.NewInteger(period)
+		request.RenewalPeriodUnit = periodUnit
+	}
+	raw, err := s.retryBssOpenApi(func(bssopenapiClient *bssopenapi.Client) (interface{}, error) {
+		return bssopenapiClient.SetRenewal(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*bssopenapi.SetRenewalResponse)
+	// execute errors including in the bssopenapi response
+	if !response.Success {
+		return WrapErrorf(Error(response.Message), DefaultErrorMsg, instanceId, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
+
+// SetAutoRenew turns on the auto renewal of a subscription instance for the period in months, or sets it back to manual.
+func (s *BssOpenApiService) SetAutoRenew(productCode, instanceId string, autoRenew bool, period int) error {
+	if autoRenew {
+		return s.SetRenewal(productCode, "", instanceId, RenewAutoRenewal, period, RenewalPeriodUnitMonth)
+	}
+	return s.SetRenewal(productCode, "", instanceId, RenewManualRenewal, 0, "")
+}
+
+// ConvertChargeType converts a pay-as-you-go instance to a subscription one for the period in months,
+// or a subscription instance to a pay-as-you-go one when the period is 0.
+func (s *BssOpenApiService) ConvertChargeType(productCode, productType, instanceId string, period int) error {
+	request := bssopenapi.CreateConvertChargeTypeRequest()
+	request.ProductCode = productCode
+	request.ProductType = productType
+	request.InstanceId = instanceId
+	if period > 0 {
+		request.SubscriptionType = SubscriptionTypeSubscription
+		request.Period = requests.NewInteger(period)
+	} else {
+		request.SubscriptionType = SubscriptionTypePayAsYouGo
+	}
+	raw, err := s.retryBssOpenApi(func(bssopenapiClient *bssopenapi.Client) (interface{}, error) {
+		return bssopenapiClient.ConvertChargeType(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*bssopenapi.ConvertChargeTypeResponse)
+	// execute errors including in the bssopenapi response
+	if !response.Success {
+		return WrapErrorf(Error(response.Message), DefaultErrorMsg, instanceId, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
@@ -43,3 +43,22 @@ func (s *CasService) DescribeCas(id string) (*cas.Certificate, error) {
 
 	return certificate, WrapErrorf(Error(GetNotFoundMessage("Cas", id)), NotFoundMsg, ProviderERROR)
 }
+
+func (s *CasService) DescribeCasDetail(id string) (*cas.DescribeUserCertificateDetailResponse, error) {
+	request := cas.CreateDescribeUserCertificateDetailRequest()
+	request.RegionId = s.client.RegionId
+	request.CertId = requests.Integer(id)
+
+	raw, err := s.client.WithCasClient(func(casClient *cas.Client) (interface{}, error) {
+		return casClient.DescribeUserCertificateDetail(request)
+	})
+	if err != nil {
+		if IsExceptedErrors(err, []string{CertNotExist}) {
+			return nil, WrapErrorf(Error(GetNotFoundMessage("Cas", id)), NotFoundMsg, ProviderERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*cas.DescribeUserCertificateDetailResponse)
+	return response, nil
+}
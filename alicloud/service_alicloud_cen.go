@@ -34,16 +34,13 @@ func (s *CenService) DescribeCenInstance(id string) (c cbn.Cen, err error) {
 	request.Filter = &filters
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.CENCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.DescribeCens(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, CenThrottlingUser}) {
-				time.Sleep(10 * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -157,16 +154,13 @@ func (s *CenService) DescribeCenBandwidthPackage(id string) (c cbn.CenBandwidthP
 	request.Filter = &filters
 
 	var raw interface{}
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.CENCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.DescribeCenBandwidthPackages(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, CenThrottlingUser}) {
-				time.Sleep(10 * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -378,16 +372,13 @@ func (s *CenService) DescribeCenRouteEntry(id string) (c cbn.PublishedRouteEntry
 	request.DestinationCidrBlock = cidr
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.CENCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.DescribePublishedRouteEntries(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, CenThrottlingUser}) {
-				time.Sleep(10 * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
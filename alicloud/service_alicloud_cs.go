@@ -19,7 +19,7 @@ type CsService struct {
 
 func (s *CsService) GetContainerClusterByName(name string) (cluster cs.ClusterType, err error) {
 	name = Trim(name)
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.CONTAINCode)
 	var clusters []cs.ClusterType
 	err = invoker.Run(func() error {
 		raw, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -54,7 +54,7 @@ func (s *CsService) GetContainerClusterAndCertsByName(name string) (*cs.ClusterT
 		return nil, nil, err
 	}
 	var certs cs.ClusterCerts
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.CONTAINCode)
 	err = invoker.Run(func() error {
 		raw, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.GetClusterCerts(cluster.ClusterID)
@@ -119,7 +119,7 @@ func (s *CsService) WaitForContainerApplication(clusterName, appName string, sta
 }
 
 func (s *CsService) DescribeCsKubernetes(id string) (cluster cs.KubernetesCluster, err error) {
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.CONTAINCode)
 	var requestInfo *cs.Client
 	var response interface{}
 
@@ -175,7 +175,7 @@ func (s *CsService) WaitForCsKubernetes(id string, status Status, timeout int) e
 
 func (s *CsService) DescribeCsManagedKubernetes(id string) (cluster cs.KubernetesCluster, err error) {
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.CONTAINCode)
 	var response interface{}
 
 	if err := invoker.Run(func() error {
@@ -292,7 +292,7 @@ func (s *CsService) CsServerlessKubernetesInstanceStateRefreshFunc(id string, fa
 func (s *CsService) DescribeCsServerlessKubernetes(id string) (*cs.ServerlessClusterResponse, error) {
 	cluster := &cs.ServerlessClusterResponse{}
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.CONTAINCode)
 	var response interface{}
 
 	if err := invoker.Run(func() error {
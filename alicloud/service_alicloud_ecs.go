@@ -578,6 +578,221 @@ func (s *EcsService) DescribeImageById(id string) (image ecs.Image, err error) {
 	return resp.Images.Image[0], nil
 }
 
+// DescribeImage describes a custom image in any status, the ones being created or failed to create included.
+func (s *EcsService) DescribeImage(id string) (image ecs.Image, err error) {
+	var statuses []string
+	for _, status := range ImageStatuses {
+		statuses = append(statuses, string(status))
+	}
+	request := ecs.CreateDescribeImagesRequest()
+	request.RegionId = s.client.RegionId
+	request.ImageId = id
+	request.ImageOwnerAlias = "self"
+	request.Status = strings.Join(statuses, ",")
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeImages(request)
+	})
+	if err != nil {
+		return image, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.DescribeImagesResponse)
+	if len(response.Images.Image) < 1 || response.Images.Image[0].ImageId != id {
+		return image, WrapErrorf(Error(GetNotFoundMessage("Image", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response.Images.Image[0], nil
+}
+
+func (s *EcsService) ImageStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeImage(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.Status == failState {
+				return object, object.Status, WrapError(Error(FailedToReachTargetStatus, object.Status))
+			}
+		}
+		return object, object.Status, nil
+	}
+}
+
+// WaitForImage waits for a custom image to be available, which takes a while after creating, copying or importing it.
+func (s *EcsService) WaitForImage(id string, timeout time.Duration) error {
+	stateConf := BuildStateConf([]string{string(ImageCreating), string(ImageWaiting)}, []string{string(ImageAvailable)}, timeout, 5*time.Second,
+		s.ImageStateRefreshFunc(id, []string{string(ImageCreateFailed), string(ImageUnAvailable)}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, id)
+	}
+	return nil
+}
+
+// DescribeImageSharePermission describes an account which a custom image is shared with, the id is formatted as <image_id>:<account_id>.
+func (s *EcsService) DescribeImageSharePermission(id string) (account ecs.Account, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return account, WrapError(err)
+	}
+	request := ecs.CreateDescribeImageSharePermissionRequest()
+	request.RegionId = s.client.RegionId
+	request.ImageId = parts[0]
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeImageSharePermission(request)
+		})
+		if err != nil {
+			if IsExceptedError(err, ImageNotFound) {
+				return account, WrapErrorf(Error(GetNotFoundMessage("ImageSharePermission", id)), NotFoundMsg, ProviderERROR)
+			}
+			return account, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ecs.DescribeImageSharePermissionResponse)
+		for _, object := range response.Accounts.Account {
+			if object.AliyunId == parts[1] {
+				return object, nil
+			}
+		}
+		if len(response.Accounts.Account) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return account, WrapError(err)
+		}
+		request.PageNumber = page
+	}
+	return account, WrapErrorf(Error(GetNotFoundMessage("ImageSharePermission", id)), NotFoundMsg, ProviderERROR)
+}
+
+// ModifyImageSharePermission shares a custom image with the accounts to add and stops sharing it with the ones to remove.
+func (s *EcsService) ModifyImageSharePermission(imageId string, addAccounts, removeAccounts []string) error {
+	request := ecs.CreateModifyImageSharePermissionRequest()
+	request.RegionId = s.client.RegionId
+	request.ImageId = imageId
+	if len(addAccounts) > 0 {
+		request.AddAccount = &addAccounts
+	}
+	if len(removeAccounts) > 0 {
+		request.RemoveAccount = &removeAccounts
+	}
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ModifyImageSharePermission(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, imageId, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
+
+// DescribeTask describes an asynchronous task of ECS, like importing and exporting images.
+func (s *EcsService) DescribeTask(id string) (task *ecs.DescribeTaskAttributeResponse, err error) {
+	request := ecs.CreateDescribeTaskAttributeRequest()
+	request.RegionId = s.client.RegionId
+	request.TaskId = id
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeTaskAttribute(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, TaskNotFound) {
+			return task, WrapErrorf(Error(GetNotFoundMessage("Task", id)), NotFoundMsg, ProviderERROR)
+		}
+		return task, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	task, _ = raw.(*ecs.DescribeTaskAttributeResponse)
+	if task.TaskId != id {
+		return task, WrapErrorf(Error(GetNotFoundMessage("Task", id)), NotFoundMsg, ProviderERROR)
+	}
+	return task, nil
+}
+
+func (s *EcsService) TaskStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeTask(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.TaskStatus == failState {
+				return object, object.TaskStatus, WrapError(Error(FailedToReachTargetStatus, object.TaskStatus))
+			}
+		}
+		return object, object.TaskStatus, nil
+	}
+}
+
+// updateImage applies the changes of the name, description and tags of the custom image resources.
+func (s *EcsService) updateImage(d *schema.ResourceData) error {
+	if d.HasChange("image_name") || d.HasChange("description") {
+		request := ecs.CreateModifyImageAttributeRequest()
+		request.RegionId = s.client.RegionId
+		request.ImageId = d.Id()
+		request.ImageName = d.Get("image_name").(string)
+		request.Description = d.Get("description").(string)
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyImageAttribute(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+	if err := setTags(s.client, TagResourceImage, d); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+// deleteImage deletes the custom image of the resource, by force when its force is true, even if instances are using it.
+func (s *EcsService) deleteImage(d *schema.ResourceData) error {
+	request := ecs.CreateDeleteImageRequest()
+	request.RegionId = s.client.RegionId
+	request.ImageId = d.Id()
+	request.Force = requests.NewBoolean(d.Get("force").(bool))
+
+	var raw interface{}
+	invoker := NewProductInvoker(s.client, connectivity.ECSCode)
+	for _, code := range ImageInvalidOperations {
+		invoker.AddCatcher(Catcher{code, 0, DefaultRetryWaitSeconds})
+	}
+	err := invoker.Run(func() error {
+		resp, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DeleteImage(request)
+		})
+		raw = resp
+		return err
+	})
+	if err != nil {
+		if IsExceptedError(err, ImageNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+
+	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second,
+		s.ImageStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
+
 func (s *EcsService) DescribeNetworkInterface(id string) (networkInterface ecs.NetworkInterfaceSet, err error) {
 	request := ecs.CreateDescribeNetworkInterfacesRequest()
 	request.RegionId = s.client.RegionId
@@ -910,15 +1125,13 @@ func (s *EcsService) AttachKeyPair(keyName string, instanceIds []interface{}) er
 	request.RegionId = s.client.RegionId
 	request.KeyPairName = keyName
 	request.InstanceIds = convertListToJsonString(instanceIds)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.ECSCode)
+	err := invoker.Run(func() error {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachKeyPair(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, KeyPairServiceUnavailable) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -1062,6 +1275,31 @@ func (s *EcsService) DescribeLaunchTemplate(id string) (set ecs.LaunchTemplateSe
 
 }
 
+func (s *EcsService) DescribeLaunchTemplateByName(name string) (set ecs.LaunchTemplateSet, err error) {
+
+	request := ecs.CreateDescribeLaunchTemplatesRequest()
+	request.RegionId = s.client.RegionId
+	request.LaunchTemplateName = &[]string{name}
+
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeLaunchTemplates(request)
+	})
+	if err != nil {
+		err = WrapErrorf(err, DefaultErrorMsg, name, request.GetActionName(), AlibabaCloudSdkGoERROR)
+		return
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response := raw.(*ecs.DescribeLaunchTemplatesResponse)
+	if len(response.LaunchTemplateSets.LaunchTemplateSet) != 1 ||
+		response.LaunchTemplateSets.LaunchTemplateSet[0].LaunchTemplateName != name {
+		err = WrapErrorf(Error(GetNotFoundMessage("LaunchTemplate", name)), NotFoundMsg, ProviderERROR)
+		return
+	}
+
+	return response.LaunchTemplateSets.LaunchTemplateSet[0], nil
+
+}
+
 func (s *EcsService) DescribeLaunchTemplateVersion(id string, version int) (set ecs.LaunchTemplateVersionSet, err error) {
 
 	request := ecs.CreateDescribeLaunchTemplateVersionsRequest()
@@ -1114,3 +1352,163 @@ func (s *EcsService) WaitForLaunchTemplate(id string, status Status, timeout int
 		time.Sleep(DefaultIntervalShort * time.Second)
 	}
 }
+
+func (s *EcsService) DescribeEcsDedicatedHost(id string) (host ecs.DedicatedHost, err error) {
+	request := ecs.CreateDescribeDedicatedHostsRequest()
+	request.RegionId = s.client.RegionId
+	request.DedicatedHostIds = convertListToJsonString([]interface{}{id})
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeDedicatedHosts(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, DedicatedHostNotFound) {
+			return host, WrapErrorf(Error(GetNotFoundMessage("EcsDedicatedHost", id)), NotFoundMsg, ProviderERROR)
+		}
+		return host, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.DescribeDedicatedHostsResponse)
+	if len(response.DedicatedHosts.DedicatedHost) < 1 || response.DedicatedHosts.DedicatedHost[0].DedicatedHostId != id {
+		return host, WrapErrorf(Error(GetNotFoundMessage("EcsDedicatedHost", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response.DedicatedHosts.DedicatedHost[0], nil
+}
+
+func (s *EcsService) EcsDedicatedHostStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeEcsDedicatedHost(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.Status == failState {
+				return object, object.Status, WrapError(Error(FailedToReachTargetStatus, object.Status))
+			}
+		}
+		return object, object.Status, nil
+	}
+}
+
+func (s *EcsService) DescribeEcsDeploymentSet(id string) (set ecs.DeploymentSet, err error) {
+	request := ecs.CreateDescribeDeploymentSetsRequest()
+	request.RegionId = s.client.RegionId
+	request.DeploymentSetIds = convertListToJsonString([]interface{}{id})
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeDeploymentSets(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, DeploymentSetNotFound) {
+			return set, WrapErrorf(Error(GetNotFoundMessage("EcsDeploymentSet", id)), NotFoundMsg, ProviderERROR)
+		}
+		return set, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.DescribeDeploymentSetsResponse)
+	if len(response.DeploymentSets.DeploymentSet) < 1 || response.DeploymentSets.DeploymentSet[0].DeploymentSetId != id {
+		return set, WrapErrorf(Error(GetNotFoundMessage("EcsDeploymentSet", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response.DeploymentSets.DeploymentSet[0], nil
+}
+
+func (s *EcsService) DescribeEcsCommand(id string) (command ecs.Command, err error) {
+	request := ecs.CreateDescribeCommandsRequest()
+	request.RegionId = s.client.RegionId
+	request.CommandId = id
+	request.ContentEncoding = "PlainText"
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeCommands(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, CommandNotFound) {
+			return command, WrapErrorf(Error(GetNotFoundMessage("EcsCommand", id)), NotFoundMsg, ProviderERROR)
+		}
+		return command, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.DescribeCommandsResponse)
+	if len(response.Commands.Command) < 1 || response.Commands.Command[0].CommandId != id {
+		return command, WrapErrorf(Error(GetNotFoundMessage("EcsCommand", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response.Commands.Command[0], nil
+}
+
+func (s *EcsService) DescribeEcsInvocation(id string) (invocation ecs.Invocation, err error) {
+	request := ecs.CreateDescribeInvocationsRequest()
+	request.RegionId = s.client.RegionId
+	request.InvokeId = id
+	request.ContentEncoding = "PlainText"
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeInvocations(request)
+	})
+	if err != nil {
+		if IsExceptedError(err, InvocationNotFound) {
+			return invocation, WrapErrorf(Error(GetNotFoundMessage("EcsInvocation", id)), NotFoundMsg, ProviderERROR)
+		}
+		return invocation, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*ecs.DescribeInvocationsResponse)
+	if len(response.Invocations.Invocation) < 1 || response.Invocations.Invocation[0].InvokeId != id {
+		return invocation, WrapErrorf(Error(GetNotFoundMessage("EcsInvocation", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response.Invocations.Invocation[0], nil
+}
+
+func (s *EcsService) EcsInvocationStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeEcsInvocation(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.InvokeStatus == failState {
+				return object, object.InvokeStatus, WrapError(Error(FailedToReachTargetStatus, object.InvokeStatus))
+			}
+		}
+		return object, object.InvokeStatus, nil
+	}
+}
+
+// DescribeEcsInvocationResults returns the latest result of an invocation on each of its instances, the outputs are Base64 encoded.
+func (s *EcsService) DescribeEcsInvocationResults(id string) (results []ecs.InvocationResult, err error) {
+	request := ecs.CreateDescribeInvocationResultsRequest()
+	request.RegionId = s.client.RegionId
+	request.InvokeId = id
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeInvocationResults(request)
+		})
+		if err != nil {
+			if IsExceptedError(err, InvocationNotFound) {
+				return results, WrapErrorf(Error(GetNotFoundMessage("EcsInvocation", id)), NotFoundMsg, ProviderERROR)
+			}
+			return results, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ecs.DescribeInvocationResultsResponse)
+		results = append(results, response.Invocation.InvocationResults.InvocationResult...)
+
+		if len(response.Invocation.InvocationResults.InvocationResult) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return results, WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+	return results, nil
+}
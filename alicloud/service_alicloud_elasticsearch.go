@@ -23,7 +23,7 @@ func (s *ElasticsearchService) DescribeElasticsearchInstance(id string) (*elasti
 	request.InstanceId = id
 	request.SetContentType("application/json")
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.ELASTICSEARCHCode)
 	err := invoker.Run(func() error {
 		raw, err := s.client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 			return elasticsearchClient.DescribeInstance(request)
@@ -179,19 +179,17 @@ func updateDateNodeAmount(d *schema.ResourceData, meta interface{}) error {
 	request.InstanceId = d.Id()
 	request.SetContent(data)
 	request.SetContentType("application/json")
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(client, connectivity.ELASTICSEARCHCode)
+	invoker.AddCatcher(Catcher{ESConcurrencyConflictError, 0, DefaultRetryWaitSeconds})
+	invoker.AddCatcher(Catcher{ESNotSupportCurrentActionError, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err = client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (resp interface{}, errs error) {
 			return elasticsearchClient.UpdateInstance(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ESConcurrencyConflictError, ESNotSupportCurrentActionError}) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 		return nil
@@ -233,19 +231,17 @@ func updateDataNodeSpec(d *schema.ResourceData, meta interface{}) error {
 	request.SetContent(data)
 	request.SetContentType("application/json")
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(client, connectivity.ELASTICSEARCHCode)
+	invoker.AddCatcher(Catcher{ESConcurrencyConflictError, 0, DefaultRetryWaitSeconds})
+	invoker.AddCatcher(Catcher{ESNotSupportCurrentActionError, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err = client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 			return elasticsearchClient.UpdateInstance(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ESConcurrencyConflictError, ESNotSupportCurrentActionError}) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 		return nil
@@ -291,19 +287,17 @@ func updateMasterNode(d *schema.ResourceData, meta interface{}) error {
 	request.SetContent(data)
 	request.SetContentType("application/json")
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(client, connectivity.ELASTICSEARCHCode)
+	invoker.AddCatcher(Catcher{ESConcurrencyConflictError, 0, DefaultRetryWaitSeconds})
+	invoker.AddCatcher(Catcher{ESNotSupportCurrentActionError, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err = client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 			return elasticsearchClient.UpdateInstance(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ESConcurrencyConflictError, ESNotSupportCurrentActionError}) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
 		return nil
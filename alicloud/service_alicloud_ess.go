@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -151,6 +152,29 @@ func (s *EssService) WaitForEssNotification(id string, status Status, timeout in
 }
 
 func (s *EssService) DescribeEssScalingGroup(id string) (group ess.ScalingGroup, err error) {
+	group, _, err = s.describeEssScalingGroup(id)
+	return
+}
+
+// EssLaunchTemplateOverride is an instance type overriding the one of the launch template of a scaling group.
+// The ess sdk does not support it yet, it is decoded from the DescribeScalingGroups response.
+type EssLaunchTemplateOverride struct {
+	InstanceType     string
+	WeightedCapacity int
+}
+
+// DescribeEssScalingGroupWithOverrides returns the scaling group along with its launch template overrides, which are
+// decoded from the same DescribeScalingGroups response.
+func (s *EssService) DescribeEssScalingGroupWithOverrides(id string) (group ess.ScalingGroup, overrides []EssLaunchTemplateOverride, err error) {
+	group, response, err := s.describeEssScalingGroup(id)
+	if err != nil {
+		return
+	}
+	overrides, err = decodeEssLaunchTemplateOverrides(response.GetHttpContentBytes(), id)
+	return group, overrides, WrapError(err)
+}
+
+func (s *EssService) describeEssScalingGroup(id string) (group ess.ScalingGroup, response *ess.DescribeScalingGroupsResponse, err error) {
 	request := ess.CreateDescribeScalingGroupsRequest()
 	request.ScalingGroupId1 = id
 	request.RegionId = s.client.RegionId
@@ -162,16 +186,57 @@ func (s *EssService) DescribeEssScalingGroup(id string) (group ess.ScalingGroup,
 		return
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	response, _ := raw.(*ess.DescribeScalingGroupsResponse)
+	response, _ = raw.(*ess.DescribeScalingGroupsResponse)
 	for _, v := range response.ScalingGroups.ScalingGroup {
 		if v.ScalingGroupId == id {
-			return v, nil
+			return v, response, nil
 		}
 	}
 	err = WrapErrorf(Error(GetNotFoundMessage("EssScalingGroup", id)), NotFoundMsg, ProviderERROR)
 	return
 }
 
+func decodeEssLaunchTemplateOverrides(content []byte, id string) (overrides []EssLaunchTemplateOverride, err error) {
+	response := struct {
+		ScalingGroups struct {
+			ScalingGroup []struct {
+				ScalingGroupId          string
+				LaunchTemplateOverrides struct {
+					LaunchTemplateOverride []EssLaunchTemplateOverride
+				}
+			}
+		}
+	}{}
+	if err := json.Unmarshal(content, &response); err != nil {
+		return overrides, WrapError(err)
+	}
+	for _, v := range response.ScalingGroups.ScalingGroup {
+		if v.ScalingGroupId == id {
+			return v.LaunchTemplateOverrides.LaunchTemplateOverride, nil
+		}
+	}
+	return overrides, WrapErrorf(Error(GetNotFoundMessage("EssScalingGroup", id)), NotFoundMsg, ProviderERROR)
+}
+
+// EnableEssScalingGroup enables the scaling group and waits for it to be active.
+// The activeConfigId is empty when the scaling group creates instances with a launch template.
+func (s *EssService) EnableEssScalingGroup(id, activeConfigId string, timeout int) error {
+	request := ess.CreateEnableScalingGroupRequest()
+	request.RegionId = s.client.RegionId
+	request.ScalingGroupId = id
+	if activeConfigId != "" {
+		request.ActiveScalingConfigurationId = activeConfigId
+	}
+	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+		return essClient.EnableScalingGroup(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return WrapError(s.WaitForEssScalingGroup(id, Active, timeout))
+}
+
 func (s *EssService) DescribeEssScalingConfiguration(id string) (config ess.ScalingConfiguration, err error) {
 	request := ess.CreateDescribeScalingConfigurationsRequest()
 	request.ScalingConfigurationId1 = id
@@ -6,8 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/schema"
-
 	"github.com/hashicorp/terraform/helper/resource"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/gpdb"
@@ -183,50 +181,6 @@ func (s *GpdbService) WaitForGpdbConnection(id string, status Status, timeout in
 	}
 }
 
-func (s *GpdbService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
-	o := oraw.(map[string]interface{})
-	n := nraw.(map[string]interface{})
-	create, remove := diffGpdbTags(gpdbTagsFromMap(o), gpdbTagsFromMap(n))
-
-	if len(remove) > 0 {
-		var tagKey []string
-		for _, v := range remove {
-			tagKey = append(tagKey, v.Key)
-		}
-		request := gpdb.CreateUntagResourcesRequest()
-		request.ResourceId = &[]string{d.Id()}
-		request.ResourceType = string(TagResourceInstance)
-		request.TagKey = &tagKey
-		request.RegionId = s.client.RegionId
-		raw, err := s.client.WithGpdbClient(func(client *gpdb.Client) (interface{}, error) {
-			return client.UntagResources(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	}
-
-	if len(create) > 0 {
-		request := gpdb.CreateTagResourcesRequest()
-		request.ResourceId = &[]string{d.Id()}
-		request.Tag = &create
-		request.ResourceType = string(TagResourceInstance)
-		request.RegionId = s.client.RegionId
-		raw, err := s.client.WithGpdbClient(func(client *gpdb.Client) (interface{}, error) {
-			return client.TagResources(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	}
-
-	d.SetPartial("tags")
-	return nil
-}
-
 func (s *GpdbService) tagsToMap(tags []gpdb.Tag) map[string]string {
 	result := make(map[string]string)
 	for _, t := range tags {
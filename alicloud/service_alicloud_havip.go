@@ -26,7 +26,7 @@ func (s *HaVipService) DescribeHaVip(haVipId string) (v vpc.HaVip, err error) {
 	}
 	request.Filter = &filter
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeHaVips(request)
@@ -69,7 +69,7 @@ func (s *HaVipService) WaitForHaVip(haVipId string, status Status, timeout int)
 }
 
 func (s *HaVipService) DescribeHaVipAttachment(haVipId string, instanceId string) (err error) {
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	return invoker.Run(func() error {
 		haVip, err := s.DescribeHaVip(haVipId)
 		if err != nil {
@@ -4,7 +4,6 @@ import (
 	"time"
 
 	sls "github.com/aliyun/aliyun-log-go-sdk"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -17,17 +16,15 @@ type LogService struct {
 func (s *LogService) DescribeLogProject(id string) (*sls.LogProject, error) {
 	project := &sls.LogProject{}
 	var requestInfo *sls.Client
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.LOGCode)
+	invoker.AddCatcher(Catcher{LogClientTimeout, 0, DefaultRetryWaitSeconds})
+	err := invoker.Run(func() error {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetProject(id)
 		})
 		if err != nil {
-			if IsExceptedError(err, LogClientTimeout) {
-				time.Sleep(5 * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		if debugOn() {
 			addDebug("GetProject", raw, requestInfo, map[string]string{"name": id})
@@ -77,16 +74,16 @@ func (s *LogService) DescribeLogStore(id string) (*sls.LogStore, error) {
 	}
 	projectName, name := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.LOGCode)
+	invoker.AddCatcher(Catcher{InternalServerError, 0, DefaultRetryWaitSeconds})
+	invoker.AddCatcher(Catcher{LogClientTimeout, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetLogStore(projectName, name)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InternalServerError, LogClientTimeout}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		if debugOn() {
 			addDebug("GetLogStore", raw, requestInfo, map[string]string{
@@ -144,16 +141,16 @@ func (s *LogService) DescribeLogStoreIndex(id string) (*sls.Index, error) {
 	}
 	projectName, name := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.LOGCode)
+	invoker.AddCatcher(Catcher{InternalServerError, 0, DefaultRetryWaitSeconds})
+	invoker.AddCatcher(Catcher{LogClientTimeout, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetIndex(projectName, name)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InternalServerError, LogClientTimeout}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		if debugOn() {
 			addDebug("GetIndex", raw, requestInfo, map[string]string{
@@ -186,16 +183,16 @@ func (s *LogService) DescribeLogMachineGroup(id string) (*sls.MachineGroup, erro
 	}
 	projectName, groupName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.LOGCode)
+	invoker.AddCatcher(Catcher{InternalServerError, 0, DefaultRetryWaitSeconds})
+	invoker.AddCatcher(Catcher{LogClientTimeout, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetMachineGroup(projectName, groupName)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InternalServerError, LogClientTimeout}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		if debugOn() {
 			addDebug("GetMachineGroup", raw, requestInfo, map[string]string{
@@ -255,16 +252,15 @@ func (s *LogService) DescribeLogtailConfig(id string) (*sls.LogConfig, error) {
 	}
 	projectName, configName := parts[0], parts[2]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.LOGCode)
+	invoker.AddCatcher(Catcher{InternalServerError, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetConfig(projectName, configName)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InternalServerError}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		if debugOn() {
 			addDebug("GetConfig", raw, requestInfo, map[string]string{
@@ -322,17 +318,15 @@ func (s *LogService) DescribeLogtailAttachment(id string) (groupName string, err
 	projectName, configName, name := parts[0], parts[1], parts[2]
 	var groupNames []string
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-
+	invoker := NewProductInvoker(s.client, connectivity.LOGCode)
+	invoker.AddCatcher(Catcher{InternalServerError, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetAppliedMachineGroups(projectName, configName)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InternalServerError}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		if debugOn() {
 			addDebug("GetAppliedMachineGroups", raw, requestInfo, map[string]string{
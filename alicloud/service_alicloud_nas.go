@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -18,23 +17,21 @@ func (s *NasService) DescribeNasFileSystem(id string) (fs nas.DescribeFileSystem
 	request := nas.CreateDescribeFileSystemsRequest()
 	request.RegionId = s.client.RegionId
 	request.FileSystemId = id
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.NASCode)
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithNasClient(func(nasClient *nas.Client) (interface{}, error) {
 			return nasClient.DescribeFileSystems(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ServiceUnavailable, Throttling}) {
-				return resource.RetryableError(err)
-			}
 			if IsExceptedErrors(err, []string{InvalidFileSystemIDNotFound, ForbiddenNasNotFound}) {
-				return resource.NonRetryableError(WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR))
+				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
-			return resource.NonRetryableError(WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR))
+			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*nas.DescribeFileSystemsResponse)
 		if response.TotalCount <= 0 {
-			return resource.NonRetryableError(WrapErrorf(Error(GetNotFoundMessage("NasFileSystem", id)), NotFoundMsg, ProviderERROR))
+			return WrapErrorf(Error(GetNotFoundMessage("NasFileSystem", id)), NotFoundMsg, ProviderERROR)
 		}
 		fs = response.FileSystems.FileSystem[0]
 		return nil
@@ -48,18 +45,16 @@ func (s *NasService) DescribeNasMountTarget(id string) (fs nas.DescribeMountTarg
 	request.RegionId = string(s.client.Region)
 	split := strings.Split(id, "-")
 	request.FileSystemId = split[0]
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.NASCode)
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithNasClient(func(nasClient *nas.Client) (interface{}, error) {
 			return nasClient.DescribeMountTargets(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ServiceUnavailable, Throttling}) {
-				return resource.RetryableError(err)
-			}
 			if IsExceptedErrors(err, NasNotFound) {
-				return resource.NonRetryableError(WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR))
+				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
-			return resource.NonRetryableError(WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR))
+			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*nas.DescribeMountTargetsResponse)
@@ -69,7 +64,7 @@ func (s *NasService) DescribeNasMountTarget(id string) (fs nas.DescribeMountTarg
 				return nil
 			}
 		}
-		return resource.NonRetryableError(WrapErrorf(Error(GetNotFoundMessage("NasMountTarget", id)), NotFoundMsg, ProviderERROR))
+		return WrapErrorf(Error(GetNotFoundMessage("NasMountTarget", id)), NotFoundMsg, ProviderERROR)
 	})
 	return fs, WrapError(err)
 }
@@ -80,23 +75,21 @@ func (s *NasService) DescribeNasAccessGroup(id string) (ag nas.DescribeAccessGro
 	request.RegionId = string(s.client.Region)
 	request.AccessGroupName = id
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.NASCode)
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithNasClient(func(nasClient *nas.Client) (interface{}, error) {
 			return nasClient.DescribeAccessGroups(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ServiceUnavailable, Throttling}) {
-				return resource.RetryableError(err)
-			}
 			if IsExceptedErrors(err, []string{InvalidAccessGroupNotFound, ForbiddenNasNotFound}) {
-				return resource.NonRetryableError(WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR))
+				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
-			return resource.NonRetryableError(WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR))
+			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*nas.DescribeAccessGroupsResponse)
 		if len(response.AccessGroups.AccessGroup) <= 0 {
-			return resource.NonRetryableError(WrapErrorf(Error(GetNotFoundMessage("NasAccessGroup", id)), NotFoundMsg, ProviderERROR))
+			return WrapErrorf(Error(GetNotFoundMessage("NasAccessGroup", id)), NotFoundMsg, ProviderERROR)
 		}
 		ag = response.AccessGroups.AccessGroup[0]
 		return nil
@@ -115,18 +108,16 @@ func (s *NasService) DescribeNasAccessRule(id string) (fs nas.DescribeAccessRule
 	}
 	request.AccessGroupName = parts[0]
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.NASCode)
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithNasClient(func(nasClient *nas.Client) (interface{}, error) {
 			return nasClient.DescribeAccessRules(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ServiceUnavailable, Throttling}) {
-				return resource.RetryableError(err)
-			}
 			if IsExceptedErrors(err, []string{InvalidAccessGroupNotFound, ForbiddenNasNotFound}) {
-				return resource.NonRetryableError(WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR))
+				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
-			return resource.NonRetryableError(WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR))
+			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ := raw.(*nas.DescribeAccessRulesResponse)
@@ -136,7 +127,7 @@ func (s *NasService) DescribeNasAccessRule(id string) (fs nas.DescribeAccessRule
 				return nil
 			}
 		}
-		return resource.NonRetryableError(WrapErrorf(Error(GetNotFoundMessage("NasAccessRule", id)), NotFoundMsg, ProviderERROR))
+		return WrapErrorf(Error(GetNotFoundMessage("NasAccessRule", id)), NotFoundMsg, ProviderERROR)
 	})
 	return fs, WrapError(err)
 }
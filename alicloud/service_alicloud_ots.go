@@ -10,7 +10,6 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
 	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -38,16 +37,15 @@ func (s *OtsService) ListOtsTable(instanceName string) (table *tablestore.ListTa
 	}
 	var raw interface{}
 	var requestInfo *tablestore.TableStoreClient
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.OTSCode)
+	invoker.AddCatcher(Catcher{SuffixNoSuchHost, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestInfo = tableStoreClient
 			return tableStoreClient.ListTable()
 		})
 		if err != nil {
-			if strings.HasSuffix(err.Error(), SuffixNoSuchHost) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug("ListTable", raw, requestInfo)
 		return nil
@@ -80,16 +78,17 @@ func (s *OtsService) DescribeOtsTable(id string) (*tablestore.DescribeTableRespo
 	}
 	var raw interface{}
 	var requestInfo *tablestore.TableStoreClient
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.OTSCode)
+	for _, code := range OtsTableIsTemporarilyUnavailable {
+		invoker.AddCatcher(Catcher{code, 0, DefaultRetryWaitSeconds})
+	}
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestInfo = tableStoreClient
 			return tableStoreClient.DescribeTable(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, OtsTableIsTemporarilyUnavailable) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug("DescribeTable", raw, requestInfo, request)
 		return nil
@@ -3,8 +3,6 @@ package alicloud
 import (
 	"strconv"
 
-	"github.com/hashicorp/terraform/helper/resource"
-
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -22,16 +20,14 @@ func (s *PvtzService) DescribePvtzZone(id string) (zone pvtz.DescribeZoneInfoRes
 	request.ZoneId = id
 
 	var response *pvtz.DescribeZoneInfoResponse
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.PVTZCode)
+	invoker.AddCatcher(Catcher{PvtzSystemBusy, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
 			return pvtzClient.DescribeZoneInfo(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ServiceUnavailable, PvtzThrottlingUser, PvtzSystemBusy}) {
-				time.Sleep(5 * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ = raw.(*pvtz.DescribeZoneInfoResponse)
@@ -109,16 +105,14 @@ func (s *PvtzService) DescribePvtzZoneRecord(id string) (record pvtz.Record, err
 	var response *pvtz.DescribeZoneRecordsResponse
 
 	for {
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		invoker := NewProductInvoker(s.client, connectivity.PVTZCode)
+		invoker.AddCatcher(Catcher{PvtzSystemBusy, 0, DefaultRetryWaitSeconds})
+		err := invoker.Run(func() error {
 			raw, err := s.client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
 				return pvtzClient.DescribeZoneRecords(request)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{ServiceUnavailable, PvtzThrottlingUser, PvtzSystemBusy}) {
-					time.Sleep(5 * time.Second)
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
+				return err
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 			response, _ = raw.(*pvtz.DescribeZoneRecordsResponse)
@@ -11,7 +11,6 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -519,17 +518,13 @@ func (s *RamService) DescribeRamRoleAttachment(id string) (*ecs.DescribeInstance
 	request.RegionId = s.client.RegionId
 	request.InstanceIds = parts[1]
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.ECSCode)
+	invoker.AddCatcher(Catcher{RoleAttachmentUnExpectedJson, 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstanceRamRole(request)
 		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{RoleAttachmentUnExpectedJson}) {
-				return resource.RetryableError(WrapError(err))
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+		return err
 	})
 	if err != nil {
 		if IsExceptedErrors(err, []string{InvalidRamRoleNotFound}) {
@@ -2,8 +2,6 @@ package alicloud
 
 import (
 	"encoding/json"
-	"log"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -91,7 +89,7 @@ func (s *RdsService) DescribeDBAccount(id string) (*rds.DBInstanceAccount, error
 	request.RegionId = s.client.RegionId
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.RDSCode)
 	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *rds.DescribeAccountsResponse
 	if err := invoker.Run(func() error {
@@ -129,7 +127,7 @@ func (s *RdsService) DescribeDBAccountPrivilege(id string) (*rds.DBInstanceAccou
 	request.RegionId = s.client.RegionId
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.RDSCode)
 	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *rds.DescribeAccountsResponse
 	if err := invoker.Run(func() error {
@@ -167,25 +165,25 @@ func (s *RdsService) DescribeDBDatabase(id string) (*rds.Database, error) {
 	request.DBInstanceId = parts[0]
 	request.DBName = dbName
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.RDSCode)
+	invoker.AddCatcher(Catcher{DBInternalError, 0, DefaultRetryWaitSeconds})
+	invoker.AddCatcher(Catcher{"OperationDenied.DBInstanceStatus", 0, DefaultRetryWaitSeconds})
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DescribeDatabases(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{DBInternalError, "OperationDenied.DBInstanceStatus"}) {
-				return resource.RetryableError(WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR))
-			}
 			if s.NotFoundDBInstance(err) || IsExceptedErrors(err, []string{InvalidDBNameNotFound}) {
-				return resource.NonRetryableError(WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR))
+				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
-			return resource.NonRetryableError(WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR))
+			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 		response, _ := raw.(*rds.DescribeDatabasesResponse)
 		if len(response.Databases.Database) < 1 {
-			return resource.NonRetryableError(WrapErrorf(Error(GetNotFoundMessage("DBDatabase", dbName)), NotFoundMsg, ProviderERROR))
+			return WrapErrorf(Error(GetNotFoundMessage("DBDatabase", dbName)), NotFoundMsg, ProviderERROR)
 		}
 		ds = &response.Databases.Database[0]
 		return nil
@@ -386,15 +384,16 @@ func (s *RdsService) GrantAccountPrivilege(id, dbName string) error {
 	request.DBName = dbName
 	request.AccountPrivilege = parts[2]
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.RDSCode)
+	for _, code := range OperationDeniedDBStatus {
+		invoker.AddCatcher(Catcher{code, 0, DefaultRetryWaitSeconds})
+	}
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.GrantAccountPrivilege(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, OperationDeniedDBStatus) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -424,15 +423,16 @@ func (s *RdsService) RevokeAccountPrivilege(id, dbName string) error {
 	request.AccountName = parts[1]
 	request.DBName = dbName
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.RDSCode)
+	for _, code := range OperationDeniedDBStatus {
+		invoker.AddCatcher(Catcher{code, 0, DefaultRetryWaitSeconds})
+	}
+	err = invoker.Run(func() error {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.RevokeAccountPrivilege(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, OperationDeniedDBStatus) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -987,148 +987,3 @@ func (s *RdsService) NotFoundDBInstance(err error) bool {
 	return false
 }
 
-const tagsMaxNumPerTime = 5
-
-func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		if len(remove) > 0 {
-			if err := s.removeTags(remove, d.Id()); err != nil {
-				return err
-			}
-		}
-
-		if len(create) > 0 {
-			if err := s.addTags(create, d.Id()); err != nil {
-				return err
-			}
-		}
-
-		d.SetPartial("tags")
-	}
-
-	return nil
-}
-
-func (s *RdsService) addTags(tags []Tag, instanceId string) error {
-	return s.doBatchTags(s.addTagsPerTime, tags, instanceId)
-}
-
-func (s *RdsService) addTagsPerTime(tags []Tag, id string) error {
-	request := rds.CreateAddTagsToResourceRequest()
-	request.DBInstanceId = id
-	request.Tags = s.tagsToString(tags)
-	request.RegionId = s.client.RegionId
-	raw, err := s.client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
-		return client.AddTagsToResource(request)
-	})
-	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
-	}
-	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return nil
-}
-
-func (s *RdsService) removeTags(tags []Tag, id string) error {
-	return s.doBatchTags(s.removeTagsPerTime, tags, id)
-}
-
-func (s *RdsService) removeTagsPerTime(tags []Tag, id string) error {
-	request := rds.CreateRemoveTagsFromResourceRequest()
-	request.DBInstanceId = id
-	request.Tags = s.tagsToString(tags)
-	request.RegionId = s.client.RegionId
-	raw, err := s.client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
-		return client.RemoveTagsFromResource(request)
-	})
-	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
-	}
-	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return nil
-}
-
-func (s *RdsService) doBatchTags(batchFunc func([]Tag, string) error, tags []Tag, instanceId string) error {
-	num := len(tags)
-	if num <= 0 {
-		return nil
-	}
-
-	start, end := 0, 0
-	for end < num {
-		start = end
-		end += tagsMaxNumPerTime
-		if end > num {
-			end = num
-		}
-		if err := batchFunc(tags[start:end], instanceId); err != nil {
-			return WrapError(err)
-		}
-	}
-	return nil
-}
-
-func (s *RdsService) describeTags(d *schema.ResourceData) (tags []Tag, err error) {
-	request := rds.CreateDescribeTagsRequest()
-	request.DBInstanceId = d.Id()
-	request.RegionId = s.client.RegionId
-	raw, err := s.client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
-		return client.DescribeTags(request)
-	})
-	if err != nil {
-		tmp := make([]Tag, 0)
-		return tmp, WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
-	}
-
-	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-
-	response, _ := raw.(*rds.DescribeTagsResponse)
-	return s.respToTags(response.Items.TagInfos), nil
-}
-
-func (s *RdsService) respToTags(tagSet []rds.TagInfos) (tags []Tag) {
-	result := make([]Tag, 0, len(tagSet))
-	for _, t := range tagSet {
-		tag := Tag{
-			Key:   t.TagKey,
-			Value: t.TagValue,
-		}
-		result = append(result, tag)
-	}
-
-	return result
-}
-
-func (s *RdsService) tagsToMap(tags []Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range tags {
-		if !s.ignoreTag(t) {
-			result[t.Key] = t.Value
-		}
-	}
-
-	return result
-}
-
-func (s *RdsService) ignoreTag(t Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
-}
-
-func (s *RdsService) tagsToString(tags []Tag) string {
-	v, _ := json.Marshal(s.tagsToMap(tags))
-
-	return string(v)
-}
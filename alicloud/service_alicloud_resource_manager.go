@@ -0,0 +1,244 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+type ResourceManagerService struct {
+	client *connectivity.AliyunClient
+}
+
+type ResourceGroup struct {
+	Id          string
+	Name        string
+	DisplayName string
+	AccountId   string
+	Status      string
+	CreateDate  string
+}
+
+type Folder struct {
+	FolderId       string
+	FolderName     string
+	ParentFolderId string
+	CreateTime     string
+}
+
+type ResourceManagerResource struct {
+	ResourceId      string
+	ResourceType    string
+	Service         string
+	RegionId        string
+	ResourceGroupId string
+	CreateDate      string
+}
+
+// ResourceGroupResourceType identifies a kind of resource in the Resource Manager APIs by its service and resource type.
+type ResourceGroupResourceType struct {
+	Service      string
+	ResourceType string
+}
+
+var (
+	ResourceGroupRdsInstance      = ResourceGroupResourceType{Service: "rds", ResourceType: "DBInstance"}
+	ResourceGroupKVStoreInstance  = ResourceGroupResourceType{Service: "kvstore", ResourceType: "Instance"}
+	ResourceGroupMongoDBInstance  = ResourceGroupResourceType{Service: "dds", ResourceType: "DBInstance"}
+	ResourceGroupNasFileSystem    = ResourceGroupResourceType{Service: "nas", ResourceType: "filesystem"}
+	ResourceGroupOssBucket        = ResourceGroupResourceType{Service: "oss", ResourceType: "bucket"}
+	ResourceGroupCsCluster        = ResourceGroupResourceType{Service: "cs", ResourceType: "cluster"}
+	ResourceGroupAlikafkaInstance = ResourceGroupResourceType{Service: "alikafka", ResourceType: "instance"}
+)
+
+func (s *ResourceManagerService) newRequest(apiName string) *requests.CommonRequest {
+	request := requests.NewCommonRequest()
+	request.Product = "ResourceManager"
+	request.Version = "2020-03-31"
+	request.ApiName = apiName
+	request.RegionId = s.client.RegionId
+	request.Method = requests.POST
+	request.Scheme = "https"
+	return request
+}
+
+// doRequest calls the Resource Manager API and unmarshals its response into response.
+func (s *ResourceManagerService) doRequest(id string, request *requests.CommonRequest, response interface{}) error {
+	raw, err := s.client.WithResourceManagerClient(func(rmClient *sdk.Client) (interface{}, error) {
+		return rmClient.ProcessCommonRequest(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, request.ApiName, AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.ApiName, raw, request.Headers, request)
+	if response == nil {
+		return nil
+	}
+	if err := json.Unmarshal(raw.(*responses.CommonResponse).GetHttpContentBytes(), response); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func (s *ResourceManagerService) DescribeResourceManagerResourceGroup(id string) (object ResourceGroup, err error) {
+	request := s.newRequest("GetResourceGroup")
+	request.QueryParams["ResourceGroupId"] = id
+	response := struct {
+		ResourceGroup ResourceGroup
+	}{}
+	if err = s.doRequest(id, request, &response); err != nil {
+		if IsExceptedError(err, ResourceGroupNotFound) {
+			err = WrapErrorf(Error(GetNotFoundMessage("ResourceManagerResourceGroup", id)), NotFoundMsg, ProviderERROR)
+		}
+		return
+	}
+	// The resource group is kept for a while after it is deleted.
+	if response.ResourceGroup.Status == "Deleted" {
+		return object, WrapErrorf(Error(GetNotFoundMessage("ResourceManagerResourceGroup", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response.ResourceGroup, nil
+}
+
+func (s *ResourceManagerService) ResourceManagerResourceGroupStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeResourceManagerResourceGroup(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.Status == failState {
+				return object, object.Status, WrapError(Error(FailedToReachTargetStatus, object.Status))
+			}
+		}
+		return object, object.Status, nil
+	}
+}
+
+func (s *ResourceManagerService) DescribeResourceManagerFolder(id string) (object Folder, err error) {
+	request := s.newRequest("GetFolder")
+	request.QueryParams["FolderId"] = id
+	response := struct {
+		Folder Folder
+	}{}
+	if err = s.doRequest(id, request, &response); err != nil {
+		if IsExceptedError(err, FolderNotFound) {
+			err = WrapErrorf(Error(GetNotFoundMessage("ResourceManagerFolder", id)), NotFoundMsg, ProviderERROR)
+		}
+		return
+	}
+	return response.Folder, nil
+}
+
+// ListResourceGroups returns all of the resource groups of the account in the specified status, or in any status if it is empty.
+func (s *ResourceManagerService) ListResourceGroups(status string) ([]ResourceGroup, error) {
+	var groups []ResourceGroup
+	pageNumber := 1
+	for {
+		request := s.newRequest("ListResourceGroups")
+		request.QueryParams["PageNumber"] = strconv.Itoa(pageNumber)
+		request.QueryParams["PageSize"] = strconv.Itoa(PageSizeLarge)
+		if status != "" {
+			request.QueryParams["Status"] = status
+		}
+		response := struct {
+			TotalCount     int
+			ResourceGroups struct {
+				ResourceGroup []ResourceGroup
+			}
+		}{}
+		if err := s.doRequest("alicloud_resource_manager_resource_groups", request, &response); err != nil {
+			return nil, err
+		}
+		groups = append(groups, response.ResourceGroups.ResourceGroup...)
+		if len(response.ResourceGroups.ResourceGroup) < PageSizeLarge || len(groups) >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+	return groups, nil
+}
+
+// DescribeResourceGroupId returns the id of the resource group which the resource belongs to. An empty id is returned
+// when the caller has no permission to use the Resource Manager APIs, so the resources can still be read without them.
+func (s *ResourceManagerService) DescribeResourceGroupId(id string, resourceType ResourceGroupResourceType) (string, error) {
+	request := s.newRequest("ListResources")
+	request.QueryParams["Service"] = resourceType.Service
+	request.QueryParams["ResourceType"] = resourceType.ResourceType
+	request.QueryParams["ResourceIds"] = id
+	request.QueryParams["Region"] = s.client.RegionId
+	response := struct {
+		Resources struct {
+			Resource []ResourceManagerResource
+		}
+	}{}
+	if err := s.doRequest(id, request, &response); err != nil {
+		if IsExceptedErrors(err, ResourceManagerNoPermission) {
+			log.Printf("[WARN] The resource group of %s can not be read: %#v", id, err)
+			return "", nil
+		}
+		return "", WrapError(err)
+	}
+	for _, r := range response.Resources.Resource {
+		if r.ResourceId == id {
+			return r.ResourceGroupId, nil
+		}
+	}
+	return "", nil
+}
+
+// MoveResource moves the resource into the resource group.
+func (s *ResourceManagerService) MoveResource(id string, resourceType ResourceGroupResourceType, resourceGroupId string) error {
+	request := s.newRequest("MoveResources")
+	request.QueryParams["ResourceGroupId"] = resourceGroupId
+	request.QueryParams["Resources.1.ResourceId"] = id
+	request.QueryParams["Resources.1.RegionId"] = s.client.RegionId
+	request.QueryParams["Resources.1.Service"] = resourceType.Service
+	request.QueryParams["Resources.1.ResourceType"] = resourceType.ResourceType
+	response := struct {
+		Responses struct {
+			Response []struct {
+				ResourceId string
+				Status     string
+				ErrorCode  string
+				ErrorMsg   string
+			}
+		}
+	}{}
+	if err := s.doRequest(id, request, &response); err != nil {
+		return err
+	}
+	for _, r := range response.Responses.Response {
+		if r.Status != "" && r.Status != "Success" {
+			return WrapErrorf(Error(fmt.Sprintf("%s: %s", r.ErrorCode, r.ErrorMsg)), DefaultErrorMsg, id, request.ApiName, AlibabaCloudSdkGoERROR)
+		}
+	}
+	return nil
+}
+
+// SetResourceGroup moves the resource into the resource group set by resource_group_id if it is changed.
+// A resource is never moved out of its resource group when resource_group_id is removed from the configuration.
+func (s *ResourceManagerService) SetResourceGroup(d *schema.ResourceData, resourceType ResourceGroupResourceType) error {
+	if !d.HasChange("resource_group_id") {
+		return nil
+	}
+	if v, ok := d.GetOk("resource_group_id"); ok && v.(string) != "" {
+		if err := s.MoveResource(d.Id(), resourceType, v.(string)); err != nil {
+			return WrapError(err)
+		}
+	}
+	d.SetPartial("resource_group_id")
+	return nil
+}
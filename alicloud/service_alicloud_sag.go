@@ -4,7 +4,6 @@ import (
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/smartag"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -18,16 +17,13 @@ func (s *SagService) DescribeCloudConnectNetwork(id string) (c smartag.CloudConn
 	request.CcnId = id
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(ccnClient *smartag.Client) (interface{}, error) {
 			return ccnClient.DescribeCloudConnectNetworks(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -58,16 +54,13 @@ func (s *SagService) DescribeCloudConnectNetworkGrant(id string) (c smartag.Gran
 	request.AssociatedCcnId = parts[0]
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(ccnClient *smartag.Client) (interface{}, error) {
 			return ccnClient.DescribeGrantRules(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -94,16 +87,13 @@ func (s *SagService) DescribeSagAcl(id string) (c smartag.Acl, err error) {
 	request.AclIds = id
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeACLs(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -134,16 +124,13 @@ func (s *SagService) DescribeSagAclRule(id string) (c smartag.Acr, err error) {
 	request.AclId = parts[0]
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeACLAttribute(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -170,16 +157,13 @@ func (s *SagService) DescribeSagNetworkopt(id string) (c smartag.NetworkOptimiza
 	request.NetworkOptId = id
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeNetworkOptimizations(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -210,16 +194,13 @@ func (s *SagService) DescribeSagNetworkoptSetting(id string) (c smartag.Setting,
 	request.NetworkOptId = parts[0]
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeNetworkOptimizationSettings(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -251,16 +232,13 @@ func (s *SagService) DescribeSagClientUser(id string) (c smartag.User, err error
 	request.SmartAGId = parts[0]
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeSmartAccessGatewayClientUsers(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -292,16 +270,13 @@ func (s *SagService) DescribeSagSnatEntry(id string) (c smartag.SnatEntry, err e
 	request.SmartAGId = parts[0]
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeSnatEntries(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -333,16 +308,13 @@ func (s *SagService) DescribeSagDnatEntry(id string) (c smartag.DnatEntry, err e
 	request.SagId = parts[0]
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeDnatEntries(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -369,16 +341,13 @@ func (s *SagService) DescribeSagQos(id string) (c smartag.Qos, err error) {
 	request.QosIds = id
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeQoses(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -410,16 +379,13 @@ func (s *SagService) DescribeSagQosPolicy(id string) (c smartag.QosPolicy, err e
 	request.QosPolicyId = parts[1]
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeQosPolicies(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -451,16 +417,13 @@ func (s *SagService) DescribeSagQosCar(id string) (c smartag.QosCar, err error)
 	request.QosCarId = parts[1]
 
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.SAGCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithSagClient(func(sagClient *smartag.Client) (interface{}, error) {
 			return sagClient.DescribeQosCars(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, "Throttling.User"}) {
-				time.Sleep(DefaultIntervalShort * time.Second)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -0,0 +1,342 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/elasticsearch"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/gpdb"
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+const (
+	// MaxTagsPerResource is the max number of the tags of a resource supported by the Tag API.
+	MaxTagsPerResource = 20
+	// tagsPerRequest is the max number of the tags or the tag keys in one TagResources or UntagResources call.
+	tagsPerRequest = 20
+)
+
+// reservedTagKeyPrefixes are used by the tags added by Alibaba Cloud, which can not be added or removed by users.
+var reservedTagKeyPrefixes = []string{"acs:", "aliyun"}
+
+// tagApi describes the Tag API of a product. The APIs TagResources, UntagResources and ListTagResources are the same
+// in all of the products except their API version and resource types, so they are called by the common requests.
+type tagApi struct {
+	product             string
+	locationServiceCode string
+	version             string
+	// roa is set for the products whose Tag API is in the ROA style, like Elasticsearch.
+	roa bool
+	// resourceTypes maps the resource types of the provider to the ones of the product.
+	resourceTypes map[TagResourceType]string
+	call          func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error)
+}
+
+var tagApis = map[connectivity.ServiceCode]tagApi{
+	connectivity.RDSCode: {
+		product: "Rds", locationServiceCode: "rds", version: "2014-08-15",
+		resourceTypes: map[TagResourceType]string{TagResourceInstance: "INSTANCE"},
+		call: func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error) {
+			return client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+				return rdsClient.ProcessCommonRequest(request)
+			})
+		},
+	},
+	connectivity.KVSTORECode: {
+		product: "R-kvstore", locationServiceCode: "redisa", version: "2015-01-01",
+		resourceTypes: map[TagResourceType]string{TagResourceInstance: "INSTANCE"},
+		call: func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error) {
+			return client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+				return rkvClient.ProcessCommonRequest(request)
+			})
+		},
+	},
+	connectivity.SLBCode: {
+		product: "Slb", locationServiceCode: "slb", version: "2014-05-15",
+		resourceTypes: map[TagResourceType]string{TagResourceInstance: "instance"},
+		call: func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error) {
+			return client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
+				return slbClient.ProcessCommonRequest(request)
+			})
+		},
+	},
+	connectivity.GPDBCode: {
+		product: "gpdb", locationServiceCode: "gpdb", version: "2016-05-03",
+		resourceTypes: map[TagResourceType]string{TagResourceInstance: "instance"},
+		call: func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error) {
+			return client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
+				return gpdbClient.ProcessCommonRequest(request)
+			})
+		},
+	},
+	connectivity.DDSCode: {
+		product: "Dds", locationServiceCode: "dds", version: "2015-12-01",
+		resourceTypes: map[TagResourceType]string{TagResourceInstance: "INSTANCE"},
+		call: func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error) {
+			return client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+				return ddsClient.ProcessCommonRequest(request)
+			})
+		},
+	},
+	connectivity.ALIKAFKACode: {
+		product: "alikafka", locationServiceCode: "alikafka", version: "2019-09-16",
+		resourceTypes: map[TagResourceType]string{
+			TagResourceInstance:      "INSTANCE",
+			TagResourceTopic:         "TOPIC",
+			TagResourceConsumerGroup: "CONSUMERGROUP",
+		},
+		call: func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error) {
+			return client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+				return alikafkaClient.ProcessCommonRequest(request)
+			})
+		},
+	},
+	connectivity.ELASTICSEARCHCode: {
+		product: "elasticsearch", locationServiceCode: "elasticsearch", version: "2017-06-13", roa: true,
+		resourceTypes: map[TagResourceType]string{TagResourceInstance: "INSTANCE"},
+		call: func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error) {
+			return client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
+				return elasticsearchClient.ProcessCommonRequest(request)
+			})
+		},
+	},
+}
+
+// TagService manages the tags of the resources by the Tag API of their products.
+type TagService struct {
+	client *connectivity.AliyunClient
+}
+
+type tagResource struct {
+	ResourceId   string
+	ResourceType string
+	TagKey       string
+	TagValue     string
+}
+
+type listTagResourcesResponse struct {
+	NextToken    string
+	TagResources struct {
+		TagResource []tagResource
+	}
+	Result *listTagResourcesResponse
+}
+
+func (s *TagService) describeTagApi(product connectivity.ServiceCode, resourceType TagResourceType) (tagApi, string, error) {
+	api, ok := tagApis[product]
+	if !ok {
+		return api, "", WrapError(fmt.Errorf("the product %s does not support the Tag API", product))
+	}
+	apiResourceType, ok := api.resourceTypes[resourceType]
+	if !ok {
+		return api, "", WrapError(fmt.Errorf("the product %s does not support tagging the resource type %s", product, resourceType))
+	}
+	return api, apiResourceType, nil
+}
+
+func (s *TagService) newTagRequest(api tagApi, action, resourceType string, ids []string) *requests.CommonRequest {
+	request := requests.NewCommonRequest()
+	request.Product = api.product
+	request.ServiceCode = api.locationServiceCode
+	request.Version = api.version
+	request.ApiName = action
+	request.RegionId = s.client.RegionId
+	request.Scheme = "https"
+	if api.roa {
+		// The ROA Tag API takes the lists in JSON.
+		request.PathPattern = "/openapi/tags"
+		ids, _ := json.Marshal(ids)
+		request.QueryParams["ResourceType"] = resourceType
+		request.QueryParams["ResourceIds"] = string(ids)
+		return request
+	}
+	request.Method = requests.POST
+	request.QueryParams["RegionId"] = s.client.RegionId
+	request.QueryParams["ResourceType"] = resourceType
+	for i, id := range ids {
+		request.QueryParams[fmt.Sprintf("ResourceId.%d", i+1)] = id
+	}
+	return request
+}
+
+// SetResourceTags applies the change of tags_all to the resource.
+func (s *TagService) SetResourceTags(d *schema.ResourceData, product connectivity.ServiceCode, resourceType TagResourceType) error {
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
+		if err := s.UpdateResourceTags([]string{d.Id()}, product, resourceType, oraw, nraw); err != nil {
+			return WrapError(err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+	return nil
+}
+
+// UpdateResourceTags removes the old tags which are not in the new ones, and adds the new tags in batches.
+func (s *TagService) UpdateResourceTags(ids []string, product connectivity.ServiceCode, resourceType TagResourceType, oraw, nraw interface{}) error {
+	api, apiResourceType, err := s.describeTagApi(product, resourceType)
+	if err != nil {
+		return err
+	}
+	o := oraw.(map[string]interface{})
+	n := nraw.(map[string]interface{})
+	create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+
+	for start := 0; start < len(remove); start += tagsPerRequest {
+		end := start + tagsPerRequest
+		if end > len(remove) {
+			end = len(remove)
+		}
+		log.Printf("[DEBUG] Removing tags: %#v from %#v", remove[start:end], ids)
+		request := s.newTagRequest(api, "UntagResources", apiResourceType, ids)
+		var keys []string
+		for _, t := range remove[start:end] {
+			keys = append(keys, t.Key)
+		}
+		if api.roa {
+			request.Method = requests.DELETE
+			content, _ := json.Marshal(keys)
+			request.QueryParams["TagKeys"] = string(content)
+		} else {
+			for i, key := range keys {
+				request.QueryParams[fmt.Sprintf("TagKey.%d", i+1)] = key
+			}
+		}
+		if err := s.callTagApi(api, request, ids); err != nil {
+			return err
+		}
+	}
+
+	for start := 0; start < len(create); start += tagsPerRequest {
+		end := start + tagsPerRequest
+		if end > len(create) {
+			end = len(create)
+		}
+		log.Printf("[DEBUG] Creating tags: %s for %#v", create[start:end], ids)
+		request := s.newTagRequest(api, "TagResources", apiResourceType, ids)
+		if api.roa {
+			request.Method = requests.POST
+			var tags []map[string]string
+			for _, t := range create[start:end] {
+				tags = append(tags, map[string]string{"key": t.Key, "value": t.Value})
+			}
+			content, _ := json.Marshal(map[string]interface{}{
+				"ResourceIds":  ids,
+				"ResourceType": apiResourceType,
+				"Tags":         tags,
+			})
+			delete(request.QueryParams, "ResourceType")
+			delete(request.QueryParams, "ResourceIds")
+			request.SetContent(content)
+			request.SetContentType(requests.Json)
+		} else {
+			for i, t := range create[start:end] {
+				request.QueryParams[fmt.Sprintf("Tag.%d.Key", i+1)] = t.Key
+				request.QueryParams[fmt.Sprintf("Tag.%d.Value", i+1)] = t.Value
+			}
+		}
+		if err := s.callTagApi(api, request, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *TagService) callTagApi(api tagApi, request *requests.CommonRequest, ids []string) error {
+	raw, err := api.call(s.client, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, ids, request.ApiName, AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.ApiName, raw, request.Headers, request)
+	return nil
+}
+
+// ListResourceTags returns the tags of a resource without the ones added by Alibaba Cloud.
+func (s *TagService) ListResourceTags(id string, product connectivity.ServiceCode, resourceType TagResourceType) (map[string]string, error) {
+	api, apiResourceType, err := s.describeTagApi(product, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string)
+	nextToken := ""
+	for {
+		request := s.newTagRequest(api, "ListTagResources", apiResourceType, []string{id})
+		if api.roa {
+			request.Method = requests.GET
+		}
+		if nextToken != "" {
+			request.QueryParams["NextToken"] = nextToken
+		}
+		raw, err := api.call(s.client, request)
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, id, request.ApiName, AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.ApiName, raw, request.Headers, request)
+		response := &listTagResourcesResponse{}
+		if err := json.Unmarshal(raw.(*responses.CommonResponse).GetHttpContentBytes(), response); err != nil {
+			return nil, WrapError(err)
+		}
+		// The ROA Tag API returns the tags in Result, and its NextToken may be at either level.
+		if response.Result != nil {
+			if response.Result.NextToken == "" {
+				response.Result.NextToken = response.NextToken
+			}
+			response = response.Result
+		}
+		for _, t := range response.TagResources.TagResource {
+			if t.ResourceId != "" && t.ResourceId != id || tagKeyIgnored(t.TagKey) {
+				continue
+			}
+			tags[t.TagKey] = t.TagValue
+		}
+		if response.NextToken == "" || response.NextToken == nextToken {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	return tags, nil
+}
+
+// tagApiTagsCustomizeDiff checks the planned tags_all against the limits of the Tag API, so that the invalid tags are
+// reported at plan time. It runs after the tags_all is planned.
+func tagApiTagsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags_all") {
+		return nil
+	}
+	return WrapError(checkTagApiTags(d.Get("tags_all").(map[string]interface{})))
+}
+
+// checkTagApiTags checks the tags against the limits of the Tag API.
+func checkTagApiTags(tags map[string]interface{}) error {
+	if len(tags) > MaxTagsPerResource {
+		return fmt.Errorf("a resource supports at most %d tags, got %d", MaxTagsPerResource, len(tags))
+	}
+	for key := range tags {
+		for _, prefix := range reservedTagKeyPrefixes {
+			if strings.HasPrefix(strings.ToLower(key), prefix) {
+				return fmt.Errorf("the tag key %s is invalid, it can not start with %s which is reserved by Alibaba Cloud", key, prefix)
+			}
+		}
+	}
+	return nil
+}
+
+// tagKeyIgnored reports whether a tag is added by Alibaba Cloud and should be ignored.
+func tagKeyIgnored(key string) bool {
+	for _, prefix := range append(reservedTagKeyPrefixes, "http://", "https://") {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
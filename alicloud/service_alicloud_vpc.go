@@ -5,8 +5,6 @@ import (
 	"regexp"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
-
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -61,7 +59,7 @@ func (s *VpcService) DescribeNatGateway(id string) (nat vpc.NatGateway, err erro
 	request.RegionId = string(s.client.Region)
 	request.NatGatewayId = id
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeNatGateways(request)
@@ -88,7 +86,7 @@ func (s *VpcService) DescribeVpc(id string) (v vpc.DescribeVpcAttributeResponse,
 	request.RegionId = s.client.RegionId
 	request.VpcId = id
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeVpcAttribute(request)
@@ -115,7 +113,7 @@ func (s *VpcService) DescribeVSwitch(id string) (v vpc.DescribeVSwitchAttributes
 	request.RegionId = s.client.RegionId
 	request.VSwitchId = id
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeVSwitchAttributes(request)
@@ -148,7 +146,7 @@ func (s *VpcService) DescribeSnatEntry(id string) (snat vpc.SnatTableEntry, err
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	for {
-		invoker := NewInvoker()
+		invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 		var response *vpc.DescribeSnatTableEntriesResponse
 		var raw interface{}
 		err = invoker.Run(func() error {
@@ -202,7 +200,7 @@ func (s *VpcService) DescribeForwardEntry(id string) (entry vpc.ForwardTableEntr
 	request.RegionId = string(s.client.Region)
 	request.ForwardTableId = forwardTableId
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeForwardTableEntries(request)
@@ -234,7 +232,7 @@ func (s *VpcService) QueryRouteTableById(routeTableId string) (rt vpc.RouteTable
 	request.RegionId = s.client.RegionId
 	request.RouteTableId = routeTableId
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeRouteTables(request)
@@ -266,7 +264,7 @@ func (s *VpcService) DescribeRouteEntry(id string) (*vpc.RouteEntry, error) {
 	request.RegionId = s.client.RegionId
 	request.RouteTableId = rtId
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
@@ -315,7 +313,7 @@ func (s *VpcService) DescribeRouterInterface(id, regionId string) (ri vpc.Router
 		},
 	}
 	request.Filter = &filter
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeRouterInterfaces(request)
@@ -365,7 +363,7 @@ func (s *VpcService) DescribeCenInstanceGrant(id string) (rule vpc.CbnGrantRule,
 	request.InstanceId = instanceId
 	request.InstanceType = instanceType
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeGrantRulesToCen(request)
@@ -426,7 +424,7 @@ func (s *VpcService) DescribeCommonBandwidthPackage(id string) (v vpc.CommonBand
 	request := vpc.CreateDescribeCommonBandwidthPackagesRequest()
 	request.RegionId = s.client.RegionId
 	request.BandwidthPackageId = id
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeCommonBandwidthPackages(request)
@@ -474,7 +472,7 @@ func (s *VpcService) DescribeRouteTable(id string) (v vpc.RouterTableListType, e
 	request.RegionId = s.client.RegionId
 	request.RouteTableId = id
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeRouteTableList(request)
@@ -501,7 +499,7 @@ func (s *VpcService) DescribeRouteTableAttachment(id string) (v vpc.RouterTableL
 	if err != nil {
 		return v, WrapError(err)
 	}
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	routeTableId := parts[0]
 	vSwitchId := parts[1]
 
@@ -940,7 +938,7 @@ func (s *VpcService) DescribeNetworkAcl(id string) (networkAcl vpc.NetworkAcl, e
 
 func (s *VpcService) DescribeNetworkAclAttachment(id string, resource []vpc.Resource) (err error) {
 
-	invoker := NewInvoker()
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
 	return invoker.Run(func() error {
 		object, err := s.DescribeNetworkAcl(id)
 		if err != nil {
@@ -1042,19 +1040,15 @@ func (s *VpcService) DescribeTags(resourceId string, resourceTags map[string]int
 		request.Tag = &reqTags
 	}
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	invoker := NewProductInvoker(s.client, connectivity.VPCCode)
+	err = invoker.Run(func() error {
 		raw, err = s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ListTagResources(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, Throttling) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return err
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
@@ -1069,8 +1063,8 @@ func (s *VpcService) DescribeTags(resourceId string, resourceTags map[string]int
 }
 
 func (s *VpcService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -1110,6 +1104,7 @@ func (s *VpcService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 		}
 
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return nil
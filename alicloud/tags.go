@@ -2,10 +2,9 @@ package alicloud
 
 import (
 	"log"
+	"reflect"
 	"strings"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/services/gpdb"
-
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -38,11 +37,143 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
+// tagsAllSchema is the effective tags of a resource, including the provider default_tags.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
+// tagIgnored reports whether the tag key is specified by the provider ignore_tags.
+func tagIgnored(client *connectivity.AliyunClient, key string) bool {
+	for _, k := range client.IgnoreTagKeys {
+		if key == k {
+			return true
+		}
+	}
+	for _, prefix := range client.IgnoreTagKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// effectiveTags merges the provider default_tags and the tags of a resource, whose tags take precedence,
+// without the ignored tags.
+func effectiveTags(client *connectivity.AliyunClient, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for key, value := range client.DefaultTags {
+		result[key] = value
+	}
+	for key, value := range tags {
+		result[key] = value
+	}
+	for key := range result {
+		if tagIgnored(client, key) {
+			delete(result, key)
+		}
+	}
+	return result
+}
+
+// tagsAllCustomizeDiff plans the tags_all of a resource. The resources apply the change of tags_all instead of tags,
+// so that the change of the provider default_tags is applied as well.
+func tagsAllCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return planTagsAll(d, meta, false)
+}
+
+// caseInsensitiveTagsAllCustomizeDiff plans the tags_all of the resources whose tags are not case sensitive, like RDS.
+func caseInsensitiveTagsAllCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return planTagsAll(d, meta, true)
+}
+
+func planTagsAll(d *schema.ResourceDiff, meta interface{}, ignoreCase bool) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+	client := meta.(*connectivity.AliyunClient)
+	all := effectiveTags(client, d.Get("tags").(map[string]interface{}))
+	old := d.Get("tags_all").(map[string]interface{})
+	if reflect.DeepEqual(all, old) || ignoreCase && tagsEqualFold(all, old) {
+		return nil
+	}
+	return d.SetNew("tags_all", all)
+}
+
+// tagsEqualFold compares the tags with the keys and the values of both sides normalized to lower case.
+func tagsEqualFold(tags, other map[string]interface{}) bool {
+	lower, otherLower := lowerCaseTags(tags), lowerCaseTags(other)
+	if len(lower) != len(otherLower) {
+		return false
+	}
+	for key, value := range lower {
+		if otherValue, ok := otherLower[key]; !ok || otherValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+func lowerCaseTags(tags map[string]interface{}) map[string]string {
+	lower := make(map[string]string, len(tags))
+	for key, value := range tags {
+		lower[strings.ToLower(key)] = strings.ToLower(value.(string))
+	}
+	return lower
+}
+
+// setTagsAndTagsAll sets the tags read from a resource without the ignored tags into tags_all, and the ones into tags
+// except the provider default_tags which are not specified by the resource.
+func setTagsAndTagsAll(client *connectivity.AliyunClient, d *schema.ResourceData, tags map[string]string) error {
+	configured := d.Get("tags").(map[string]interface{})
+	all := make(map[string]string)
+	own := make(map[string]string)
+	for key, value := range tags {
+		if tagIgnored(client, key) {
+			continue
+		}
+		all[key] = value
+		if isDefaultTag(client, key, value) && !tagConfigured(configured, key) {
+			continue
+		}
+		own[key] = value
+	}
+	if err := d.Set("tags", own); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("tags_all", all); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+// isDefaultTag reports whether the tag comes from the provider default_tags. The tags of some products, like RDS, are
+// returned in lower case, so the tags are compared without case.
+func isDefaultTag(client *connectivity.AliyunClient, key, value string) bool {
+	for defaultKey, defaultValue := range client.DefaultTags {
+		if strings.EqualFold(key, defaultKey) && strings.EqualFold(value, defaultValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func tagConfigured(configured map[string]interface{}, key string) bool {
+	for configuredKey := range configured {
+		if strings.EqualFold(key, configuredKey) {
+			return true
+		}
+	}
+	return false
+}
+
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// effective tags field to be named "tags_all"
 func setTags(client *connectivity.AliyunClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		return updateTags(client, []string{d.Id()}, resourceType, oraw, nraw)
 	}
 
@@ -50,8 +181,8 @@ func setTags(client *connectivity.AliyunClient, resourceType TagResourceType, d
 }
 
 func setCdnTags(client *connectivity.AliyunClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		return updateCdnTags(client, []string{d.Id()}, resourceType, oraw, nraw)
 	}
 
@@ -218,26 +349,6 @@ func diffTags(oldTags, newTags []Tag) ([]Tag, []Tag) {
 	return tagsFromMap(create), remove
 }
 
-func diffGpdbTags(oldTags, newTags []gpdb.TagResourcesTag) ([]gpdb.TagResourcesTag, []gpdb.TagResourcesTag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[t.Key] = t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []gpdb.TagResourcesTag
-	for _, t := range oldTags {
-		old, ok := create[t.Key]
-		if !ok || old != t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return gpdbTagsFromMap(create), remove
-}
-
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMap(m map[string]interface{}) []Tag {
 	result := make([]Tag, 0, len(m))
@@ -251,18 +362,6 @@ func tagsFromMap(m map[string]interface{}) []Tag {
 	return result
 }
 
-func gpdbTagsFromMap(m map[string]interface{}) []gpdb.TagResourcesTag {
-	result := make([]gpdb.TagResourcesTag, 0, len(m))
-	for k, v := range m {
-		result = append(result, gpdb.TagResourcesTag{
-			Key:   k,
-			Value: v.(string),
-		})
-	}
-
-	return result
-}
-
 func tagsToMap(tags []ecs.Tag) map[string]string {
 	result := make(map[string]string)
 	for _, t := range tags {
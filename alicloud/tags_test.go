@@ -1,7 +1,17 @@
 package alicloud
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
 func TestTagsMapEqual(t *testing.T) {
@@ -48,3 +58,132 @@ func TestTagsMapEqual(t *testing.T) {
 		t.Fatal("Tag maps is equal.")
 	}
 }
+
+func TestEffectiveTags(t *testing.T) {
+	client := &connectivity.AliyunClient{
+		DefaultTags:          map[string]string{"Environment": "test", "Owner": "provider", "ignored": "yes"},
+		IgnoreTagKeys:        []string{"ignored"},
+		IgnoreTagKeyPrefixes: []string{"acs:"},
+	}
+	tags := effectiveTags(client, map[string]interface{}{"Owner": "resource", "acs:system": "yes"})
+	expected := map[string]interface{}{"Environment": "test", "Owner": "resource"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("expected the effective tags %v, got %v.", expected, tags)
+	}
+}
+
+func TestTagsEqualFold(t *testing.T) {
+	cases := []struct {
+		tags, other map[string]interface{}
+		expected    bool
+	}{
+		{map[string]interface{}{"Env": "Test"}, map[string]interface{}{"env": "test"}, true},
+		{map[string]interface{}{"env": "test"}, map[string]interface{}{"ENV": "TEST"}, true},
+		{map[string]interface{}{"Env": "Test"}, map[string]interface{}{"ENV": "prod"}, false},
+		{map[string]interface{}{"Env": "Test"}, map[string]interface{}{"Env": "Test", "Owner": "ops"}, false},
+		{map[string]interface{}{"Env": "Test", "Owner": "ops"}, map[string]interface{}{"env": "test", "team": "ops"}, false},
+	}
+	for i, c := range cases {
+		if got := tagsEqualFold(c.tags, c.other); got != c.expected {
+			t.Fatalf("case %d: expected %t, got %t", i, c.expected, got)
+		}
+	}
+}
+
+func TestSetTagsAndTagsAll(t *testing.T) {
+	client := &connectivity.AliyunClient{
+		DefaultTags:          map[string]string{"Environment": "test", "Owner": "provider"},
+		IgnoreTagKeyPrefixes: []string{"acs:"},
+	}
+	d := schema.TestResourceDataRaw(t, resourceAliyunVpc().Schema, map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"tags":       map[string]interface{}{"Owner": "provider", "Name": "tf-test"},
+	})
+	read := map[string]string{"Environment": "test", "Owner": "provider", "Name": "tf-test", "acs:system": "yes"}
+	if err := setTagsAndTagsAll(client, d, read); err != nil {
+		t.Fatal(err)
+	}
+	tags, all := d.Get("tags").(map[string]interface{}), d.Get("tags_all").(map[string]interface{})
+	if !reflect.DeepEqual(tags, map[string]interface{}{"Owner": "provider", "Name": "tf-test"}) {
+		t.Fatalf("the default tags which are not configured should not be set into tags, got %v.", tags)
+	}
+	if !reflect.DeepEqual(all, map[string]interface{}{"Environment": "test", "Owner": "provider", "Name": "tf-test"}) {
+		t.Fatalf("the ignored tags should not be set into tags_all, got %v.", all)
+	}
+}
+
+func TestTagServiceUpdateAndListResourceTags(t *testing.T) {
+	var calls []*requests.CommonRequest
+	tagApis["TEST"] = tagApi{
+		product: "Test", version: "2020-01-01",
+		resourceTypes: map[TagResourceType]string{TagResourceInstance: "INSTANCE"},
+		call: func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error) {
+			calls = append(calls, request)
+			body := `{"RequestId": "1"}`
+			if request.ApiName == "ListTagResources" {
+				body = `{"NextToken": "next", "TagResources": {"TagResource": [{"ResourceId": "i-1", "TagKey": "Name", "TagValue": "tf-test"}, {"ResourceId": "i-1", "TagKey": "acs:system", "TagValue": "yes"}]}}`
+				if request.QueryParams["NextToken"] == "next" {
+					body = `{"TagResources": {"TagResource": [{"ResourceId": "i-1", "TagKey": "For", "TagValue": "test"}]}}`
+				}
+			}
+			response := responses.NewCommonResponse()
+			err := responses.Unmarshal(response, &http.Response{StatusCode: 200, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(body))}, "JSON")
+			return response, err
+		},
+	}
+	defer delete(tagApis, "TEST")
+	tagService := TagService{&connectivity.AliyunClient{RegionId: "cn-hangzhou"}}
+
+	old := make(map[string]interface{})
+	for i := 0; i < 25; i++ {
+		old[fmt.Sprintf("key%d", i)] = "value"
+	}
+	if err := tagService.UpdateResourceTags([]string{"i-1"}, "TEST", TagResourceInstance, old, map[string]interface{}{"Name": "tf-test"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 3 || calls[0].ApiName != "UntagResources" || calls[1].ApiName != "UntagResources" || calls[2].ApiName != "TagResources" {
+		t.Fatalf("expected the tag keys removed in 2 batches and the tags added by one call, got %d calls.", len(calls))
+	}
+	if calls[0].QueryParams["TagKey.20"] == "" || calls[1].QueryParams["TagKey.6"] != "" || calls[2].QueryParams["Tag.1.Key"] != "Name" || calls[2].QueryParams["ResourceId.1"] != "i-1" {
+		t.Fatalf("unexpected parameters of the tag requests %v.", calls)
+	}
+
+	if err := checkTagApiTags(old); err == nil {
+		t.Fatalf("expected an error for more than %d tags.", MaxTagsPerResource)
+	}
+	if err := checkTagApiTags(map[string]interface{}{"acs:system": "yes"}); err == nil {
+		t.Fatalf("expected an error for the reserved tag key.")
+	}
+	if err := tagService.UpdateResourceTags([]string{"i-1"}, "TEST", TagResourceDisk, map[string]interface{}{}, map[string]interface{}{}); err == nil {
+		t.Fatalf("expected an error for the unsupported resource type.")
+	}
+
+	tags, err := tagService.ListResourceTags("i-1", "TEST", TagResourceInstance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tags, map[string]string{"Name": "tf-test", "For": "test"}) {
+		t.Fatalf("unexpected tags %v.", tags)
+	}
+
+	// The ROA Tag API returns the tags in Result with the NextToken beside it.
+	api := tagApis["TEST"]
+	api.roa = true
+	api.call = func(client *connectivity.AliyunClient, request *requests.CommonRequest) (interface{}, error) {
+		body := `{"NextToken": "next", "Result": {"TagResources": {"TagResource": [{"ResourceId": "i-1", "TagKey": "Name", "TagValue": "tf-test"}]}}}`
+		if request.QueryParams["NextToken"] == "next" {
+			body = `{"Result": {"TagResources": {"TagResource": [{"ResourceId": "i-1", "TagKey": "For", "TagValue": "test"}]}}}`
+		}
+		response := responses.NewCommonResponse()
+		err := responses.Unmarshal(response, &http.Response{StatusCode: 200, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(body))}, "JSON")
+		return response, err
+	}
+	tagApis["TEST"] = api
+	tags, err = tagService.ListResourceTags("i-1", "TEST", TagResourceInstance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tags, map[string]string{"Name": "tf-test", "For": "test"}) {
+		t.Fatalf("unexpected tags of the ROA Tag API %v.", tags)
+	}
+}
@@ -1678,6 +1678,17 @@ func validateLaunchTemplateName(v interface{}, k string) (ws []string, errors []
 	return
 }
 
+func validateEssLaunchTemplateVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "Default" || value == "Latest" {
+		return
+	}
+	if version, err := strconv.Atoi(value); err != nil || version < 1 {
+		errors = append(errors, fmt.Errorf("%q must be Default, Latest or a version number, got %q", k, value))
+	}
+	return
+}
+
 func validateActiontrailEventrw(v interface{}, k string) (ws []string, errors []error) {
 	if value := v.(string); value != "" {
 		eventrw := EventRwType(value)
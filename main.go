@@ -1,11 +1,97 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "discover" {
+		if err := discover(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: alicloud.Provider})
 }
+
+// discover writes the configurations of the existing resources of a region, with the import blocks
+// or a shell script importing them. The credentials are read from the environment variables of the provider.
+func discover(args []string) error {
+	flags := flag.NewFlagSet("discover", flag.ContinueOnError)
+	region := flags.String("region", "", "The region to discover. Defaults to the environment variable ALICLOUD_REGION.")
+	products := flags.String("products", "", fmt.Sprintf("A comma separated list of the products to discover, from %s. Defaults to all of them.", strings.Join(alicloud.DiscoveryProducts(), ", ")))
+	tags := flags.String("tags", "", "A comma separated list of key=value tags which the discovered resources must have.")
+	resourceGroupId := flags.String("resource-group-id", "", "The ID of the resource group which the discovered resources must belong to.")
+	format := flags.String("format", alicloud.DiscoveryFormatImportBlocks, fmt.Sprintf("The output format, %q writes the import blocks along with the resources, %q writes a shell script running terraform import.", alicloud.DiscoveryFormatImportBlocks, alicloud.DiscoveryFormatScript))
+	output := flags.String("output", "discovered.tf", "The file to write the resources to, or - for the standard output.")
+	scriptOutput := flags.String("script-output", "import.sh", "The file to write the import script to when the format is script.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	options := alicloud.DiscoveryOptions{
+		ResourceGroupId: *resourceGroupId,
+		Format:          *format,
+		Tags:            make(map[string]string),
+	}
+	if *format != alicloud.DiscoveryFormatImportBlocks && *format != alicloud.DiscoveryFormatScript {
+		return fmt.Errorf("invalid format %q, expected %q or %q", *format, alicloud.DiscoveryFormatImportBlocks, alicloud.DiscoveryFormatScript)
+	}
+	if *products != "" {
+		options.Products = strings.Split(*products, ",")
+		if err := alicloud.ValidateDiscoveryProducts(options.Products); err != nil {
+			return err
+		}
+	}
+	if *tags != "" {
+		for _, tag := range strings.Split(*tags, ",") {
+			parts := strings.SplitN(tag, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return fmt.Errorf("invalid tag %q, expected key=value", tag)
+			}
+			options.Tags[parts[0]] = parts[1]
+		}
+	}
+
+	provider, err := alicloud.ConfigureDiscoveryProvider(*region)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "-" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	var scriptWriter io.Writer
+	if *format == alicloud.DiscoveryFormatScript {
+		file, err := os.OpenFile(*scriptOutput, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		scriptWriter = file
+	}
+
+	resources, err := alicloud.Discover(provider, options, w, scriptWriter)
+	if _, ok := err.(*alicloud.DiscoveryError); err != nil && !ok {
+		return err
+	}
+	// The resources which are discovered are written even if some of the resource types failed.
+	fmt.Fprintf(os.Stderr, "Discovered %d resources.\n", len(resources))
+	return err
+}